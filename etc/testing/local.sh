@@ -61,6 +61,7 @@ rules:
           - pods
           - pods/log
           - endpoints
+          - configmaps
       verbs:
           - get
           - list
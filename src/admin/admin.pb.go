@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	versionpb "github.com/pachyderm/pachyderm/v2/src/version/versionpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -29,8 +30,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ClusterInfo struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DeploymentID         string   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	ID           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeploymentID string `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// version is the version of pachd.
+	Version *versionpb.Version `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// auth_active is true if auth is activated on the cluster.
+	AuthActive bool `protobuf:"varint,4,opt,name=auth_active,json=authActive,proto3" json:"auth_active,omitempty"`
+	// enterprise_active is true if the cluster has an active enterprise license.
+	EnterpriseActive bool `protobuf:"varint,5,opt,name=enterprise_active,json=enterpriseActive,proto3" json:"enterprise_active,omitempty"`
+	// loki_logging is true if pachd can read logs from Loki, as GetLogs does
+	// when use_loki_backend is set.
+	LokiLogging bool `protobuf:"varint,6,opt,name=loki_logging,json=lokiLogging,proto3" json:"loki_logging,omitempty"`
+	// api_versions are the versions of the Pachyderm API that pachd serves,
+	// e.g. "v2" for the pfs_v2 and pps_v2 services.
+	APIVersions          []string `protobuf:"bytes,7,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -83,6 +96,41 @@ func (m *ClusterInfo) GetDeploymentID() string {
 	return ""
 }
 
+func (m *ClusterInfo) GetVersion() *versionpb.Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *ClusterInfo) GetAuthActive() bool {
+	if m != nil {
+		return m.AuthActive
+	}
+	return false
+}
+
+func (m *ClusterInfo) GetEnterpriseActive() bool {
+	if m != nil {
+		return m.EnterpriseActive
+	}
+	return false
+}
+
+func (m *ClusterInfo) GetLokiLogging() bool {
+	if m != nil {
+		return m.LokiLogging
+	}
+	return false
+}
+
+func (m *ClusterInfo) GetAPIVersions() []string {
+	if m != nil {
+		return m.APIVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterInfo)(nil), "admin_v2.ClusterInfo")
 }
@@ -90,23 +138,32 @@ func init() {
 func init() { proto.RegisterFile("admin/admin.proto", fileDescriptor_8595c8dce2486799) }
 
 var fileDescriptor_8595c8dce2486799 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x4c, 0xc9, 0xcd,
-	0xcc, 0xd3, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x1c, 0x60, 0x4e, 0x7c, 0x99,
-	0x91, 0x94, 0x74, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x58, 0x3c, 0xa9, 0x34, 0x4d, 0x3f,
-	0x35, 0xb7, 0xa0, 0xa4, 0x12, 0xa2, 0x4c, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07,
-	0xb1, 0x20, 0xa2, 0x4a, 0x31, 0x5c, 0xdc, 0xce, 0x39, 0xa5, 0xc5, 0x25, 0xa9, 0x45, 0x9e, 0x79,
-	0x69, 0xf9, 0x42, 0x62, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x6c,
-	0x8f, 0xee, 0xc9, 0x33, 0x79, 0xba, 0x04, 0x31, 0x65, 0xa6, 0x08, 0x99, 0x72, 0xf1, 0xa6, 0xa4,
-	0x16, 0xe4, 0xe4, 0x57, 0xe6, 0xa6, 0xe6, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x81, 0x95, 0x08,
-	0x3c, 0xba, 0x27, 0xcf, 0xe3, 0x02, 0x97, 0xf0, 0x74, 0x09, 0xe2, 0x41, 0x28, 0xf3, 0x4c, 0x31,
-	0xf2, 0xe0, 0x62, 0x76, 0x0c, 0xf0, 0x14, 0x72, 0xe4, 0xe2, 0xf3, 0xcc, 0x2b, 0x2e, 0x48, 0x4d,
-	0x2e, 0x81, 0xda, 0x25, 0x24, 0xa6, 0x07, 0x71, 0xaa, 0x1e, 0xcc, 0xa9, 0x7a, 0xae, 0x20, 0xa7,
-	0x4a, 0x89, 0xea, 0xc1, 0x3c, 0xa3, 0x87, 0xe4, 0x2c, 0x25, 0x06, 0x27, 0xcb, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x31, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x20, 0x31, 0x39, 0xa3, 0x32, 0x25, 0xb5, 0x08, 0x99,
-	0x55, 0x66, 0xa4, 0x5f, 0x5c, 0x94, 0x0c, 0x09, 0xa5, 0x24, 0x36, 0xb0, 0x1d, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x83, 0x02, 0xab, 0x67, 0x3b, 0x01, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0xaa, 0x9b, 0x40,
+	0x14, 0xc6, 0xab, 0x69, 0x73, 0xef, 0x1d, 0x6d, 0x7b, 0xef, 0xd0, 0x1b, 0x24, 0x85, 0x68, 0xb3,
+	0x12, 0x02, 0x33, 0x60, 0xe9, 0xa2, 0x4b, 0xd3, 0x14, 0x2a, 0x74, 0x11, 0x5c, 0x74, 0xd1, 0x8d,
+	0xf8, 0x67, 0x32, 0x19, 0xaa, 0xce, 0xa0, 0xa3, 0x90, 0xd7, 0xea, 0x53, 0x74, 0xd9, 0x27, 0x08,
+	0xc5, 0x27, 0x29, 0x8e, 0x9a, 0x64, 0xa3, 0xdf, 0xf9, 0xce, 0x0f, 0xce, 0xe1, 0x7c, 0x03, 0x9e,
+	0xe2, 0xac, 0x60, 0x25, 0x56, 0x5f, 0x24, 0x2a, 0x2e, 0x39, 0xbc, 0x57, 0x45, 0xd4, 0x7a, 0xcb,
+	0xf7, 0x94, 0x73, 0x9a, 0x13, 0xac, 0xfc, 0xa4, 0x39, 0x60, 0x52, 0x08, 0x79, 0x1a, 0xb0, 0xe5,
+	0x3b, 0xca, 0x29, 0x57, 0x12, 0xf7, 0x6a, 0x74, 0xed, 0x96, 0x54, 0x35, 0xe3, 0x25, 0x1e, 0xff,
+	0x22, 0x99, 0xd4, 0x00, 0xac, 0x7f, 0xeb, 0xc0, 0xf8, 0x92, 0x37, 0xb5, 0x24, 0x55, 0x50, 0x1e,
+	0x38, 0x5c, 0x00, 0x9d, 0x65, 0x96, 0xe6, 0x68, 0xee, 0xc3, 0x76, 0xde, 0x9d, 0x6d, 0x3d, 0xd8,
+	0x85, 0x3a, 0xcb, 0xe0, 0x27, 0xf0, 0x3a, 0x23, 0x22, 0xe7, 0xa7, 0x82, 0x94, 0x32, 0x62, 0x99,
+	0xa5, 0x2b, 0xe4, 0xb1, 0x3b, 0xdb, 0xe6, 0xee, 0xd2, 0x08, 0x76, 0xa1, 0x79, 0xc5, 0x82, 0x0c,
+	0x62, 0x70, 0x37, 0xce, 0xb3, 0x66, 0x8e, 0xe6, 0x1a, 0xde, 0x33, 0xba, 0x6c, 0x12, 0xb5, 0x1e,
+	0xfa, 0x31, 0x14, 0xe1, 0x44, 0x41, 0x1b, 0x18, 0x71, 0x23, 0x8f, 0x51, 0x9c, 0x4a, 0xd6, 0x12,
+	0xeb, 0xa5, 0xa3, 0xb9, 0xf7, 0x21, 0xe8, 0x2d, 0x5f, 0x39, 0x70, 0x03, 0x9e, 0x48, 0x29, 0x49,
+	0x25, 0x2a, 0x56, 0x93, 0x09, 0x7b, 0xa5, 0xb0, 0xc7, 0x6b, 0x63, 0x84, 0x3f, 0x00, 0x33, 0xe7,
+	0xbf, 0x58, 0x94, 0x73, 0x4a, 0x59, 0x49, 0xad, 0xb9, 0xe2, 0x8c, 0xde, 0xfb, 0x3e, 0x58, 0xd0,
+	0x03, 0x66, 0x2c, 0x58, 0x34, 0xce, 0xaf, 0xad, 0x3b, 0x67, 0xe6, 0x3e, 0x6c, 0xdf, 0x76, 0x67,
+	0xdb, 0xf0, 0xf7, 0xc1, 0xb8, 0x5f, 0x1d, 0x1a, 0xb1, 0x60, 0x53, 0xe1, 0x7d, 0x03, 0x33, 0x7f,
+	0x1f, 0x40, 0x1f, 0xbc, 0x09, 0xca, 0x5a, 0x90, 0x54, 0x8e, 0x17, 0x84, 0x0b, 0x34, 0x44, 0x84,
+	0xa6, 0x88, 0xd0, 0xd7, 0x3e, 0xa2, 0xe5, 0x33, 0x9a, 0x42, 0x44, 0x37, 0xc7, 0x5e, 0xbf, 0xd8,
+	0x7e, 0xfe, 0xd3, 0xad, 0xb4, 0xbf, 0xdd, 0x4a, 0xfb, 0xd7, 0xad, 0xb4, 0x9f, 0x1b, 0xca, 0xe4,
+	0xb1, 0x49, 0x50, 0xca, 0x0b, 0x2c, 0xe2, 0xf4, 0x78, 0xca, 0x48, 0x75, 0xab, 0x5a, 0x0f, 0xd7,
+	0x55, 0x3a, 0xbc, 0x8e, 0x64, 0xae, 0x66, 0x7c, 0xfc, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xd0,
+	0xb9, 0x04, 0x33, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -213,6 +270,57 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.APIVersions) > 0 {
+		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.APIVersions[iNdEx])
+			copy(dAtA[i:], m.APIVersions[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.APIVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LokiLogging {
+		i--
+		if m.LokiLogging {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnterpriseActive {
+		i--
+		if m.EnterpriseActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuthActive {
+		i--
+		if m.AuthActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DeploymentID) > 0 {
 		i -= len(m.DeploymentID)
 		copy(dAtA[i:], m.DeploymentID)
@@ -255,6 +363,25 @@ func (m *ClusterInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.AuthActive {
+		n += 2
+	}
+	if m.EnterpriseActive {
+		n += 2
+	}
+	if m.LokiLogging {
+		n += 2
+	}
+	if len(m.APIVersions) > 0 {
+		for _, s := range m.APIVersions {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -360,6 +487,134 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.DeploymentID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &versionpb.Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuthActive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnterpriseActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnterpriseActive = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LokiLogging", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LokiLogging = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -78,3 +78,48 @@ func WithOffset(offset int64) GetFileOption {
 		gf.Offset = offset
 	}
 }
+
+// WithSizeBytes limits the get file request to at most sizeBytes bytes.
+func WithSizeBytes(sizeBytes int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.SizeBytes = sizeBytes
+	}
+}
+
+type waitCommitSetConfig struct {
+	excludedRepoTypes []string
+	excludedRepos     []*pfs.Repo
+}
+
+func (wc *waitCommitSetConfig) excluded(repo *pfs.Repo) bool {
+	for _, repoType := range wc.excludedRepoTypes {
+		if repo.Type == repoType {
+			return true
+		}
+	}
+	for _, r := range wc.excludedRepos {
+		if repo.Name == r.Name && repo.Type == r.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitCommitSetOption configures a WaitCommitSetAll call.
+type WaitCommitSetOption func(*waitCommitSetConfig)
+
+// WithoutRepoTypes configures the WaitCommitSetAll call to neither wait for
+// nor return the commits in repos of the given types, e.g. pfs.MetaRepoType.
+func WithoutRepoTypes(repoTypes ...string) WaitCommitSetOption {
+	return func(wc *waitCommitSetConfig) {
+		wc.excludedRepoTypes = append(wc.excludedRepoTypes, repoTypes...)
+	}
+}
+
+// WithoutRepos configures the WaitCommitSetAll call to neither wait for nor
+// return the commits in the given repos.
+func WithoutRepos(repos ...*pfs.Repo) WaitCommitSetOption {
+	return func(wc *waitCommitSetConfig) {
+		wc.excludedRepos = append(wc.excludedRepos, repos...)
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"io"
+	"sort"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -76,6 +77,36 @@ func (c APIClient) UpdateRepo(repoName string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateRepoWithDescription creates a new Repo object in PFS with the given
+// name and description.
+func (c APIClient) CreateRepoWithDescription(repoName, description string) error {
+	_, err := c.PfsAPIClient.CreateRepo(
+		c.Ctx(),
+		&pfs.CreateRepoRequest{
+			Repo:        NewRepo(repoName),
+			Description: description,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// UpdateRepoDescription sets the description of the existing repo with the
+// given name. An empty description clears it.
+func (c APIClient) UpdateRepoDescription(repoName, description string) error {
+	if _, err := c.InspectRepo(repoName); err != nil {
+		return err
+	}
+	_, err := c.PfsAPIClient.CreateRepo(
+		c.Ctx(),
+		&pfs.CreateRepoRequest{
+			Repo:        NewRepo(repoName),
+			Description: description,
+			Update:      true,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectRepo returns info about a specific Repo.
 func (c APIClient) InspectRepo(repoName string) (_ *pfs.RepoInfo, retErr error) {
 	defer func() {
@@ -157,18 +188,17 @@ func (c APIClient) StartCommit(repoName string, branchName string) (_ *pfs.Commi
 // branch is a more convenient way to build linear chains of commits. When a
 // commit is started with a non empty branch the value of branch becomes an
 // alias for the created Commit. This enables a more intuitive access pattern.
-// When the commit is started on a branch the previous head of the branch is
-// used as the parent of the commit.
-// parentCommit specifies the parent Commit, upon creation the new Commit will
-// appear identical to the parent Commit, data can safely be added to the new
-// commit without affecting the contents of the parent Commit. You may pass ""
-// as parentCommit in which case the new Commit will have no parent and will
-// initially appear empty.
-func (c APIClient) StartCommitParent(repoName string, branchName string, parentBranch string, parentCommit string) (*pfs.Commit, error) {
+// The commit's parent is parent rather than the previous head of the branch.
+// parent specifies the parent Commit, which may be any finished commit in the
+// repo (not just the head of branch), so this can be used to fork history.
+// Upon creation the new Commit will appear identical to the parent Commit, data
+// can safely be added to the new commit without affecting the contents of the
+// parent Commit.
+func (c APIClient) StartCommitParent(repoName string, branchName string, parent *pfs.Commit) (*pfs.Commit, error) {
 	commit, err := c.PfsAPIClient.StartCommit(
 		c.Ctx(),
 		&pfs.StartCommitRequest{
-			Parent: NewCommit(repoName, parentBranch, parentCommit),
+			Parent: parent,
 			Branch: NewBranch(repoName, branchName),
 		},
 	)
@@ -247,8 +277,8 @@ func (c APIClient) ListCommit(repo *pfs.Repo, to, from *pfs.Commit, number int64
 // itself, are considered.
 // If `to` and `from` are the same commit, no commits will be returned.
 // `number` determines how many commits are returned.  If `number` is 0,
-// `reverse` lists the commits from oldest to newest, rather than newest to oldest
 // all commits that match the aforementioned criteria are passed to f.
+// `reverse` lists the commits from oldest to newest, rather than newest to oldest.
 func (c APIClient) ListCommitF(repo *pfs.Repo, to, from *pfs.Commit, number int64, reverse bool, f func(*pfs.CommitInfo) error) error {
 	req := &pfs.ListCommitRequest{
 		Repo:    repo,
@@ -285,6 +315,20 @@ func (c APIClient) ListCommitByRepo(repo *pfs.Repo) ([]*pfs.CommitInfo, error) {
 	return c.ListCommit(repo, nil, nil, 0)
 }
 
+// ListCommitByCommitSet lists the commits in a repo that belong to the
+// commitset with the given ID.
+func (c APIClient) ListCommitByCommitSet(repo *pfs.Repo, commitSetID string) (_ []*pfs.CommitInfo, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	client, err := c.PfsAPIClient.ListCommit(c.Ctx(), &pfs.ListCommitRequest{
+		Repo:      repo,
+		CommitSet: NewCommitSet(commitSetID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clientsdk.ListCommit(client)
+}
+
 // CreateBranch creates a new branch
 func (c APIClient) CreateBranch(repoName string, branchName string, commitBranch string, commitID string, provenance []*pfs.Branch) error {
 	var head *pfs.Commit
@@ -332,6 +376,48 @@ func (c APIClient) InspectBranch(repoName string, branchName string) (*pfs.Branc
 	return branchInfo, grpcutil.ScrubGRPC(err)
 }
 
+// InspectBranchProvenance returns every branch upstream of 'branch'
+// (provenance) and every branch downstream of it (subvenance). Both lists are
+// in topological order: each branch comes after all of the branches it's
+// provenant on.
+func (c APIClient) InspectBranchProvenance(branch *pfs.Branch) (provenance, subvenance []*pfs.Branch, err error) {
+	branchInfo, err := c.PfsAPIClient.InspectBranch(c.Ctx(), &pfs.InspectBranchRequest{Branch: branch})
+	if err != nil {
+		return nil, nil, grpcutil.ScrubGRPC(err)
+	}
+	if provenance, err = c.sortBranchesTopologically(branchInfo.Provenance); err != nil {
+		return nil, nil, err
+	}
+	if subvenance, err = c.sortBranchesTopologically(branchInfo.Subvenance); err != nil {
+		return nil, nil, err
+	}
+	return provenance, subvenance, nil
+}
+
+// sortBranchesTopologically sorts branches so that each one comes after the
+// branches it's provenant on. A branch's full provenance contains the full
+// provenance of every branch upstream of it, so sorting by the size of each
+// branch's provenance is enough.
+func (c APIClient) sortBranchesTopologically(branches []*pfs.Branch) ([]*pfs.Branch, error) {
+	provenanceSize := make(map[string]int)
+	for _, branch := range branches {
+		branchInfo, err := c.PfsAPIClient.InspectBranch(c.Ctx(), &pfs.InspectBranchRequest{Branch: branch})
+		if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		provenanceSize[branch.String()] = len(branchInfo.Provenance)
+	}
+	result := append([]*pfs.Branch(nil), branches...)
+	sort.SliceStable(result, func(i, j int) bool {
+		si, sj := provenanceSize[result[i].String()], provenanceSize[result[j].String()]
+		if si != sj {
+			return si < sj
+		}
+		return result[i].String() < result[j].String()
+	})
+	return result, nil
+}
+
 // ListBranch lists the active branches on a Repo.
 func (c APIClient) ListBranch(repoName string) ([]*pfs.BranchInfo, error) {
 	ctx, cf := context.WithCancel(c.Ctx())
@@ -408,9 +494,30 @@ func (c APIClient) InspectCommitSet(id string) (_ []*pfs.CommitInfo, retErr erro
 }
 
 // WaitCommitSetAll blocks until all of a CommitSet's commits are finished.  To
-// wait for an individual commit, use WaitCommit instead.
-func (c APIClient) WaitCommitSetAll(id string) (_ []*pfs.CommitInfo, retErr error) {
+// wait for an individual commit, use WaitCommit instead. Options such as
+// WithoutRepoTypes exclude commits from both the wait and the result.
+func (c APIClient) WaitCommitSetAll(id string, opts ...WaitCommitSetOption) (_ []*pfs.CommitInfo, retErr error) {
 	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	if len(opts) > 0 {
+		config := &waitCommitSetConfig{}
+		for _, opt := range opts {
+			opt(config)
+		}
+		commitInfos, err := c.InspectCommitSet(id)
+		if err != nil {
+			return nil, err
+		}
+		var repos []*pfs.Repo
+		for _, ci := range commitInfos {
+			if !config.excluded(ci.Commit.Branch.Repo) {
+				repos = append(repos, ci.Commit.Branch.Repo)
+			}
+		}
+		if len(repos) == 0 {
+			return []*pfs.CommitInfo{}, nil
+		}
+		return c.WaitCommitSetState(id, repos, pfs.CommitState_FINISHED)
+	}
 	result := []*pfs.CommitInfo{}
 	if err := c.WaitCommitSet(id, func(ci *pfs.CommitInfo) error {
 		result = append(result, ci)
@@ -432,6 +539,137 @@ func (c APIClient) WaitCommitSet(id string, cb func(*pfs.CommitInfo) error) (ret
 	return nil
 }
 
+// WaitCommitSetState blocks until the commits in a CommitSet to each of the
+// given repos have reached 'state', and returns them in the same order as
+// 'repos'. If 'repos' is empty, it waits for all of the CommitSet's commits.
+// Use WithCtx to bound how long WaitCommitSetState may block.
+func (c APIClient) WaitCommitSetState(id string, repos []*pfs.Repo, state pfs.CommitState) (_ []*pfs.CommitInfo, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	if len(repos) == 0 {
+		commitInfos, err := c.InspectCommitSet(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, ci := range commitInfos {
+			repos = append(repos, ci.Commit.Branch.Repo)
+		}
+	}
+	result := []*pfs.CommitInfo{}
+	for _, repo := range repos {
+		commitInfo, err := c.PfsAPIClient.InspectCommit(
+			c.Ctx(),
+			&pfs.InspectCommitRequest{
+				Commit: repo.NewCommit("", id),
+				Wait:   state,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, commitInfo)
+	}
+	return result, nil
+}
+
+// InspectCommitSetGraph returns info about a specific CommitSet, including the
+// provenance edges among its commits.
+func (c APIClient) InspectCommitSetGraph(id string) (_ *pfs.CommitSetInfo, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	commitInfos, err := c.InspectCommitSet(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.CommitSetInfo{
+		CommitSet: NewCommitSet(id),
+		Commits:   commitInfos,
+		Edges:     pfs.CommitSetEdges(commitInfos),
+	}, nil
+}
+
+// The overall states of a CommitSet, as reported by InspectCommitSetState.
+const (
+	CommitSetStateRunning = "RUNNING"
+	CommitSetStateSuccess = "SUCCESS"
+	CommitSetStateFailure = "FAILURE"
+)
+
+// CommitSetState summarizes the progress of a CommitSet's commits.
+type CommitSetState struct {
+	// State is CommitSetStateFailure if any of the CommitSet's commits finished
+	// with an error, CommitSetStateRunning if any are still open, and
+	// CommitSetStateSuccess otherwise.
+	State string
+	// Finished and Open are the numbers of the CommitSet's commits that are
+	// finished and still open, respectively.
+	Finished int
+	Open     int
+}
+
+// InspectCommitSetState returns the overall state of a CommitSet, without
+// waiting for its commits to finish. It's intended for polling; use
+// WaitCommitSetAll to block until a CommitSet is finished.
+func (c APIClient) InspectCommitSetState(id string) (*CommitSetState, error) {
+	commitInfos, err := c.InspectCommitSet(id)
+	if err != nil {
+		return nil, err
+	}
+	result := &CommitSetState{State: CommitSetStateSuccess}
+	var failed bool
+	for _, ci := range commitInfos {
+		if ci.Finished == nil {
+			result.Open++
+			continue
+		}
+		result.Finished++
+		if ci.Error != "" {
+			failed = true
+		}
+	}
+	switch {
+	case failed:
+		result.State = CommitSetStateFailure
+	case result.Open > 0:
+		result.State = CommitSetStateRunning
+	}
+	return result, nil
+}
+
+// ListCommitsetsByRepo returns info about the CommitSets that include a commit
+// in the given repo, newest first. The commits in each CommitSetInfo carry the
+// state of the CommitSet. `limit` determines how many CommitSets are returned;
+// if `limit` is 0, all of them are returned.
+func (c APIClient) ListCommitsetsByRepo(repoName string, limit int) (_ []*pfs.CommitSetInfo, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	var ids []string
+	seen := make(map[string]struct{})
+	if err := c.ListCommitF(NewRepo(repoName), nil, nil, 0, false, func(ci *pfs.CommitInfo) error {
+		if _, ok := seen[ci.Commit.ID]; ok {
+			return nil
+		}
+		seen[ci.Commit.ID] = struct{}{}
+		ids = append(ids, ci.Commit.ID)
+		if limit > 0 && len(ids) >= limit {
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	result := []*pfs.CommitSetInfo{}
+	for _, id := range ids {
+		commits, err := c.InspectCommitSet(id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &pfs.CommitSetInfo{
+			CommitSet: NewCommitSet(id),
+			Commits:   commits,
+			Edges:     pfs.CommitSetEdges(commits),
+		})
+	}
+	return result, nil
+}
+
 // SquashCommitSet squashes the commits of a CommitSet into their children.
 func (c APIClient) SquashCommitSet(id string) error {
 	_, err := c.PfsAPIClient.SquashCommitSet(
@@ -454,6 +692,19 @@ func (c APIClient) DropCommitSet(id string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// DeleteCommit deletes a single commit, reparenting its children onto its
+// parent. Unlike SquashCommitSet, the other commits in the commit's CommitSet
+// are left in place, so it fails if any of them are downstream of the commit.
+func (c APIClient) DeleteCommit(commit *pfs.Commit) error {
+	_, err := c.PfsAPIClient.DeleteCommit(
+		c.Ctx(),
+		&pfs.DeleteCommitRequest{
+			Commit: commit,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // SubscribeCommit is like ListCommit but it keeps listening for commits as
 // they come in.
 func (c APIClient) SubscribeCommit(repo *pfs.Repo, branchName string, from string, state pfs.CommitState, cb func(*pfs.CommitInfo) error) (retErr error) {
@@ -508,7 +759,18 @@ func (c APIClient) ClearCommit(repoName string, branchName string, commitID stri
 // prevent the completion of fsck. Errors that do prevent completion will be
 // returned from the function.
 func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
-	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), &pfs.FsckRequest{Fix: fix})
+	return c.fsck(&pfs.FsckRequest{Fix: fix}, cb)
+}
+
+// FsckRepo performs the same checks as Fsck, but only reports (and, if fix is
+// set, fixes) the problems in the given repo. Each problem identifies the
+// commit it relates to, where there is one.
+func (c APIClient) FsckRepo(repoName string, fix bool, cb func(*pfs.FsckResponse) error) error {
+	return c.fsck(&pfs.FsckRequest{Fix: fix, Repo: NewRepo(repoName)}, cb)
+}
+
+func (c APIClient) fsck(req *pfs.FsckRequest, cb func(*pfs.FsckResponse) error) error {
+	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
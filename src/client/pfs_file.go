@@ -2,6 +2,7 @@ package client
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"io"
 	"io/ioutil"
@@ -9,11 +10,13 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	globlib "github.com/pachyderm/ohmyglob"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 )
 
 // PutFile puts a file into PFS from a reader.
@@ -47,7 +50,10 @@ func (c APIClient) DeleteFile(commit *pfs.Commit, path string, opts ...DeleteFil
 }
 
 // CopyFile copies a file from one PFS location to another.
-// It can be used on directories or regular files.
+// It can be used on directories or regular files. The copy is done by pachd,
+// which references the existing content rather than transferring it, so it
+// works within or across repos without sending the data through the client.
+// It returns an error if srcPath doesn't exist in srcCommit.
 func (c APIClient) CopyFile(dstCommit *pfs.Commit, dstPath string, srcCommit *pfs.Commit, srcPath string, opts ...CopyFileOption) error {
 	return c.WithModifyFileClient(dstCommit, func(mf ModifyFile) error {
 		return mf.CopyFile(dstPath, srcCommit.NewFile(srcPath), opts...)
@@ -58,6 +64,9 @@ func (c APIClient) CopyFile(dstCommit *pfs.Commit, dstPath string, srcCommit *pf
 // The modifications are not persisted until the ModifyFileClient is closed.
 // ModifyFileClient is not thread safe. Multiple ModifyFileClients
 // should be used for concurrent modifications.
+// Puts, deletes and copies can be mixed in one stream, and are applied in the
+// order they're made, so e.g. a delete followed by a put of the same path
+// leaves the new file. CopyFile reads its source as it was before the stream.
 type ModifyFile interface {
 	// PutFile puts a file into PFS from a reader.
 	PutFile(path string, r io.Reader, opts ...PutFileOption) error
@@ -446,17 +455,94 @@ func (c APIClient) GetFile(commit *pfs.Commit, path string, w io.Writer, opts ..
 	return nil
 }
 
+// GetFileCompressed is like GetFile, but writes the file's contents to 'w'
+// compressed, so that fewer bytes are transferred (and the caller may store
+// them as-is, or decompress them lazily). pachd recompresses the contents for
+// each request, rather than sending the bytes it stores. It returns the codec
+// the contents were written in.
+func (c APIClient) GetFileCompressed(commit *pfs.Commit, path string, w io.Writer, opts ...GetFileOption) (_ pfs.Compression, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	gf := &pfs.GetFileRequest{
+		File: &pfs.File{
+			Commit: commit,
+			Path:   path,
+		},
+		Compression: pfs.Compression_GZIP,
+	}
+	for _, opt := range opts {
+		opt(gf)
+	}
+	gfc, err := c.PfsAPIClient.GetFile(ctx, gf)
+	if err != nil {
+		return 0, err
+	}
+	for m, err := gfc.Recv(); err != io.EOF; m, err = gfc.Recv() {
+		if err != nil {
+			return 0, err
+		}
+		if _, err := w.Write(m.Value); err != nil {
+			return 0, err
+		}
+	}
+	header, err := gfc.Header()
+	if err != nil {
+		return 0, err
+	}
+	codecs := header.Get(pfs.CompressionHeader)
+	if len(codecs) == 0 {
+		return 0, errors.Errorf("pachd didn't report the file's compression")
+	}
+	codec, ok := pfs.Compression_value[codecs[0]]
+	if !ok {
+		return 0, errors.Errorf("unrecognized compression %q", codecs[0])
+	}
+	return pfs.Compression(codec), nil
+}
+
+// GetFileRange writes at most length bytes of the file at path, starting at
+// offset, to w. Only the storage chunks covering the range are fetched.
+func (c APIClient) GetFileRange(commit *pfs.Commit, path string, offset, length int64, w io.Writer, opts ...GetFileOption) error {
+	if offset < 0 || length <= 0 {
+		return errors.Errorf("invalid range: offset %d, length %d", offset, length)
+	}
+	return c.GetFile(commit, path, w, append(opts, WithOffset(offset), WithSizeBytes(length))...)
+}
+
+// GetFileIfExists is like GetFile, but returns false rather than an error if
+// the file doesn't exist in the commit. It returns true once the file's
+// contents have been written to 'w'.
+func (c APIClient) GetFileIfExists(commit *pfs.Commit, path string, w io.Writer, opts ...GetFileOption) (bool, error) {
+	if err := c.GetFile(commit, path, w, opts...); err != nil {
+		if pfsserver.IsFileNotFoundErr(err) && !pfsserver.IsCommitNotFoundErr(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // GetFileTAR gets a tar file from PFS.
 func (c APIClient) GetFileTAR(commit *pfs.Commit, path string) (io.ReadCloser, error) {
-	return c.getFileTar(commit, path)
+	return c.getFileTar(commit, path, pfs.Compression_UNCOMPRESSED)
+}
+
+// GetFileTARCompressed is like GetFileTAR, but the tar stream is gzip
+// compressed, which is useful for downloading a whole directory in one call.
+func (c APIClient) GetFileTARCompressed(commit *pfs.Commit, path string) (io.ReadCloser, error) {
+	return c.getFileTar(commit, path, pfs.Compression_GZIP)
 }
 
-func (c APIClient) getFileTar(commit *pfs.Commit, path string) (_ io.ReadCloser, retErr error) {
+func (c APIClient) getFileTar(commit *pfs.Commit, path string, compression pfs.Compression) (_ io.ReadCloser, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
-		File: commit.NewFile(path),
+		File:        commit.NewFile(path),
+		Compression: compression,
 	}
 	ctx, cf := context.WithCancel(c.Ctx())
 	client, err := c.PfsAPIClient.GetFileTAR(ctx, req)
@@ -470,7 +556,7 @@ func (c APIClient) getFileTar(commit *pfs.Commit, path string) (_ io.ReadCloser,
 // GetFileReader gets a reader for the specified path
 // TODO: This should probably be an io.ReadCloser so we can close the rpc if the full file isn't read.
 func (c APIClient) GetFileReader(commit *pfs.Commit, path string) (io.Reader, error) {
-	r, err := c.getFileTar(commit, path)
+	r, err := c.getFileTar(commit, path, pfs.Compression_UNCOMPRESSED)
 	if err != nil {
 		return nil, err
 	}
@@ -577,6 +663,22 @@ func (c APIClient) InspectFile(commit *pfs.Commit, path string) (_ *pfs.FileInfo
 	return fi, err
 }
 
+// InspectFileDigests returns info about a specific file, including digests of
+// its content computed with each of the given algorithms ("md5", "sha256").
+func (c APIClient) InspectFileDigests(commit *pfs.Commit, path string, algorithms ...string) (_ *pfs.FileInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	fi, err := c.PfsAPIClient.InspectFile(
+		c.Ctx(),
+		&pfs.InspectFileRequest{
+			File:    commit.NewFile(path),
+			Digests: algorithms,
+		},
+	)
+	return fi, err
+}
+
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
 func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
@@ -623,9 +725,53 @@ func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileIn
 	return fis, nil
 }
 
+// ListFilePage returns one page of the files in a Commit under path. Pass an
+// empty pageToken to get the first page, and the returned nextToken to get
+// the page after it; nextToken is empty once there are no more files. The
+// token records where the page ended, so fetching the next page doesn't
+// rescan the earlier ones. If pageSize is zero, all of the files are returned.
+func (c APIClient) ListFilePage(commit *pfs.Commit, path string, pageToken string, pageSize int64) (_ []*pfs.FileInfo, nextToken string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pfs.ListFileRequest{
+		File:      commit.NewFile(path),
+		PageToken: pageToken,
+	}
+	if pageSize > 0 {
+		// Request one extra file, to learn whether there's another page.
+		req.PageSize = pageSize + 1
+	}
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	client, err := c.PfsAPIClient.ListFile(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	var fis []*pfs.FileInfo
+	for {
+		fi, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, "", err
+		}
+		fis = append(fis, fi)
+	}
+	if pageSize > 0 && int64(len(fis)) > pageSize {
+		fis = fis[:pageSize]
+		nextToken = fis[len(fis)-1].File.Path
+	}
+	return fis, nextToken, nil
+}
+
 // GlobFile returns files that match a given glob pattern in a given commit,
 // calling cb with each FileInfo. The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
+// The pattern is matched by pachd and the results are streamed, so they are
+// never all held in memory. If cb returns errutil.ErrBreak, GlobFile stops
+// and returns nil.
 func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
@@ -728,7 +874,112 @@ func (c APIClient) DiffFileAll(newCommit *pfs.Commit, newPath string, oldCommit
 	return newFis, oldFis, nil
 }
 
-// WalkFile walks the files under path.
+// DiffFilePathsF calls 'cb' with each file under 'path' that's in only one of
+// oldCommit and newCommit, or whose contents differ between them, as it's
+// found: 'added' is true for the file's version in newCommit and false for its
+// version in oldCommit. 'path' may exist in just one of the commits. If
+// oldCommit is nil, the parent of newCommit is used.
+func (c APIClient) DiffFilePathsF(oldCommit, newCommit *pfs.Commit, path string, cb func(fi *pfs.FileInfo, added bool) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	isFile := func(fi *pfs.FileInfo) bool {
+		return fi != nil && fi.FileType == pfs.FileType_FILE
+	}
+	return c.DiffFile(newCommit, path, oldCommit, path, false, func(newFi, oldFi *pfs.FileInfo) error {
+		if isFile(newFi) && isFile(oldFi) && bytes.Equal(newFi.Hash, oldFi.Hash) {
+			return nil
+		}
+		if isFile(oldFi) {
+			if err := cb(oldFi, false); err != nil {
+				return err
+			}
+		}
+		if isFile(newFi) {
+			return cb(newFi, true)
+		}
+		return nil
+	})
+}
+
+// DiffFilePaths returns the files under 'path' that were added in newCommit
+// and deleted from oldCommit (see DiffFilePathsF). A file whose contents
+// changed is both deleted (its old version) and added (its new version).
+func (c APIClient) DiffFilePaths(oldCommit, newCommit *pfs.Commit, path string) (added, deleted []*pfs.FileInfo, _ error) {
+	if err := c.DiffFilePathsF(oldCommit, newCommit, path, func(fi *pfs.FileInfo, isAdded bool) error {
+		if isAdded {
+			added = append(added, fi)
+		} else {
+			deleted = append(deleted, fi)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return added, deleted, nil
+}
+
+// CommitDiffStats summarizes the differences between the files of two commits.
+type CommitDiffStats struct {
+	FilesAdded    int64
+	FilesModified int64
+	FilesDeleted  int64
+	// BytesAdded is the size of the added files plus the growth of the
+	// modified files that got larger.
+	BytesAdded int64
+	// BytesRemoved is the size of the deleted files plus the shrinkage of the
+	// modified files that got smaller.
+	BytesRemoved int64
+}
+
+// GetCommitDiffStats returns a summary of the differences between the files
+// matching glob in oldCommit and newCommit, without transferring their
+// contents. An empty glob matches every file. If oldCommit is nil, the parent
+// of newCommit is used.
+func (c APIClient) GetCommitDiffStats(oldCommit, newCommit *pfs.Commit, glob string) (_ *CommitDiffStats, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	match := func(string) bool { return true }
+	if glob != "" {
+		g, err := globlib.Compile(glob, '/')
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		match = g.Match
+	}
+	isFile := func(fi *pfs.FileInfo) bool {
+		return fi != nil && fi.FileType == pfs.FileType_FILE && match(fi.File.Path)
+	}
+	stats := &CommitDiffStats{}
+	if err := c.DiffFile(newCommit, "/", oldCommit, "/", false, func(newFi, oldFi *pfs.FileInfo) error {
+		switch {
+		case isFile(newFi) && isFile(oldFi):
+			stats.FilesModified++
+			if delta := newFi.SizeBytes - oldFi.SizeBytes; delta > 0 {
+				stats.BytesAdded += delta
+			} else {
+				stats.BytesRemoved -= delta
+			}
+		case isFile(newFi):
+			stats.FilesAdded++
+			stats.BytesAdded += newFi.SizeBytes
+		case isFile(oldFi):
+			stats.FilesDeleted++
+			stats.BytesRemoved += oldFi.SizeBytes
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// WalkFile walks the files under path, recursively calling cb with each file
+// and directory, in lexical order, starting with path itself. Directories are
+// visited before their contents. Returning errutil.ErrBreak from cb stops the
+// walk without an error. PFS doesn't store symlinks (the worker uploads the
+// files a symlink points to), so there are none to follow.
 func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileInfo) error) (retErr error) {
 	client, err := c.PfsAPIClient.WalkFile(
 		c.Ctx(),
@@ -2,9 +2,16 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
+	"path"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
@@ -12,7 +19,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
+	"github.com/robfig/cron"
 )
 
 const (
@@ -66,11 +75,35 @@ const (
 	// DatumIDEnv is an env var that is added to the environment of user
 	// pipelined code and indicates the id of the datum.
 	DatumIDEnv = "PACH_DATUM_ID"
+	// DatumMemoryLimitEnv is an env var that is added to the environment of
+	// user pipeline code when the pipeline sets datum_memory_scaling, and
+	// indicates the memory (in bytes) that the datum is allowed to use.
+	DatumMemoryLimitEnv = "PACH_DATUM_MEMORY_LIMIT"
+	// OutputDescriptionFile is a file that user pipeline code can write to the
+	// root of /pfs/out to set the description of the job's output commit. If
+	// several datums write it, their contents are concatenated. The file is
+	// removed from the output before the commit is finished.
+	OutputDescriptionFile = ".pach_description"
+	// OutputErrorFile is a file that user pipeline code can write to the root
+	// of /pfs/out to fail the job once all of its datums are processed. Its
+	// contents are the reason the job failed, and are set as the output
+	// commit's error. The file is removed from the output.
+	OutputErrorFile = ".pach_error"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
 
 	ReprocessSpecUntilSuccess = "until_success"
 	ReprocessSpecEveryJob     = "every_job"
+
+	// CronFormatRFC3339 and CronFormatUnix are the named formats a cron
+	// input can write its tick times in. Any other format is treated as a Go
+	// time layout string.
+	CronFormatRFC3339 = "rfc3339"
+	CronFormatUnix    = "unix"
+
+	// FailureReportBranch is the branch of a pipeline's output repo that
+	// failure reports are written to.
+	FailureReportBranch = "failure_report"
 )
 
 // NewJob creates a pps.Job.
@@ -134,6 +167,10 @@ func NewCrossInput(input ...*pps.Input) *pps.Input {
 // NewJoinInput returns an input which is the join of other inputs.
 // That means that all combination of datums which match on `joinOn` will be seen by the job /
 // pipeline.
+// Any number of inputs may be joined: a key only produces datums if every input
+// (other than those with `outerJoin` set) has a file matching it. If an input
+// has several files with the same key, each of them is crossed with the
+// matching files from the other inputs.
 func NewJoinInput(input ...*pps.Input) *pps.Input {
 	return &pps.Input{
 		Join: input,
@@ -149,6 +186,16 @@ func NewUnionInput(input ...*pps.Input) *pps.Input {
 	}
 }
 
+// NewDedupUnionInput returns a union input that processes datums whose files
+// have the same paths once, rather than once for each input producing them.
+// The collapsed datums are left out of the job, rather than being skipped.
+func NewDedupUnionInput(input ...*pps.Input) *pps.Input {
+	return &pps.Input{
+		Union: input,
+		Dedup: true,
+	}
+}
+
 // NewGroupInput returns an input which groups the inputs by the GroupBy pattern.
 // That means that it will return a datum for each group of input datums matching
 // a particular GroupBy pattern
@@ -265,6 +312,20 @@ func (c APIClient) InspectJobSet(id string, details bool) (_ []*pps.JobInfo, ret
 	return result, nil
 }
 
+// InspectJobSetInfo returns info about the jobs in the job set 'id' along
+// with their aggregate state, which is FAILURE if any job failed, RUNNING if
+// any job is still in progress and SUCCESS only if every job succeeded.
+func (c APIClient) InspectJobSetInfo(id string, details bool) (_ *pps.JobSetInfo, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	return c.PpsAPIClient.InspectJobSetInfo(
+		c.Ctx(),
+		&pps.InspectJobSetRequest{
+			JobSet:  NewJobSet(id),
+			Details: details,
+		},
+	)
+}
+
 func (c APIClient) WaitJobSetAll(id string, details bool) (_ []*pps.JobInfo, retErr error) {
 	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
 	result := []*pps.JobInfo{}
@@ -308,7 +369,8 @@ func (c APIClient) ListJob(pipelineName string, inputCommit []*pfs.Commit, histo
 }
 
 // ListJobF is a previous version of ListJobFilterF, returning info about all jobs
-// and calling f on each JobInfo
+// and calling f on each JobInfo as it is received. If f returns
+// errutil.ErrBreak, iteration stops early and ListJobF returns nil.
 func (c APIClient) ListJobF(pipelineName string, inputCommit []*pfs.Commit,
 	history int64, details bool,
 	f func(*pps.JobInfo) error) error {
@@ -367,17 +429,115 @@ func (c APIClient) ListJobFilterF(pipelineName string, inputCommit []*pfs.Commit
 	}
 }
 
+// ListJobsFiltered returns info about the jobs of all pipelines, newest first,
+// whose state is one of 'states' and which were created between 'since' and
+// 'until'. An empty 'states' matches every state, and a zero 'since' or
+// 'until' leaves that end of the window open.
+// At most 'limit' jobs are returned, or all of them if 'limit' is 0. If more
+// jobs match, a cursor is also returned which can be passed as 'cursor' to
+// list the next page of jobs. The cursor names the page's last job and its
+// creation time, so the next page starts at the jobs created before it even if
+// that job has since been deleted.
+func (c APIClient) ListJobsFiltered(states []pps.JobState, since, until time.Time, limit int, cursor string) (_ []*pps.JobInfo, _ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var cursorJob *pps.Job
+	var cursorCreated time.Time
+	if cursor != "" {
+		var err error
+		if cursorJob, cursorCreated, err = parseJobsCursor(cursor); err != nil {
+			return nil, "", err
+		}
+	}
+	var result []*pps.JobInfo
+	var next string
+	if err := c.ListJobF("", nil, -1, false, func(ji *pps.JobInfo) error {
+		created, err := types.TimestampFromProto(ji.Created)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if cursorJob != nil {
+			// Skip the jobs up to and including the cursor's job
+			if ji.Job.Pipeline.Name == cursorJob.Pipeline.Name && ji.Job.ID == cursorJob.ID {
+				cursorJob = nil
+				return nil
+			}
+			if !created.Before(cursorCreated) {
+				return nil
+			}
+			cursorJob = nil
+		}
+		if len(states) > 0 && !containsJobState(states, ji.State) {
+			return nil
+		}
+		if (!since.IsZero() && created.Before(since)) || (!until.IsZero() && created.After(until)) {
+			return nil
+		}
+		if limit > 0 && len(result) == limit {
+			last := result[len(result)-1]
+			lastCreated, err := types.TimestampFromProto(last.Created)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			next = fmt.Sprintf("%s@%s@%s", last.Job.Pipeline.Name, last.Job.ID, lastCreated.Format(time.RFC3339Nano))
+			return errutil.ErrBreak
+		}
+		result = append(result, ji)
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+	return result, next, nil
+}
+
+// parseJobsCursor parses a cursor returned by ListJobsFiltered, of the form
+// pipeline@id@created.
+func parseJobsCursor(cursor string) (*pps.Job, time.Time, error) {
+	parts := strings.SplitN(cursor, "@", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return nil, time.Time{}, errors.Errorf("invalid job cursor %q", cursor)
+	}
+	created, err := time.Parse(time.RFC3339Nano, parts[2])
+	if err != nil {
+		return nil, time.Time{}, errors.Wrapf(err, "invalid job cursor %q", cursor)
+	}
+	return NewJob(parts[0], parts[1]), created, nil
+}
+
+func containsJobState(states []pps.JobState, state pps.JobState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
 // SubscribeJob calls the given callback with each open job in the given
 // pipeline until canceled.
 func (c APIClient) SubscribeJob(pipelineName string, details bool, cb func(*pps.JobInfo) error) error {
+	return c.subscribeJob(&pps.SubscribeJobRequest{
+		Pipeline: NewPipeline(pipelineName),
+		Details:  details,
+	}, cb)
+}
+
+// SubscribeJobTransitions calls the given callback each time a job in the
+// given pipeline is created or changes state, starting with the current state
+// of the pipeline's existing jobs. Return errutil.ErrBreak from the callback
+// to stop the subscription.
+func (c APIClient) SubscribeJobTransitions(pipelineName string, cb func(*pps.JobInfo) error) error {
+	return c.subscribeJob(&pps.SubscribeJobRequest{
+		Pipeline:    NewPipeline(pipelineName),
+		Transitions: true,
+	}, cb)
+}
+
+func (c APIClient) subscribeJob(req *pps.SubscribeJobRequest, cb func(*pps.JobInfo) error) error {
 	ctx, cf := context.WithCancel(c.Ctx())
 	defer cf()
-	client, err := c.PpsAPIClient.SubscribeJob(
-		ctx,
-		&pps.SubscribeJobRequest{
-			Pipeline: NewPipeline(pipelineName),
-			Details:  details,
-		})
+	client, err := c.PpsAPIClient.SubscribeJob(ctx, req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -444,6 +604,18 @@ func (c APIClient) ListDatum(pipelineName string, jobID string, cb func(*pps.Dat
 	return c.listDatum(req, cb)
 }
 
+// ListDatumFromFileset returns info about the datums recorded in a job's datum
+// file set, as returned in JobInfo.Details.DatumFilesetId by InspectJob.
+func (c APIClient) ListDatumFromFileset(fsID string, cb func(*pps.DatumInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pps.ListDatumRequest{
+		DatumFilesetId: fsID,
+	}
+	return c.listDatum(req, cb)
+}
+
 // ListDatumAll returns info about datums in a job.
 func (c APIClient) ListDatumAll(pipelineName string, jobID string) (_ []*pps.DatumInfo, retErr error) {
 	defer func() {
@@ -459,6 +631,41 @@ func (c APIClient) ListDatumAll(pipelineName string, jobID string) (_ []*pps.Dat
 	return dis, nil
 }
 
+// DatumDistribution describes the datums that a single worker processed for a
+// job.
+type DatumDistribution struct {
+	// Datums is the number of datums the worker processed.
+	Datums int64
+	// Bytes is the total size of the input data of those datums.
+	Bytes int64
+}
+
+// InspectJobDatumDistribution returns how the datums processed by a job were
+// distributed across the pipeline's workers, keyed by worker ID. Datums which
+// the job skipped aren't included.
+func (c APIClient) InspectJobDatumDistribution(pipelineName string, jobID string) (_ map[string]*DatumDistribution, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	distribution := make(map[string]*DatumDistribution)
+	if err := c.ListDatum(pipelineName, jobID, func(di *pps.DatumInfo) error {
+		if di.WorkerID == "" {
+			return nil
+		}
+		d, ok := distribution[di.WorkerID]
+		if !ok {
+			d = &DatumDistribution{}
+			distribution[di.WorkerID] = d
+		}
+		d.Datums++
+		d.Bytes += di.Stats.GetDownloadBytes()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return distribution, nil
+}
+
 // ListDatumInput returns info about datums for a pipeline with input. The
 // pipeline doesn't need to exist.
 func (c APIClient) ListDatumInput(input *pps.Input, cb func(*pps.DatumInfo) error) (retErr error) {
@@ -487,6 +694,26 @@ func (c APIClient) ListDatumInputAll(input *pps.Input) (_ []*pps.DatumInfo, retE
 	return dis, nil
 }
 
+// GetDatumCount returns the number of datums that a pipeline with the
+// given input would produce against the current heads of its input branches.
+// If input is nil, the input of the named pipeline is used.
+func (c APIClient) GetDatumCount(pipelineName string, input *pps.Input) (_ int64, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pps.GetDatumCountRequest{
+		Input: input,
+	}
+	if pipelineName != "" {
+		req.Pipeline = NewPipeline(pipelineName)
+	}
+	resp, err := c.PpsAPIClient.GetDatumCount(c.Ctx(), req)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
 func (c APIClient) listDatum(req *pps.ListDatumRequest, cb func(*pps.DatumInfo) error) (retErr error) {
 	ctx, cf := context.WithCancel(c.Ctx())
 	defer cf()
@@ -528,6 +755,118 @@ func (c APIClient) InspectDatum(pipelineName string, jobID string, datumID strin
 	return datumInfo, nil
 }
 
+// InspectDatumByPath returns info about the datum in a job whose inputs
+// include every one of 'paths'. As with the data filters accepted by GetLogs,
+// each path may also be given as the hex-encoded hash of an input file.
+func (c APIClient) InspectDatumByPath(pipelineName string, jobID string, paths []string) (*pps.DatumInfo, error) {
+	if len(paths) == 0 {
+		return nil, errors.Errorf("at least one path must be given")
+	}
+	var result *pps.DatumInfo
+	if err := c.ListDatum(pipelineName, jobID, func(di *pps.DatumInfo) error {
+		if datumHasPaths(di, paths) {
+			result = di
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.Errorf("no datum in job %s has inputs %v", jobID, paths)
+	}
+	return result, nil
+}
+
+// datumHasPaths returns true if each of 'paths' is the path (or hex-encoded
+// hash) of one of the datum's input files.
+func datumHasPaths(di *pps.DatumInfo, paths []string) bool {
+nextPath:
+	for _, p := range paths {
+		for _, fi := range di.Data {
+			if path.Clean("/"+p) == path.Clean("/"+fi.File.Path) || p == hex.EncodeToString(fi.Hash) {
+				continue nextPath
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// FileProvenance traces a file in a pipeline's output back to the datum that
+// wrote it. Datum.Data holds the input files the datum consumed.
+type FileProvenance struct {
+	File  *pfs.FileInfo
+	Datum *pps.DatumInfo
+}
+
+// InspectFileProvenance returns the datum that wrote the file at 'path' in
+// 'outputCommit', a commit in a pipeline's output repo, along with the input
+// files that datum consumed.
+func (c APIClient) InspectFileProvenance(outputCommit *pfs.Commit, path string) (*FileProvenance, error) {
+	commitInfo, err := c.InspectCommit(outputCommit.Branch.Repo.Name, outputCommit.Branch.Name, outputCommit.ID)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo, err := c.InspectFile(commitInfo.Commit, path)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.FileType != pfs.FileType_FILE {
+		return nil, errors.Errorf("%q is not a file", path)
+	}
+	// Files written by a datum are tagged with the datum's ID
+	datumInfo, err := c.InspectDatum(commitInfo.Commit.Branch.Repo.Name, commitInfo.Commit.ID, fileInfo.File.Datum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find the datum %q that wrote %q", fileInfo.File.Datum, path)
+	}
+	return &FileProvenance{File: fileInfo, Datum: datumInfo}, nil
+}
+
+// DatumSkipReason describes why a job skipped a datum.
+type DatumSkipReason int
+
+const (
+	// DatumNotSkipped means the job didn't skip the datum.
+	DatumNotSkipped DatumSkipReason = iota
+	// DatumSkipUnchanged means the datum's inputs were unchanged since an
+	// earlier job, which processed it.
+	DatumSkipUnchanged
+	// DatumSkipQuarantined means the datum failed in too many consecutive
+	// jobs of a pipeline with QuarantineAfter set.
+	DatumSkipQuarantined
+)
+
+func (r DatumSkipReason) String() string {
+	switch r {
+	case DatumNotSkipped:
+		return "NOT_SKIPPED"
+	case DatumSkipUnchanged:
+		return "UNCHANGED"
+	case DatumSkipQuarantined:
+		return "QUARANTINED"
+	}
+	return "UNKNOWN"
+}
+
+// InspectDatumSkipReason returns the reason a job skipped a datum, along with
+// a message describing it. If the job didn't skip the datum, it returns
+// DatumNotSkipped.
+func (c APIClient) InspectDatumSkipReason(pipelineName, jobID, datumID string) (DatumSkipReason, string, error) {
+	datumInfo, err := c.InspectDatum(pipelineName, jobID, datumID)
+	if err != nil {
+		return DatumNotSkipped, "", err
+	}
+	switch datumInfo.State {
+	case pps.DatumState_SKIPPED:
+		// A skipped datum's info carries the job that processed it
+		return DatumSkipUnchanged, fmt.Sprintf("datum's inputs are unchanged since job %s", datumInfo.Datum.Job.ID), nil
+	case pps.DatumState_QUARANTINED:
+		return DatumSkipQuarantined, "datum is quarantined after failing in consecutive jobs", nil
+	}
+	return DatumNotSkipped, fmt.Sprintf("datum is %s in job %s", datumInfo.State, jobID), nil
+}
+
 // LogsIter iterates through log messages returned from pps.GetLogs. Logs can
 // be fetched with 'Next()'. The log message received can be examined with
 // 'Message()', and any errors can be examined with 'Err()'.
@@ -574,13 +913,30 @@ func (c APIClient) GetLogs(
 	follow bool,
 	since time.Duration,
 ) *LogsIter {
-	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, false)
+	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, false, false)
+}
+
+// GetUserLogs is like GetLogs, but only returns log lines written by the
+// user's code. The worker's own log lines are filtered out by pachd, rather
+// than being sent to the client.
+func (c APIClient) GetUserLogs(
+	pipelineName string,
+	jobID string,
+	data []string,
+	datumID string,
+	follow bool,
+	since time.Duration,
+) *LogsIter {
+	return c.getLogs(pipelineName, jobID, data, datumID, false, follow, since, false, true)
 }
 
 // GetLogsLoki gets logs from a job (logs includes stdout and stderr). 'pipelineName',
 // 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
 // simply pass an empty value, though one of 'pipelineName' and 'jobID'
 // must be set. Responses are written to 'messages'
+// If Loki logs aren't enabled on the cluster (see InspectEnterpriseFeatures),
+// the returned iterator yields no messages and its Err method returns
+// ErrLokiLogsNotEnabled.
 func (c APIClient) GetLogsLoki(
 	pipelineName string,
 	jobID string,
@@ -590,7 +946,45 @@ func (c APIClient) GetLogsLoki(
 	follow bool,
 	since time.Duration,
 ) *LogsIter {
-	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, true)
+	features, err := c.InspectEnterpriseFeatures()
+	if err != nil {
+		return &LogsIter{err: err}
+	}
+	if !features.LokiLogs {
+		return &LogsIter{err: ErrLokiLogsNotEnabled}
+	}
+	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, true, false)
+}
+
+// ErrLokiLogsNotEnabled is returned when Loki logs are requested from a
+// cluster that doesn't have them enabled.
+var ErrLokiLogsNotEnabled = errors.New("Loki logs are not enabled on this cluster, they require an active enterprise license and a Loki deployment")
+
+// InspectClusterLimits returns the limits CreatePipeline enforces on the
+// cluster, so that pipeline specs can be checked before they're submitted. A
+// limit of 0 means there is no limit.
+func (c APIClient) InspectClusterLimits() (*pps.ClusterLimits, error) {
+	resp, err := c.PpsAPIClient.InspectClusterLimits(
+		c.Ctx(),
+		&pps.InspectClusterLimitsRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// InspectEnterpriseFeatures returns which enterprise features of PPS are
+// enabled on the cluster.
+func (c APIClient) InspectEnterpriseFeatures() (*pps.InspectEnterpriseFeaturesResponse, error) {
+	resp, err := c.PpsAPIClient.InspectEnterpriseFeatures(
+		c.Ctx(),
+		&pps.InspectEnterpriseFeaturesRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
 }
 
 func (c APIClient) getLogs(
@@ -602,12 +996,14 @@ func (c APIClient) getLogs(
 	follow bool,
 	since time.Duration,
 	useLoki bool,
+	userOnly bool,
 ) *LogsIter {
 	request := pps.GetLogsRequest{
 		Master:         master,
 		Follow:         follow,
 		UseLokiBackend: useLoki,
 		Since:          types.DurationProto(since),
+		UserOnly:       userOnly,
 	}
 	if pipelineName != "" {
 		request.Pipeline = NewPipeline(pipelineName)
@@ -675,6 +1071,14 @@ func (c APIClient) CreatePipeline(
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreatePipelineDryRun validates 'request' as CreatePipeline would and returns
+// the PipelineInfo that it would create, without creating the pipeline or
+// changing any other cluster state.
+func (c APIClient) CreatePipelineDryRun(request *pps.CreatePipelineRequest) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.CreatePipelineDryRun(c.Ctx(), request)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
 // InspectPipeline returns info about a specific pipeline.
 func (c APIClient) InspectPipeline(pipelineName string, details bool) (*pps.PipelineInfo, error) {
 	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
@@ -687,6 +1091,51 @@ func (c APIClient) InspectPipeline(pipelineName string, details bool) (*pps.Pipe
 	return pipelineInfo, grpcutil.ScrubGRPC(err)
 }
 
+// InspectPipelineVersion returns info about a specific version of a pipeline,
+// e.g. to find the spec that produced a historical job. Versions start at 1.
+func (c APIClient) InspectPipelineVersion(pipelineName string, version uint64) (*pps.PipelineInfo, error) {
+	if version == 0 {
+		return nil, errors.Errorf("pipeline versions start at 1")
+	}
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.Ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline: NewPipeline(ancestry.Add(pipelineName, -int(version))),
+			Details:  true,
+		},
+	)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
+// InspectPipelineHistory returns info about a specific pipeline, along with
+// summaries of its 'recentJobs' most recently created jobs (newest first) in
+// RecentJobs. pachd returns at most 10 summaries.
+func (c APIClient) InspectPipelineHistory(pipelineName string, details bool, recentJobs int64) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.Ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline:   NewPipeline(pipelineName),
+			Details:    details,
+			RecentJobs: recentJobs,
+		},
+	)
+	return pipelineInfo, grpcutil.ScrubGRPC(err)
+}
+
+// InspectPipelineParallelismEffective returns the number of workers that a
+// pipeline currently distributes datums across. This may differ from the
+// pipeline's ParallelismSpec, e.g. if the pipeline is autoscaling.
+func (c APIClient) InspectPipelineParallelismEffective(pipelineName string) (int, error) {
+	pipelineInfo, err := c.InspectPipeline(pipelineName, false)
+	if err != nil {
+		return 0, err
+	}
+	if pipelineInfo.Parallelism == 0 {
+		return 1, nil
+	}
+	return int(pipelineInfo.Parallelism), nil
+}
+
 // ListPipeline returns info about all pipelines.
 func (c APIClient) ListPipeline(details bool) ([]*pps.PipelineInfo, error) {
 	ctx, cf := context.WithCancel(c.Ctx())
@@ -731,6 +1180,66 @@ func (c APIClient) ListPipelineHistory(pipeline string, history int64, details b
 	return clientsdk.ListPipelineInfo(client)
 }
 
+// PipelineSpecDiff is a field of a pipeline's spec that differs between two
+// versions of the pipeline. A and B are the JSON values of the field in each
+// version, and are empty if the field is unset in that version.
+type PipelineSpecDiff struct {
+	Field string
+	A, B  string
+}
+
+// DiffPipelineSpecs returns the top-level fields of a pipeline's spec (such
+// as transform, input, or resource_requests) that differ between versions
+// versionA and versionB of the pipeline, sorted by field name.
+func (c APIClient) DiffPipelineSpecs(pipelineName string, versionA, versionB int64) ([]*PipelineSpecDiff, error) {
+	pipelineInfos, err := c.ListPipelineHistory(pipelineName, -1, true)
+	if err != nil {
+		return nil, err
+	}
+	specs := make(map[uint64]map[string]json.RawMessage)
+	for _, pi := range pipelineInfos {
+		if pi.Version != uint64(versionA) && pi.Version != uint64(versionB) {
+			continue
+		}
+		spec, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(pps.PipelineReqFromInfo(pi))
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		fields := make(map[string]json.RawMessage)
+		if err := json.Unmarshal([]byte(spec), &fields); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		specs[pi.Version] = fields
+	}
+	for _, version := range []int64{versionA, versionB} {
+		if _, ok := specs[uint64(version)]; !ok {
+			return nil, errors.Errorf("pipeline %q has no version %d", pipelineName, version)
+		}
+	}
+	a, b := specs[uint64(versionA)], specs[uint64(versionB)]
+	var fields []string
+	for field := range a {
+		fields = append(fields, field)
+	}
+	for field := range b {
+		if _, ok := a[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	var result []*PipelineSpecDiff
+	for _, field := range fields {
+		if string(a[field]) != string(b[field]) {
+			result = append(result, &PipelineSpecDiff{
+				Field: field,
+				A:     string(a[field]),
+				B:     string(b[field]),
+			})
+		}
+	}
+	return result, nil
+}
+
 // DeletePipeline deletes a pipeline along with its output Repo.
 func (c APIClient) DeletePipeline(name string, force bool) error {
 	req := &pps.DeletePipelineRequest{
@@ -744,6 +1253,26 @@ func (c APIClient) DeletePipeline(name string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// DeletePipelines deletes a set of pipelines along with their output repos.
+// The pipelines are deleted downstream first, so they may be given in any
+// order. If pipelines outside of the set read from any of the pipelines being
+// deleted, an error is returned unless 'force' is true. If 'keepRepo' is true,
+// the pipelines' output repos are kept as source repos.
+func (c APIClient) DeletePipelines(names []string, force bool, keepRepo bool) error {
+	req := &pps.DeletePipelinesRequest{
+		Force:    force,
+		KeepRepo: keepRepo,
+	}
+	for _, name := range names {
+		req.Pipelines = append(req.Pipelines, NewPipeline(name))
+	}
+	_, err := c.PpsAPIClient.DeletePipelines(
+		c.Ctx(),
+		req,
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartPipeline restarts a stopped pipeline.
 func (c APIClient) StartPipeline(name string) error {
 	_, err := c.PpsAPIClient.StartPipeline(
@@ -767,6 +1296,61 @@ func (c APIClient) StopPipeline(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// StartPipelines restarts several stopped pipelines. If atomic is true, the
+// pipelines are started in a single transaction, and none of them are started
+// if any of them can't be. Otherwise each pipeline is started independently,
+// and the returned error lists the pipelines that couldn't be started.
+func (c APIClient) StartPipelines(names []string, atomic bool) error {
+	if atomic {
+		_, err := c.PpsAPIClient.StartPipeline(
+			c.Ctx(),
+			&pps.StartPipelineRequest{
+				Pipelines: newPipelines(names),
+			},
+		)
+		return grpcutil.ScrubGRPC(err)
+	}
+	return forEachPipeline(names, "start", c.StartPipeline)
+}
+
+// StopPipelines stops several pipelines, with the same semantics as
+// StartPipelines.
+func (c APIClient) StopPipelines(names []string, atomic bool) error {
+	if atomic {
+		_, err := c.PpsAPIClient.StopPipeline(
+			c.Ctx(),
+			&pps.StopPipelineRequest{
+				Pipelines: newPipelines(names),
+			},
+		)
+		return grpcutil.ScrubGRPC(err)
+	}
+	return forEachPipeline(names, "stop", c.StopPipeline)
+}
+
+func newPipelines(names []string) []*pps.Pipeline {
+	var pipelines []*pps.Pipeline
+	for _, name := range names {
+		pipelines = append(pipelines, NewPipeline(name))
+	}
+	return pipelines
+}
+
+// forEachPipeline calls f on every pipeline in names, and returns an error
+// describing every call that failed.
+func forEachPipeline(names []string, verb string, f func(string) error) error {
+	var failed []string
+	for _, name := range names {
+		if err := f(name); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("could not %s %d of %d pipelines: %s", verb, len(failed), len(names), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
 // RunPipeline runs a pipeline. It can be passed a list of commit provenance.
 // This will trigger a new job provenant on those commits, effectively running the pipeline on the data in those commits.
 func (c APIClient) RunPipeline(name string, provenance []*pfs.Commit, jobID string) error {
@@ -793,6 +1377,297 @@ func (c APIClient) RunCron(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ReprocessPipeline starts a new job for the pipeline that processes every
+// datum in its current inputs, without updating the pipeline's spec or
+// version. It returns the new job.
+func (c APIClient) ReprocessPipeline(name string) (_ *pps.Job, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	return c.PpsAPIClient.ReprocessPipeline(
+		c.Ctx(),
+		&pps.ReprocessPipelineRequest{
+			Pipeline: NewPipeline(name),
+		},
+	)
+}
+
+// RerunPipeline starts a new job for the current version of the pipeline
+// against its current inputs, without updating its spec. By default (or with
+// ReprocessSpecEveryJob) the job processes every datum; with
+// ReprocessSpecUntilSuccess it only processes the datums that didn't succeed
+// in the pipeline's previous job. This is useful when a pipeline's output
+// depends on an external resource that has changed.
+func (c APIClient) RerunPipeline(pipelineName string, reprocessSpecs ...string) (retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	if len(reprocessSpecs) > 1 {
+		return errors.Errorf("at most one reprocess spec may be given to RerunPipeline")
+	}
+	request := &pps.ReprocessPipelineRequest{
+		Pipeline: NewPipeline(pipelineName),
+	}
+	if len(reprocessSpecs) > 0 {
+		request.ReprocessSpec = reprocessSpecs[0]
+	}
+	_, err := c.PpsAPIClient.ReprocessPipeline(c.Ctx(), request)
+	return err
+}
+
+// RestartJobDatums starts a new job for the pipeline that processes the datums
+// of one of its jobs again: every datum, or if onlyFailed is set, only the
+// datums that didn't succeed. The job must be the pipeline's most recent job,
+// and must have finished, because the new job's output is built on the most
+// recent job's output commit. It returns the new job.
+func (c APIClient) RestartJobDatums(pipelineName, jobID string, onlyFailed bool) (_ *pps.Job, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	request := &pps.ReprocessPipelineRequest{
+		Pipeline:      NewPipeline(pipelineName),
+		ReprocessSpec: ReprocessSpecEveryJob,
+		Job:           NewJob(pipelineName, jobID),
+	}
+	if onlyFailed {
+		request.ReprocessSpec = ReprocessSpecUntilSuccess
+	}
+	return c.PpsAPIClient.ReprocessPipeline(c.Ctx(), request)
+}
+
+// ReleaseQuarantine releases a datum quarantined by a pipeline with
+// QuarantineAfter set, so that the pipeline's next job processes it again.
+func (c APIClient) ReleaseQuarantine(pipelineName, datumID string) error {
+	_, err := c.PpsAPIClient.ReleaseQuarantine(
+		c.Ctx(),
+		&pps.ReleaseQuarantineRequest{
+			Pipeline: NewPipeline(pipelineName),
+			DatumID:  datumID,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectWorkerHeartbeat returns the most recent heartbeat recorded by each
+// worker of a pipeline created with a Heartbeat.
+func (c APIClient) InspectWorkerHeartbeat(pipelineName string) ([]*pps.WorkerHeartbeat, error) {
+	resp, err := c.PpsAPIClient.InspectWorkerHeartbeat(
+		c.Ctx(),
+		&pps.InspectWorkerHeartbeatRequest{
+			Pipeline: NewPipeline(pipelineName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Heartbeats, nil
+}
+
+// InputReadiness describes whether one of a pipeline's PFS inputs has data for
+// the pipeline to process.
+type InputReadiness struct {
+	// Name is the name of the input.
+	Name string
+	// Branch is the input's branch.
+	Branch *pfs.Branch
+	// Head is the input branch's head commit, or nil if the branch doesn't
+	// exist yet.
+	Head *pfs.Commit
+	// Ready is true if the input's head commit is finished and has files.
+	Ready bool
+	// Reason explains why the input isn't ready, if it isn't.
+	Reason string
+}
+
+// InspectInputCommitReadiness returns the readiness of each of a pipeline's
+// PFS inputs, which explains why a pipeline with cross or join inputs may not
+// have started a job: all of its inputs must be ready.
+func (c APIClient) InspectInputCommitReadiness(pipelineName string) ([]*InputReadiness, error) {
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	if err != nil {
+		return nil, err
+	}
+	var result []*InputReadiness
+	if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+		if input.Pfs == nil {
+			return nil
+		}
+		readiness := &InputReadiness{
+			Name:   input.Pfs.Name,
+			Branch: NewBranch(input.Pfs.Repo, input.Pfs.Branch),
+		}
+		result = append(result, readiness)
+		branchInfo, err := c.InspectBranch(input.Pfs.Repo, input.Pfs.Branch)
+		if err != nil {
+			if errutil.IsNotFoundError(err) {
+				readiness.Reason = "branch doesn't exist"
+				return nil
+			}
+			return err
+		}
+		readiness.Head = branchInfo.Head
+		commitInfo, err := c.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, "")
+		if err != nil {
+			return err
+		}
+		if commitInfo.Finished == nil {
+			readiness.Reason = "head commit isn't finished"
+			return nil
+		}
+		var hasFiles bool
+		if err := c.WalkFile(branchInfo.Head, "/", func(fi *pfs.FileInfo) error {
+			if fi.FileType == pfs.FileType_FILE {
+				hasFiles = true
+				return errutil.ErrBreak
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if !hasFiles {
+			readiness.Reason = "head commit has no files"
+			return nil
+		}
+		readiness.Ready = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CronState describes the schedule of a pipeline's cron input.
+type CronState struct {
+	// Name is the name of the cron input.
+	Name string
+	// Repo is the repo that the cron input's ticks are committed to.
+	Repo string
+	// Overwrite is true if each tick replaces the previous tick's file.
+	Overwrite bool
+	// LastTick is the time of the most recent tick, or the zero time if the
+	// input hasn't ticked yet.
+	LastTick time.Time
+	// NextTick is the time at which the input is next scheduled to tick.
+	NextTick time.Time
+}
+
+// InspectCronState returns the schedule of each cron input of a pipeline,
+// derived from the input's spec and the ticks in its cron repo in the same way
+// pachd schedules them.
+func (c APIClient) InspectCronState(pipelineName string) ([]*CronState, error) {
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	if err != nil {
+		return nil, err
+	}
+	var result []*CronState
+	if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+		if input.Cron == nil {
+			return nil
+		}
+		schedule, err := cron.ParseStandard(input.Cron.Spec)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		state := &CronState{
+			Name:      input.Cron.Name,
+			Repo:      input.Cron.Repo,
+			Overwrite: input.Cron.Overwrite,
+		}
+		fileInfos, err := c.ListFileAll(NewCommit(input.Cron.Repo, "master", ""), "")
+		if err != nil {
+			return err
+		}
+		// ticks are named by their RFC3339 time, so the most recent one sorts last
+		latest, err := types.TimestampFromProto(input.Cron.Start)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(fileInfos) > 0 {
+			if latest, err = time.Parse(time.RFC3339, path.Base(fileInfos[len(fileInfos)-1].File.Path)); err != nil {
+				return errors.EnsureStack(err)
+			}
+			state.LastTick = latest
+		}
+		state.NextTick = schedule.Next(latest)
+		result = append(result, state)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PipelineMetrics is a point in a pipeline's metrics history, recorded when
+// one of its jobs finished.
+type PipelineMetrics struct {
+	// Time is the time at which Job finished.
+	Time time.Time
+	// Job is the job that finished at Time.
+	Job *pps.Job
+	// Succeeded and Failed count the jobs in the history that finished
+	// successfully and unsuccessfully, up to and including Job.
+	Succeeded, Failed int64
+	// DatumsPerSecond is the number of datums Job processed per second.
+	DatumsPerSecond float64
+	// AvgJobDuration is the average duration of the jobs in the history, up to
+	// and including Job.
+	AvgJobDuration time.Duration
+}
+
+// GetPipelineMetricsHistory returns the metrics history of a pipeline's jobs
+// (including jobs from earlier versions of the pipeline) that finished within
+// 'since' of now, oldest first. If 'since' is 0, every finished job is
+// included.
+func (c APIClient) GetPipelineMetricsHistory(pipelineName string, since time.Duration) ([]*PipelineMetrics, error) {
+	var jobInfos []*pps.JobInfo
+	if err := c.ListJobF(pipelineName, nil, -1, false, func(ji *pps.JobInfo) error {
+		if pps.IsTerminal(ji.State) && ji.Finished != nil {
+			jobInfos = append(jobInfos, ji)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var points []*PipelineMetrics
+	for _, ji := range jobInfos {
+		finished, err := types.TimestampFromProto(ji.Finished)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if since != 0 && time.Since(finished) > since {
+			continue
+		}
+		started := ji.Started
+		if started == nil {
+			started = ji.Created
+		}
+		start, err := types.TimestampFromProto(started)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		point := &PipelineMetrics{
+			Time:           finished,
+			Job:            ji.Job,
+			AvgJobDuration: finished.Sub(start),
+		}
+		if d := point.AvgJobDuration.Seconds(); d > 0 {
+			point.DatumsPerSecond = float64(ji.DataProcessed+ji.DataFailed+ji.DataRecovered) / d
+		}
+		if ji.State == pps.JobState_JOB_SUCCESS {
+			point.Succeeded = 1
+		} else {
+			point.Failed = 1
+		}
+		points = append(points, point)
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Time.Before(points[j].Time) })
+	// Accumulate the counts and durations, which so far describe each job alone
+	var total time.Duration
+	for i, point := range points {
+		total += point.AvgJobDuration
+		point.AvgJobDuration = total / time.Duration(i+1)
+		if i > 0 {
+			point.Succeeded += points[i-1].Succeeded
+			point.Failed += points[i-1].Failed
+		}
+	}
+	return points, nil
+}
+
 // CreateSecret creates a secret on the cluster.
 func (c APIClient) CreateSecret(file []byte) error {
 	_, err := c.PpsAPIClient.CreateSecret(
@@ -838,6 +1713,62 @@ func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	return secretInfos.SecretInfo, nil
 }
 
+// ExportPipeline writes a PipelineBundle describing the pipeline to w, as
+// JSON. The bundle names the secrets the pipeline references, but doesn't
+// include their values.
+func (c APIClient) ExportPipeline(pipelineName string, w io.Writer) error {
+	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
+	if err != nil {
+		return err
+	}
+	bundle := &pps.PipelineBundle{
+		Spec:    pps.PipelineReqFromInfo(pipelineInfo),
+		Version: pipelineInfo.Version,
+	}
+	secrets := make(map[string]bool)
+	if transform := pipelineInfo.Details.Transform; transform != nil {
+		for _, secret := range transform.Secrets {
+			secrets[secret.Name] = true
+		}
+		for _, secret := range transform.ImagePullSecrets {
+			secrets[secret] = true
+		}
+	}
+	if egress := pipelineInfo.Details.Egress; egress != nil && egress.Secret != nil {
+		secrets[egress.Secret.Name] = true
+	}
+	for secret := range secrets {
+		bundle.Secrets = append(bundle.Secrets, secret)
+	}
+	sort.Strings(bundle.Secrets)
+	marshaler := &jsonpb.Marshaler{Indent: "  "}
+	return errors.EnsureStack(marshaler.Marshal(w, bundle))
+}
+
+// ImportPipeline creates a pipeline from a PipelineBundle written by
+// ExportPipeline. The secrets named in the bundle must already exist in the
+// cluster.
+func (c APIClient) ImportPipeline(r io.Reader) error {
+	bundle := &pps.PipelineBundle{}
+	if err := jsonpb.Unmarshal(r, bundle); err != nil {
+		return errors.Wrapf(err, "could not read pipeline bundle")
+	}
+	if bundle.Spec == nil {
+		return errors.Errorf("pipeline bundle has no spec")
+	}
+	var missing []string
+	for _, secret := range bundle.Secrets {
+		if _, err := c.InspectSecret(secret); err != nil {
+			missing = append(missing, secret)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("pipeline %q references secrets that don't exist: %s", bundle.Spec.Pipeline.Name, strings.Join(missing, ", "))
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(c.Ctx(), bundle.Spec)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
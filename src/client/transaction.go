@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -169,6 +170,111 @@ func (c APIClient) ExecuteInTransaction(f func(c *APIClient) error) (*transactio
 	return c.FinishTransaction(txn)
 }
 
+// CommitsBuilder collects file modifications and branch updates across repos,
+// which TransactCommits applies as a single commitset.
+type CommitsBuilder struct {
+	branches       []*pfs.Branch
+	modifications  map[string][]func(ModifyFile) error
+	createBranches []*pfs.CreateBranchRequest
+}
+
+func branchKey(branch *pfs.Branch) string {
+	return branch.Repo.Name + "@" + branch.Name
+}
+
+func (cb *CommitsBuilder) modify(repoName, branchName string, f func(ModifyFile) error) {
+	branch := NewBranch(repoName, branchName)
+	key := branchKey(branch)
+	if _, ok := cb.modifications[key]; !ok {
+		cb.branches = append(cb.branches, branch)
+	}
+	cb.modifications[key] = append(cb.modifications[key], f)
+}
+
+// PutFile puts a file into the commit on the given branch.
+func (cb *CommitsBuilder) PutFile(repoName, branchName, path string, r io.Reader, opts ...PutFileOption) {
+	cb.modify(repoName, branchName, func(mf ModifyFile) error {
+		return mf.PutFile(path, r, opts...)
+	})
+}
+
+// DeleteFile deletes a file from the commit on the given branch.
+func (cb *CommitsBuilder) DeleteFile(repoName, branchName, path string, opts ...DeleteFileOption) {
+	cb.modify(repoName, branchName, func(mf ModifyFile) error {
+		return mf.DeleteFile(path, opts...)
+	})
+}
+
+// CreateBranch creates or moves a branch as part of the commitset.
+func (cb *CommitsBuilder) CreateBranch(repoName, branchName, commitBranch, commitID string, provenance []*pfs.Branch) {
+	var head *pfs.Commit
+	if commitBranch != "" || commitID != "" {
+		head = NewCommit(repoName, commitBranch, commitID)
+	}
+	cb.createBranches = append(cb.createBranches, &pfs.CreateBranchRequest{
+		Branch:     NewBranch(repoName, branchName),
+		Head:       head,
+		Provenance: provenance,
+	})
+}
+
+// TransactCommits starts a commit on every branch modified through the
+// CommitsBuilder and applies its branch updates in a single transaction, so
+// that downstream pipelines observe a single commitset. The file modifications
+// are then written and the commits are finished. The ID of the commitset is
+// returned.
+func (c APIClient) TransactCommits(cb func(*CommitsBuilder) error) (_ string, retErr error) {
+	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
+	builder := &CommitsBuilder{modifications: make(map[string][]func(ModifyFile) error)}
+	if err := cb(builder); err != nil {
+		return "", err
+	}
+	var commits []*pfs.Commit
+	if _, err := c.ExecuteInTransaction(func(txnClient *APIClient) error {
+		for _, branch := range builder.branches {
+			commit, err := txnClient.StartCommit(branch.Repo.Name, branch.Name)
+			if err != nil {
+				return err
+			}
+			commits = append(commits, commit)
+		}
+		for _, req := range builder.createBranches {
+			if _, err := txnClient.PfsAPIClient.CreateBranch(txnClient.Ctx(), req); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	for i, commit := range commits {
+		if err := c.WithModifyFileClient(commit, func(mf ModifyFile) error {
+			for _, f := range builder.modifications[branchKey(builder.branches[i])] {
+				if err := f(mf); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return "", err
+		}
+	}
+	if _, err := c.ExecuteInTransaction(func(txnClient *APIClient) error {
+		for _, commit := range commits {
+			if err := txnClient.FinishCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	if len(commits) == 0 {
+		return "", nil
+	}
+	return commits[0].ID, nil
+}
+
 // TransactionBuilder presents the same interface as a pachyderm APIClient, but
 // captures requests rather than sending to the server. If a request is not
 // supported by the transaction system, it immediately errors.
@@ -416,6 +522,9 @@ func (c *pfsBuilderClient) RunLoadTest(ctx context.Context, req *pfs.RunLoadTest
 func (c *pfsBuilderClient) RunLoadTestDefault(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTestDefault")
 }
+func (c *pfsBuilderClient) DeleteCommit(ctx context.Context, req *pfs.DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteCommit")
+}
 
 func (c *ppsBuilderClient) InspectJobSet(ctx context.Context, req *pps.InspectJobSetRequest, opts ...grpc.CallOption) (pps.API_InspectJobSetClient, error) {
 	return nil, unsupportedError("InspectJobSet")
@@ -492,6 +601,33 @@ func (c *ppsBuilderClient) RunLoadTest(ctx context.Context, req *pfs.RunLoadTest
 func (c *ppsBuilderClient) RunLoadTestDefault(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTestDefault")
 }
+func (c *ppsBuilderClient) GetDatumCount(ctx context.Context, req *pps.GetDatumCountRequest, opts ...grpc.CallOption) (*pps.GetDatumCountResponse, error) {
+	return nil, unsupportedError("GetDatumCount")
+}
+func (c *ppsBuilderClient) InspectEnterpriseFeatures(ctx context.Context, req *pps.InspectEnterpriseFeaturesRequest, opts ...grpc.CallOption) (*pps.InspectEnterpriseFeaturesResponse, error) {
+	return nil, unsupportedError("InspectEnterpriseFeatures")
+}
+func (c *ppsBuilderClient) InspectJobSetInfo(ctx context.Context, req *pps.InspectJobSetRequest, opts ...grpc.CallOption) (*pps.JobSetInfo, error) {
+	return nil, unsupportedError("InspectJobSetInfo")
+}
+func (c *ppsBuilderClient) ReprocessPipeline(ctx context.Context, req *pps.ReprocessPipelineRequest, opts ...grpc.CallOption) (*pps.Job, error) {
+	return nil, unsupportedError("ReprocessPipeline")
+}
+func (c *ppsBuilderClient) DeletePipelines(ctx context.Context, req *pps.DeletePipelinesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeletePipelines")
+}
+func (c *ppsBuilderClient) CreatePipelineDryRun(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfo, error) {
+	return nil, unsupportedError("CreatePipelineDryRun")
+}
+func (c *ppsBuilderClient) ReleaseQuarantine(ctx context.Context, req *pps.ReleaseQuarantineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ReleaseQuarantine")
+}
+func (c *ppsBuilderClient) InspectWorkerHeartbeat(ctx context.Context, req *pps.InspectWorkerHeartbeatRequest, opts ...grpc.CallOption) (*pps.WorkerHeartbeats, error) {
+	return nil, unsupportedError("InspectWorkerHeartbeat")
+}
+func (c *ppsBuilderClient) InspectClusterLimits(ctx context.Context, req *pps.InspectClusterLimitsRequest, opts ...grpc.CallOption) (*pps.ClusterLimits, error) {
+	return nil, unsupportedError("InspectClusterLimits")
+}
 
 func (c *authBuilderClient) Activate(ctx context.Context, req *auth.ActivateRequest, opts ...grpc.CallOption) (*auth.ActivateResponse, error) {
 	return nil, unsupportedError("Activate")
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/spf13/pflag"
 )
@@ -29,6 +30,11 @@ func Encoder(format string, w io.Writer) serde.Encoder {
 	return e
 }
 
+// ErrOutputRequiresRaw is returned by the commands that can render their
+// pretty-printed output as JSON, when --output is set to another format
+// without --raw.
+var ErrOutputRequiresRaw = errors.New("cannot set --output (-o) to anything other than json without --raw")
+
 func OutputFlags(raw *bool, output *string) *pflag.FlagSet {
 	outputFlags := pflag.NewFlagSet("", pflag.ExitOnError)
 	outputFlags.BoolVar(raw, "raw", false, "Disable pretty printing; serialize data structures to an encoding such as json or yaml")
@@ -37,7 +43,7 @@ func OutputFlags(raw *bool, output *string) *pflag.FlagSet {
 	// encode(), which assumes "json" if 'format' is empty.
 	// Note: because of how spf13/flags works, no other StringVarP that sets
 	// 'output' can have a default value either
-	outputFlags.StringVarP(output, "output", "o", "", "Output format when --raw is set: \"json\" or \"yaml\" (default \"json\")")
+	outputFlags.StringVarP(output, "output", "o", "", "Output format when --raw is set: \"json\" or \"yaml\" (default \"json\"). Some commands also accept \"json\" without --raw, to print their pretty-printed output as JSON")
 	return outputFlags
 }
 
@@ -58,7 +58,7 @@ var (
 	rolePolicyRules = []rbacv1.PolicyRule{{
 		APIGroups: []string{""},
 		Verbs:     []string{"get", "list", "watch"},
-		Resources: []string{"nodes", "pods", "pods/log", "endpoints"},
+		Resources: []string{"nodes", "pods", "pods/log", "endpoints", "configmaps"},
 	}, {
 		APIGroups: []string{""},
 		Verbs:     []string{"get", "list", "watch", "create", "update", "delete"},
@@ -0,0 +1,121 @@
+package dlock
+
+import (
+	"context"
+	"path"
+	"strconv"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// Semaphore is a handle to a distributed semaphore, which allows up to a fixed
+// number of holders at once.
+type Semaphore interface {
+	// Acquire acquires a slot in the semaphore, blocking until one is free.
+	Acquire(context.Context) error
+	// Release releases the slot held by this handle.
+	Release(context.Context) error
+}
+
+type etcdSemaphore struct {
+	client *etcd.Client
+	prefix string
+	size   int
+
+	session *concurrency.Session
+	key     string
+}
+
+// NewSemaphore creates a handle to a distributed semaphore with 'size' slots,
+// stored under the given prefix in etcd. Each handle can hold at most one slot.
+func NewSemaphore(client *etcd.Client, prefix string, size int) Semaphore {
+	return &etcdSemaphore{
+		client: client,
+		prefix: prefix,
+		size:   size,
+	}
+}
+
+func (s *etcdSemaphore) Acquire(ctx context.Context) (retErr error) {
+	// As with DLock, use a short TTL so that the slots of dead nodes are freed
+	// quickly.
+	session, err := concurrency.NewSession(s.client, concurrency.WithContext(ctx), concurrency.WithTTL(15))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			session.Close()
+		}
+	}()
+	for {
+		var rev int64
+		for i := 0; i < s.size; i++ {
+			key := path.Join(s.prefix, strconv.Itoa(i))
+			resp, err := s.client.Txn(ctx).
+				If(etcd.Compare(etcd.CreateRevision(key), "=", 0)).
+				Then(etcd.OpPut(key, "", etcd.WithLease(session.Lease()))).
+				Commit()
+			if err != nil {
+				return err
+			}
+			if resp.Succeeded {
+				s.session = session
+				s.key = key
+				return nil
+			}
+			if rev == 0 {
+				rev = resp.Header.Revision
+			}
+		}
+		// Every slot is held, wait for one of them to be released after the
+		// point at which we saw the first of them held, so that a slot released
+		// while the later slots were being checked isn't missed.
+		if err := s.waitForRelease(ctx, rev); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *etcdSemaphore) waitForRelease(ctx context.Context, rev int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watcher := s.client.Watch(ctx, s.prefix+"/", etcd.WithPrefix(), etcd.WithRev(rev+1), etcd.WithFilterPut())
+	for resp := range watcher {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if len(resp.Events) > 0 {
+			return nil
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.New("watch closed while waiting for a semaphore slot")
+}
+
+func (s *etcdSemaphore) Release(ctx context.Context) error {
+	if s.session == nil {
+		return errors.New("semaphore slot is not held")
+	}
+	// Only delete the slot if it's still attached to our lease. If the lease
+	// expired, another holder may have acquired the same slot since.
+	if _, err := s.client.Txn(ctx).
+		If(etcd.Compare(etcd.LeaseValue(s.key), "=", s.session.Lease())).
+		Then(etcd.OpDelete(s.key)).
+		Commit(); err != nil {
+		return err
+	}
+	err := s.session.Close()
+	s.session = nil
+	s.key = ""
+	if errors.Is(err, rpctypes.ErrLeaseNotFound) {
+		// The lease expired, so the slot was already freed
+		return nil
+	}
+	return err
+}
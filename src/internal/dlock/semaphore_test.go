@@ -0,0 +1,77 @@
+package dlock
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestSemaphore(t *testing.T) {
+	env := testetcd.NewEnv(t)
+	ctx := context.Background()
+	size := 3
+	var held, maxHeld int64
+	var eg errgroup.Group
+	for i := 0; i < 10; i++ {
+		eg.Go(func() error {
+			sem := NewSemaphore(env.EtcdClient, "semaphore", size)
+			if err := sem.Acquire(ctx); err != nil {
+				return err
+			}
+			n := atomic.AddInt64(&held, 1)
+			for {
+				max := atomic.LoadInt64(&maxHeld)
+				if n <= max || atomic.CompareAndSwapInt64(&maxHeld, max, n) {
+					break
+				}
+			}
+			time.Sleep(100 * time.Millisecond)
+			atomic.AddInt64(&held, -1)
+			return sem.Release(ctx)
+		})
+	}
+	require.NoError(t, eg.Wait())
+	require.Equal(t, int64(size), maxHeld)
+}
+
+func TestSemaphoreAcquireCanceled(t *testing.T) {
+	env := testetcd.NewEnv(t)
+	ctx := context.Background()
+	sem := NewSemaphore(env.EtcdClient, "semaphore", 1)
+	require.NoError(t, sem.Acquire(ctx))
+
+	ctx2, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.YesError(t, NewSemaphore(env.EtcdClient, "semaphore", 1).Acquire(ctx2))
+
+	require.NoError(t, sem.Release(ctx))
+	sem2 := NewSemaphore(env.EtcdClient, "semaphore", 1)
+	require.NoError(t, sem2.Acquire(ctx))
+	require.NoError(t, sem2.Release(ctx))
+}
+
+func TestSemaphoreReleaseAfterExpiry(t *testing.T) {
+	env := testetcd.NewEnv(t)
+	ctx := context.Background()
+	sem := NewSemaphore(env.EtcdClient, "semaphore", 1)
+	require.NoError(t, sem.Acquire(ctx))
+
+	// Expire the first handle's lease, so that its slot is taken by another
+	// handle before the first handle releases it
+	_, err := env.EtcdClient.Revoke(ctx, sem.(*etcdSemaphore).session.Lease())
+	require.NoError(t, err)
+	sem2 := NewSemaphore(env.EtcdClient, "semaphore", 1)
+	require.NoError(t, sem2.Acquire(ctx))
+	require.NoError(t, sem.Release(ctx))
+
+	// The second handle still holds the only slot
+	ctx2, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.YesError(t, NewSemaphore(env.EtcdClient, "semaphore", 1).Acquire(ctx2))
+	require.NoError(t, sem2.Release(ctx))
+}
@@ -156,6 +156,7 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/RenewFileSet":       authDisabledOr(authenticated),
 	"/pfs_v2.API/RunLoadTest":        authDisabledOr(authenticated),
 	"/pfs_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteCommit":       authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -189,12 +190,21 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
 	"/pps_v2.API/DeleteAll":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
-	"/pps_v2.API/CreateSecret":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
-	"/pps_v2.API/ListSecret":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
-	"/pps_v2.API/DeleteSecret":       authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
-	"/pps_v2.API/InspectSecret":      authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
-	"/pps_v2.API/RunLoadTest":        authDisabledOr(authenticated),
-	"/pps_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
+	"/pps_v2.API/CreateSecret":              authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_CREATE_SECRET)),
+	"/pps_v2.API/ListSecret":                authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
+	"/pps_v2.API/DeleteSecret":              authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
+	"/pps_v2.API/InspectSecret":             authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
+	"/pps_v2.API/RunLoadTest":               authDisabledOr(authenticated),
+	"/pps_v2.API/RunLoadTestDefault":        authDisabledOr(authenticated),
+	"/pps_v2.API/InspectClusterLimits":      authDisabledOr(authenticated),
+	"/pps_v2.API/InspectWorkerHeartbeat":    authDisabledOr(authenticated),
+	"/pps_v2.API/ReleaseQuarantine":         authDisabledOr(authenticated),
+	"/pps_v2.API/CreatePipelineDryRun":      authDisabledOr(authenticated),
+	"/pps_v2.API/DeletePipelines":           authDisabledOr(authenticated),
+	"/pps_v2.API/ReprocessPipeline":         authDisabledOr(authenticated),
+	"/pps_v2.API/InspectJobSetInfo":         authDisabledOr(authenticated),
+	"/pps_v2.API/InspectEnterpriseFeatures": authDisabledOr(authenticated),
+	"/pps_v2.API/GetDatumCount":             authDisabledOr(authenticated),
 
 	//
 	// TransactionAPI
@@ -392,6 +392,38 @@ func NewAmazonClientFromSecret(bucket string, reverse ...bool) (Client, error) {
 	return NewAmazonClient(region, bucket, &creds, distribution, endpoint)
 }
 
+// NewAmazonClientFromSecretDir constructs an amazon client for 'bucket' by
+// reading credentials from a secret mounted at 'dir', which has the same keys
+// as an AmazonSecret.
+func NewAmazonClientFromSecretDir(dir, bucket string) (Client, error) {
+	read := func(name string) (string, error) {
+		bytes, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return "", errors.EnsureStack(err)
+		}
+		return strings.TrimSpace(string(bytes)), nil
+	}
+	region, err := read(AmazonRegionEnvVar)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", AmazonRegionEnvVar)
+	}
+	var creds AmazonCreds
+	for name, dest := range map[string]*string{
+		AmazonIDEnvVar:     &creds.ID,
+		AmazonSecretEnvVar: &creds.Secret,
+		AmazonTokenEnvVar:  &creds.Token,
+	} {
+		if *dest, err = read(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+	endpoint, err := read(CustomEndpointEnvVar)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return NewAmazonClient(region, bucket, &creds, "", endpoint)
+}
+
 // NewAmazonClientFromEnv creates a Amazon client based on environment variables.
 func NewAmazonClientFromEnv() (Client, error) {
 	region, ok := os.LookupEnv(AmazonRegionEnvVar)
@@ -15,9 +15,11 @@ import (
 	"bytes"
 	"crypto/md5"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
@@ -27,8 +29,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -109,6 +113,155 @@ func GetLimitsResourceList(limits *pps.ResourceSpec) (*v1.ResourceList, error) {
 	return getResourceListFromSpec(limits)
 }
 
+// QuarantineReleasePrefix is the etcd prefix (under the PPS prefix) of the keys
+// that record quarantined datums released by ReleaseQuarantine.
+const QuarantineReleasePrefix = "quarantine-release"
+
+// QuarantineReleaseKey returns the etcd key that records the release of the
+// given datum of the given pipeline from quarantine.
+func QuarantineReleaseKey(etcdPrefix, pipeline, datumID string) string {
+	return path.Join(etcdPrefix, QuarantineReleasePrefix, pipeline, datumID)
+}
+
+// DefaultEgressSecretPath is where a pipeline's egress secret is mounted in its
+// workers if the secret doesn't specify a mount path.
+const DefaultEgressSecretPath = "/pachyderm-egress-secret"
+
+// EgressSecretPath returns where the secret holding the credentials for
+// 'egress' is mounted in a pipeline's workers.
+func EgressSecretPath(egress *pps.Egress) string {
+	if egress.Secret.MountPath != "" {
+		return egress.Secret.MountPath
+	}
+	return DefaultEgressSecretPath
+}
+
+// EgressFailedReasonPrefix starts the reason of a pipeline that is CRASHING
+// because a job's output couldn't be egressed. The PPS master leaves such a
+// pipeline CRASHING even once its workers are up, and the worker moves it back
+// to RUNNING after an egress succeeds.
+const EgressFailedReasonPrefix = "could not egress job "
+
+// IsEgressFailure returns true if 'pipelineInfo' is CRASHING because a job's
+// egress failed.
+func IsEgressFailure(pipelineInfo *pps.PipelineInfo) bool {
+	return pipelineInfo.State == pps.PipelineState_PIPELINE_CRASHING && strings.HasPrefix(pipelineInfo.Reason, EgressFailedReasonPrefix)
+}
+
+// SplitScriptFromInput splits a transform's script_from_input into the name
+// of the input holding the script and the script's path within that input.
+func SplitScriptFromInput(script string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(path.Clean(script), "/"), "/", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// HeartbeatPrefix is the etcd prefix (under the PPS prefix) of the keys in
+// which a pipeline's workers record their heartbeats.
+const HeartbeatPrefix = "heartbeat"
+
+// HeartbeatKey returns the etcd key in which the given worker of the given
+// pipeline records its heartbeat.
+func HeartbeatKey(etcdPrefix, pipeline, workerID string) string {
+	return path.Join(etcdPrefix, HeartbeatPrefix, pipeline, workerID)
+}
+
+// HeartbeatTimeout returns how long the heartbeats configured by 'heartbeat'
+// may stall before the pipeline is considered unhealthy.
+func HeartbeatTimeout(heartbeat *pps.Heartbeat) (time.Duration, error) {
+	interval, err := types.DurationFromProto(heartbeat.Interval)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	if heartbeat.Timeout == nil {
+		return 3 * interval, nil
+	}
+	timeout, err := types.DurationFromProto(heartbeat.Timeout)
+	return timeout, errors.EnsureStack(err)
+}
+
+// WorkerHeartbeats returns the most recent heartbeat recorded by each of the
+// given pipeline's workers.
+func WorkerHeartbeats(ctx context.Context, etcdClient *etcd.Client, etcdPrefix, pipeline string) ([]*pps.WorkerHeartbeat, error) {
+	prefix := heartbeatPipelinePrefix(etcdPrefix, pipeline)
+	resp, err := etcdClient.Get(ctx, prefix, etcd.WithPrefix())
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var result []*pps.WorkerHeartbeat
+	for _, kv := range resp.Kvs {
+		t, err := time.Parse(time.RFC3339Nano, string(kv.Value))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid heartbeat at %q", kv.Key)
+		}
+		ts, err := types.TimestampProto(t)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		result = append(result, &pps.WorkerHeartbeat{
+			WorkerID:  strings.TrimPrefix(string(kv.Key), prefix),
+			Timestamp: ts,
+		})
+	}
+	return result, nil
+}
+
+// DeleteWorkerHeartbeats deletes the heartbeats of all of the workers of the
+// given pipeline.
+func DeleteWorkerHeartbeats(ctx context.Context, etcdClient *etcd.Client, etcdPrefix, pipeline string) error {
+	_, err := etcdClient.Delete(ctx, heartbeatPipelinePrefix(etcdPrefix, pipeline), etcd.WithPrefix())
+	return errors.EnsureStack(err)
+}
+
+func heartbeatPipelinePrefix(etcdPrefix, pipeline string) string {
+	return HeartbeatKey(etcdPrefix, pipeline, "") + "/"
+}
+
+// LokiLogsEnabled returns whether Loki logs are enabled on the cluster, which
+// requires both an active enterprise license and a Loki deployment.
+func LokiLogsEnabled(env serviceenv.ServiceEnv, enterpriseActive bool) bool {
+	if !enterpriseActive {
+		return false
+	}
+	_, err := env.GetLokiClient()
+	return err == nil
+}
+
+// DatumMemoryLimit returns the memory (in bytes) allowed to a datum whose
+// inputs total 'inputBytes', according to 'scaling'.
+func DatumMemoryLimit(scaling *pps.DatumMemoryScaling, inputBytes int64) (int64, error) {
+	if scaling.PerByte < 0 {
+		return 0, errors.Errorf("per_byte must be non-negative (got %v)", scaling.PerByte)
+	}
+	var base int64
+	if scaling.Base != "" {
+		q, err := resource.ParseQuantity(scaling.Base)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not parse base memory %q", scaling.Base)
+		}
+		base = q.Value()
+	}
+	limit := base + int64(scaling.PerByte*float64(inputBytes))
+	if scaling.Max != "" {
+		q, err := resource.ParseQuantity(scaling.Max)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not parse max memory %q", scaling.Max)
+		}
+		if q.Value() < base {
+			return 0, errors.Errorf("max memory %q is less than base memory %q", scaling.Max, scaling.Base)
+		}
+		if limit > q.Value() {
+			limit = q.Value()
+		}
+	}
+	if limit <= 0 {
+		return 0, errors.Errorf("datum memory scaling must allow some memory")
+	}
+	return limit, nil
+}
+
 // FailPipeline updates the pipeline's state to failed and sets the failure reason
 func FailPipeline(ctx context.Context, db *sqlx.DB, pipelinesCollection col.PostgresCollection, specCommit *pfs.Commit, reason string) error {
 	return SetPipelineState(ctx, db, pipelinesCollection, specCommit,
@@ -243,6 +396,20 @@ func SetPipelineState(ctx context.Context, db *sqlx.DB, pipelinesCollection col.
 	return err
 }
 
+// OutputAncestry returns how many commits before a job's output commit 'input'
+// reads, if it's an input on an ancestor of the pipeline's own output branch
+// (e.g. "<pipeline>@master^"). It returns 0 for every other input.
+func OutputAncestry(pipelineInfo *pps.PipelineInfo, input *pps.PFSInput) int {
+	if input.Repo != pipelineInfo.Pipeline.Name || (input.RepoType != "" && input.RepoType != pfs.UserRepoType) {
+		return 0
+	}
+	branch, ancestors, err := ancestry.Parse(input.Branch)
+	if err != nil || branch != pipelineInfo.Details.OutputBranch || ancestors < 0 {
+		return 0
+	}
+	return ancestors
+}
+
 // JobInput fills in the commits for an Input
 func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Input {
 	commitsetID := outputCommit.ID
@@ -250,6 +417,12 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 	pps.VisitInput(jobInput, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			input.Pfs.Commit = commitsetID
+			// Inputs on the pipeline's own output read an ancestor of the job's
+			// output commit, which isn't part of the job's commitset.
+			if ancestors := OutputAncestry(pipelineInfo, input.Pfs); ancestors > 0 {
+				input.Pfs.Branch = pipelineInfo.Details.OutputBranch
+				input.Pfs.Commit = ancestry.Add(commitsetID, ancestors)
+			}
 		}
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
@@ -261,32 +434,7 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 
 // PipelineReqFromInfo converts a PipelineInfo into a CreatePipelineRequest.
 func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequest {
-	return &pps.CreatePipelineRequest{
-		Pipeline:              pipelineInfo.Pipeline,
-		Transform:             pipelineInfo.Details.Transform,
-		ParallelismSpec:       pipelineInfo.Details.ParallelismSpec,
-		Egress:                pipelineInfo.Details.Egress,
-		OutputBranch:          pipelineInfo.Details.OutputBranch,
-		ResourceRequests:      pipelineInfo.Details.ResourceRequests,
-		ResourceLimits:        pipelineInfo.Details.ResourceLimits,
-		SidecarResourceLimits: pipelineInfo.Details.SidecarResourceLimits,
-		Input:                 pipelineInfo.Details.Input,
-		Description:           pipelineInfo.Details.Description,
-		Service:               pipelineInfo.Details.Service,
-		DatumSetSpec:          pipelineInfo.Details.DatumSetSpec,
-		DatumTimeout:          pipelineInfo.Details.DatumTimeout,
-		JobTimeout:            pipelineInfo.Details.JobTimeout,
-		Salt:                  pipelineInfo.Details.Salt,
-		PodSpec:               pipelineInfo.Details.PodSpec,
-		PodPatch:              pipelineInfo.Details.PodPatch,
-		Spout:                 pipelineInfo.Details.Spout,
-		SchedulingSpec:        pipelineInfo.Details.SchedulingSpec,
-		DatumTries:            pipelineInfo.Details.DatumTries,
-		S3Out:                 pipelineInfo.Details.S3Out,
-		Metadata:              pipelineInfo.Details.Metadata,
-		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
-		Autoscaling:           pipelineInfo.Details.Autoscaling,
-	}
+	return pps.PipelineReqFromInfo(pipelineInfo)
 }
 
 // UpdateJobState performs the operations involved with a job state transition.
@@ -322,25 +470,31 @@ func UpdateJobState(pipelines col.PostgresReadWriteCollection, jobs col.ReadWrit
 	return jobs.Put(ppsdb.JobKey(jobInfo.Job), jobInfo)
 }
 
-func FinishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+// FinishJob finishes the job's output commit and, unless the pipeline has
+// no_meta set, its meta commit, then records the job's final state. If
+// description is set, it becomes the output commit's description.
+func FinishJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, jobInfo *pps.JobInfo, state pps.JobState, reason, description string) error {
 	jobInfo.State = state
 	jobInfo.Reason = reason
 	// TODO: Leaning on the reason rather than state for commit errors seems a bit sketchy, but we don't
 	// store commit states.
 	_, err := pachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
 		if _, err := builder.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
-			Commit: jobInfo.OutputCommit,
-			Error:  reason,
-			Force:  true,
+			Commit:      jobInfo.OutputCommit,
+			Description: description,
+			Error:       reason,
+			Force:       true,
 		}); err != nil {
 			return err
 		}
-		if _, err := builder.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
-			Commit: MetaCommit(jobInfo.OutputCommit),
-			Error:  reason,
-			Force:  true,
-		}); err != nil {
-			return err
+		if !pipelineInfo.Details.NoMeta {
+			if _, err := builder.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+				Commit: MetaCommit(jobInfo.OutputCommit),
+				Error:  reason,
+				Force:  true,
+			}); err != nil {
+				return err
+			}
 		}
 		return WriteJobInfo(&builder.APIClient, jobInfo)
 	})
@@ -37,6 +37,18 @@ func Ago(timestamp *types.Timestamp) string {
 	return fmt.Sprintf("%s ago", since)
 }
 
+// Timestamp pretty-prints timestamp the way the detailed printers do: as an
+// absolute RFC 3339 time if full is set, and relative to now otherwise.
+func Timestamp(timestamp *types.Timestamp, full bool) string {
+	if timestamp == nil {
+		return ""
+	}
+	if full {
+		return types.TimestampString(timestamp)
+	}
+	return Ago(timestamp)
+}
+
 // TimeDifference pretty-prints the duration of time between from
 // and to as a human-reabable string.
 func TimeDifference(from *types.Timestamp, to *types.Timestamp) string {
@@ -53,6 +53,11 @@ type GlobalConfiguration struct {
 
 	IdentityServerDatabase string `env:"IDENTITY_SERVER_DATABASE,default=dex"`
 
+	// MaxConcurrentJobs is the maximum number of jobs, across all pipelines,
+	// that may run at once. Jobs beyond the limit wait in the STARTING state
+	// until a running job finishes. Zero means there is no limit.
+	MaxConcurrentJobs int `env:"MAX_CONCURRENT_JOBS,default=0"`
+
 	// PPSSpecCommitID and PPSPipelineName are only set for workers and sidecar
 	// pachd instances. Because both pachd and worker need to know the spec commit
 	// (the worker so that it can avoid jobs for other versions of the same pipelines
@@ -92,6 +97,9 @@ type PachdSpecificConfiguration struct {
 	MemoryRequest              string `env:"PACHD_MEMORY_REQUEST,default=1T"`
 	WorkerUsesRoot             bool   `env:"WORKER_USES_ROOT,default=false"`
 	RequireCriticalServersOnly bool   `env:"REQUIRE_CRITICAL_SERVERS_ONLY,default=false"`
+	// WorkerNodePools is a JSON object mapping node pool names to the node
+	// selector labels that pipelines in each pool are scheduled with.
+	WorkerNodePools string `env:"WORKER_NODE_POOLS,default="`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
 }
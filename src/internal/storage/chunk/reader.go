@@ -16,6 +16,7 @@ type Reader struct {
 	memCache    kv.GetPut
 	dataRefs    []*DataRef
 	offsetBytes int64
+	sizeBytes   int64
 }
 
 type ReaderOption func(*Reader)
@@ -26,6 +27,14 @@ func WithOffsetBytes(offsetBytes int64) ReaderOption {
 	}
 }
 
+// WithSizeBytes limits a reader to the given number of bytes (after the
+// offset). Zero means no limit.
+func WithSizeBytes(sizeBytes int64) ReaderOption {
+	return func(r *Reader) {
+		r.sizeBytes = sizeBytes
+	}
+}
+
 func newReader(ctx context.Context, client Client, memCache kv.GetPut, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	r := &Reader{
 		ctx:      ctx,
@@ -42,12 +51,22 @@ func newReader(ctx context.Context, client Client, memCache kv.GetPut, dataRefs
 // Iterate iterates over the data readers for the data references.
 func (r *Reader) Iterate(cb func(*DataReader) error) error {
 	offset := r.offsetBytes
+	remaining := r.sizeBytes
 	for _, dataRef := range r.dataRefs {
 		if dataRef.SizeBytes <= offset {
 			offset -= dataRef.SizeBytes
 			continue
 		}
 		dr := newDataReader(r.ctx, r.client, r.memCache, dataRef, offset)
+		if r.sizeBytes > 0 {
+			if remaining <= 0 {
+				return nil
+			}
+			if dataRef.SizeBytes-offset > remaining {
+				dr.size = remaining
+			}
+			remaining -= dataRef.SizeBytes - offset
+		}
 		offset = 0
 		if err := cb(dr); err != nil {
 			if errors.Is(err, errutil.ErrBreak) {
@@ -73,6 +92,8 @@ type DataReader struct {
 	memCache kv.GetPut
 	dataRef  *DataRef
 	offset   int64
+	// size, if non-zero, limits the data read to size bytes after offset
+	size int64
 }
 
 func newDataReader(ctx context.Context, client Client, memCache kv.GetPut, dataRef *DataRef, offset int64) *DataReader {
@@ -96,7 +117,11 @@ func (dr *DataReader) Get(w io.Writer) error {
 		if dr.offset > dr.dataRef.SizeBytes {
 			return errors.Errorf("DataReader.offset cannot be greater than the dataRef size. offset size: %v, dataRef size: %v.", dr.offset, dr.dataRef.SizeBytes)
 		}
-		data := chunk[dr.dataRef.OffsetBytes+dr.offset : dr.dataRef.OffsetBytes+dr.dataRef.SizeBytes]
+		end := dr.dataRef.OffsetBytes + dr.dataRef.SizeBytes
+		if dr.size > 0 {
+			end = dr.dataRef.OffsetBytes + dr.offset + dr.size
+		}
+		data := chunk[dr.dataRef.OffsetBytes+dr.offset : end]
 		_, err := w.Write(data)
 		return err
 	})
@@ -11,3 +11,10 @@ func WithHeaderCallback(cb func(*tar.Header) error) ExportOption {
 		ec.headerCallback = cb
 	}
 }
+
+// WithSkipCallback configures the export call to skip the files for which the callback returns true.
+func WithSkipCallback(cb func(*tar.Header, string) (bool, error)) ExportOption {
+	return func(ec *exportConfig) {
+		ec.skipCallback = cb
+	}
+}
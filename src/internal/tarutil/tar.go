@@ -206,6 +206,7 @@ func writeFile(filePath string, r io.Reader) (retErr error) {
 
 type exportConfig struct {
 	headerCallback func(*tar.Header) error
+	skipCallback   func(*tar.Header, string) (bool, error)
 }
 
 func Export(storageRoot string, w io.Writer, opts ...ExportOption) (retErr error) {
@@ -236,6 +237,15 @@ func Export(storageRoot string, w io.Writer, opts ...ExportOption) (retErr error
 			if err != nil {
 				return err
 			}
+			if ec.skipCallback != nil {
+				skip, err := ec.skipCallback(hdr, file)
+				if err != nil {
+					return err
+				}
+				if skip {
+					return nil
+				}
+			}
 			if ec.headerCallback != nil {
 				if err := ec.headerCallback(hdr); err != nil {
 					return err
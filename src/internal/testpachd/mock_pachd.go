@@ -441,6 +441,7 @@ type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFi
 type renewFileSetFunc func(context.Context, *pfs.RenewFileSetRequest) (*types.Empty, error)
 type runLoadTestFunc func(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
 type runLoadTestDefaultFunc func(context.Context, *types.Empty) (*pfs.RunLoadTestResponse, error)
+type deleteCommitFunc func(context.Context, *pfs.DeleteCommitRequest) (*types.Empty, error)
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
@@ -477,6 +478,7 @@ type mockGetFileSet struct{ handler getFileSetFunc }
 type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockRunLoadTest struct{ handler runLoadTestFunc }
 type mockRunLoadTestDefault struct{ handler runLoadTestDefaultFunc }
+type mockDeleteCommit struct{ handler deleteCommitFunc }
 
 func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
 func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
@@ -513,6 +515,7 @@ func (mock *mockGetFileSet) Use(cb getFileSetFunc)                 { mock.handle
 func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)             { mock.handler = cb }
 func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)               { mock.handler = cb }
 func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc) { mock.handler = cb }
+func (mock *mockDeleteCommit) Use(cb deleteCommitFunc)             { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
@@ -555,6 +558,7 @@ type mockPFSServer struct {
 	RenewFileSet       mockRenewFileSet
 	RunLoadTest        mockRunLoadTest
 	RunLoadTestDefault mockRunLoadTestDefault
+	DeleteCommit       mockDeleteCommit
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -767,6 +771,12 @@ func (api *pfsServerAPI) RunLoadTestDefault(ctx context.Context, req *types.Empt
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.RunLoadTestDefault")
 }
+func (api *pfsServerAPI) DeleteCommit(ctx context.Context, req *pfs.DeleteCommitRequest) (*types.Empty, error) {
+	if api.mock.DeleteCommit.handler != nil {
+		return api.mock.DeleteCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteCommit")
+}
 
 /* PPS Server Mocks */
 
@@ -798,6 +808,15 @@ type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
 type activateAuthPPSFunc func(context.Context, *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error)
 type runLoadTestPPSFunc func(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
 type runLoadTestDefaultPPSFunc func(context.Context, *types.Empty) (*pfs.RunLoadTestResponse, error)
+type getDatumCountFunc func(context.Context, *pps.GetDatumCountRequest) (*pps.GetDatumCountResponse, error)
+type inspectEnterpriseFeaturesFunc func(context.Context, *pps.InspectEnterpriseFeaturesRequest) (*pps.InspectEnterpriseFeaturesResponse, error)
+type inspectJobSetInfoFunc func(context.Context, *pps.InspectJobSetRequest) (*pps.JobSetInfo, error)
+type reprocessPipelineFunc func(context.Context, *pps.ReprocessPipelineRequest) (*pps.Job, error)
+type deletePipelinesFunc func(context.Context, *pps.DeletePipelinesRequest) (*types.Empty, error)
+type createPipelineDryRunFunc func(context.Context, *pps.CreatePipelineRequest) (*pps.PipelineInfo, error)
+type releaseQuarantineFunc func(context.Context, *pps.ReleaseQuarantineRequest) (*types.Empty, error)
+type inspectWorkerHeartbeatFunc func(context.Context, *pps.InspectWorkerHeartbeatRequest) (*pps.WorkerHeartbeats, error)
+type inspectClusterLimitsFunc func(context.Context, *pps.InspectClusterLimitsRequest) (*pps.ClusterLimits, error)
 
 type mockInspectJob struct{ handler inspectJobFunc }
 type mockListJob struct{ handler listJobFunc }
@@ -827,70 +846,97 @@ type mockGetLogs struct{ handler getLogsFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 type mockRunLoadTestPPS struct{ handler runLoadTestPPSFunc }
 type mockRunLoadTestDefaultPPS struct{ handler runLoadTestDefaultPPSFunc }
-
-func (mock *mockInspectJob) Use(cb inspectJobFunc)                       { mock.handler = cb }
-func (mock *mockListJob) Use(cb listJobFunc)                             { mock.handler = cb }
-func (mock *mockSubscribeJob) Use(cb subscribeJobFunc)                   { mock.handler = cb }
-func (mock *mockDeleteJob) Use(cb deleteJobFunc)                         { mock.handler = cb }
-func (mock *mockStopJob) Use(cb stopJobFunc)                             { mock.handler = cb }
-func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)               { mock.handler = cb }
-func (mock *mockInspectJobSet) Use(cb inspectJobSetFunc)                 { mock.handler = cb }
-func (mock *mockListJobSet) Use(cb listJobSetFunc)                       { mock.handler = cb }
-func (mock *mockInspectDatum) Use(cb inspectDatumFunc)                   { mock.handler = cb }
-func (mock *mockListDatum) Use(cb listDatumFunc)                         { mock.handler = cb }
-func (mock *mockRestartDatum) Use(cb restartDatumFunc)                   { mock.handler = cb }
-func (mock *mockCreatePipeline) Use(cb createPipelineFunc)               { mock.handler = cb }
-func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)             { mock.handler = cb }
-func (mock *mockListPipeline) Use(cb listPipelineFunc)                   { mock.handler = cb }
-func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)               { mock.handler = cb }
-func (mock *mockStartPipeline) Use(cb startPipelineFunc)                 { mock.handler = cb }
-func (mock *mockStopPipeline) Use(cb stopPipelineFunc)                   { mock.handler = cb }
-func (mock *mockRunPipeline) Use(cb runPipelineFunc)                     { mock.handler = cb }
-func (mock *mockRunCron) Use(cb runCronFunc)                             { mock.handler = cb }
-func (mock *mockCreateSecret) Use(cb createSecretFunc)                   { mock.handler = cb }
-func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)                   { mock.handler = cb }
-func (mock *mockInspectSecret) Use(cb inspectSecretFunc)                 { mock.handler = cb }
-func (mock *mockListSecret) Use(cb listSecretFunc)                       { mock.handler = cb }
-func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)                   { mock.handler = cb }
-func (mock *mockGetLogs) Use(cb getLogsFunc)                             { mock.handler = cb }
-func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)             { mock.handler = cb }
-func (mock *mockRunLoadTestPPS) Use(cb runLoadTestPPSFunc)               { mock.handler = cb }
-func (mock *mockRunLoadTestDefaultPPS) Use(cb runLoadTestDefaultPPSFunc) { mock.handler = cb }
+type mockGetDatumCount struct{ handler getDatumCountFunc }
+type mockInspectEnterpriseFeatures struct{ handler inspectEnterpriseFeaturesFunc }
+type mockInspectJobSetInfo struct{ handler inspectJobSetInfoFunc }
+type mockReprocessPipeline struct{ handler reprocessPipelineFunc }
+type mockDeletePipelines struct{ handler deletePipelinesFunc }
+type mockCreatePipelineDryRun struct{ handler createPipelineDryRunFunc }
+type mockReleaseQuarantine struct{ handler releaseQuarantineFunc }
+type mockInspectWorkerHeartbeat struct{ handler inspectWorkerHeartbeatFunc }
+type mockInspectClusterLimits struct{ handler inspectClusterLimitsFunc }
+
+func (mock *mockInspectJob) Use(cb inspectJobFunc)                               { mock.handler = cb }
+func (mock *mockListJob) Use(cb listJobFunc)                                     { mock.handler = cb }
+func (mock *mockSubscribeJob) Use(cb subscribeJobFunc)                           { mock.handler = cb }
+func (mock *mockDeleteJob) Use(cb deleteJobFunc)                                 { mock.handler = cb }
+func (mock *mockStopJob) Use(cb stopJobFunc)                                     { mock.handler = cb }
+func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)                       { mock.handler = cb }
+func (mock *mockInspectJobSet) Use(cb inspectJobSetFunc)                         { mock.handler = cb }
+func (mock *mockListJobSet) Use(cb listJobSetFunc)                               { mock.handler = cb }
+func (mock *mockInspectDatum) Use(cb inspectDatumFunc)                           { mock.handler = cb }
+func (mock *mockListDatum) Use(cb listDatumFunc)                                 { mock.handler = cb }
+func (mock *mockRestartDatum) Use(cb restartDatumFunc)                           { mock.handler = cb }
+func (mock *mockCreatePipeline) Use(cb createPipelineFunc)                       { mock.handler = cb }
+func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)                     { mock.handler = cb }
+func (mock *mockListPipeline) Use(cb listPipelineFunc)                           { mock.handler = cb }
+func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)                       { mock.handler = cb }
+func (mock *mockStartPipeline) Use(cb startPipelineFunc)                         { mock.handler = cb }
+func (mock *mockStopPipeline) Use(cb stopPipelineFunc)                           { mock.handler = cb }
+func (mock *mockRunPipeline) Use(cb runPipelineFunc)                             { mock.handler = cb }
+func (mock *mockRunCron) Use(cb runCronFunc)                                     { mock.handler = cb }
+func (mock *mockCreateSecret) Use(cb createSecretFunc)                           { mock.handler = cb }
+func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)                           { mock.handler = cb }
+func (mock *mockInspectSecret) Use(cb inspectSecretFunc)                         { mock.handler = cb }
+func (mock *mockListSecret) Use(cb listSecretFunc)                               { mock.handler = cb }
+func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)                           { mock.handler = cb }
+func (mock *mockGetLogs) Use(cb getLogsFunc)                                     { mock.handler = cb }
+func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)                     { mock.handler = cb }
+func (mock *mockRunLoadTestPPS) Use(cb runLoadTestPPSFunc)                       { mock.handler = cb }
+func (mock *mockRunLoadTestDefaultPPS) Use(cb runLoadTestDefaultPPSFunc)         { mock.handler = cb }
+func (mock *mockGetDatumCount) Use(cb getDatumCountFunc)                         { mock.handler = cb }
+func (mock *mockInspectEnterpriseFeatures) Use(cb inspectEnterpriseFeaturesFunc) { mock.handler = cb }
+func (mock *mockInspectJobSetInfo) Use(cb inspectJobSetInfoFunc)                 { mock.handler = cb }
+func (mock *mockReprocessPipeline) Use(cb reprocessPipelineFunc)                 { mock.handler = cb }
+func (mock *mockDeletePipelines) Use(cb deletePipelinesFunc)                     { mock.handler = cb }
+func (mock *mockCreatePipelineDryRun) Use(cb createPipelineDryRunFunc)           { mock.handler = cb }
+func (mock *mockReleaseQuarantine) Use(cb releaseQuarantineFunc)                 { mock.handler = cb }
+func (mock *mockInspectWorkerHeartbeat) Use(cb inspectWorkerHeartbeatFunc)       { mock.handler = cb }
+func (mock *mockInspectClusterLimits) Use(cb inspectClusterLimitsFunc)           { mock.handler = cb }
 
 type ppsServerAPI struct {
 	mock *mockPPSServer
 }
 
 type mockPPSServer struct {
-	api                ppsServerAPI
-	InspectJob         mockInspectJob
-	ListJob            mockListJob
-	SubscribeJob       mockSubscribeJob
-	DeleteJob          mockDeleteJob
-	StopJob            mockStopJob
-	UpdateJobState     mockUpdateJobState
-	InspectJobSet      mockInspectJobSet
-	ListJobSet         mockListJobSet
-	InspectDatum       mockInspectDatum
-	ListDatum          mockListDatum
-	RestartDatum       mockRestartDatum
-	CreatePipeline     mockCreatePipeline
-	InspectPipeline    mockInspectPipeline
-	ListPipeline       mockListPipeline
-	DeletePipeline     mockDeletePipeline
-	StartPipeline      mockStartPipeline
-	StopPipeline       mockStopPipeline
-	RunPipeline        mockRunPipeline
-	RunCron            mockRunCron
-	CreateSecret       mockCreateSecret
-	DeleteSecret       mockDeleteSecret
-	InspectSecret      mockInspectSecret
-	ListSecret         mockListSecret
-	DeleteAll          mockDeleteAllPPS
-	GetLogs            mockGetLogs
-	ActivateAuth       mockActivateAuthPPS
-	RunLoadTest        mockRunLoadTestPPS
-	RunLoadTestDefault mockRunLoadTestDefaultPPS
+	api                       ppsServerAPI
+	InspectJob                mockInspectJob
+	ListJob                   mockListJob
+	SubscribeJob              mockSubscribeJob
+	DeleteJob                 mockDeleteJob
+	StopJob                   mockStopJob
+	UpdateJobState            mockUpdateJobState
+	InspectJobSet             mockInspectJobSet
+	ListJobSet                mockListJobSet
+	InspectDatum              mockInspectDatum
+	ListDatum                 mockListDatum
+	RestartDatum              mockRestartDatum
+	CreatePipeline            mockCreatePipeline
+	InspectPipeline           mockInspectPipeline
+	ListPipeline              mockListPipeline
+	DeletePipeline            mockDeletePipeline
+	StartPipeline             mockStartPipeline
+	StopPipeline              mockStopPipeline
+	RunPipeline               mockRunPipeline
+	RunCron                   mockRunCron
+	CreateSecret              mockCreateSecret
+	DeleteSecret              mockDeleteSecret
+	InspectSecret             mockInspectSecret
+	ListSecret                mockListSecret
+	DeleteAll                 mockDeleteAllPPS
+	GetLogs                   mockGetLogs
+	ActivateAuth              mockActivateAuthPPS
+	RunLoadTest               mockRunLoadTestPPS
+	RunLoadTestDefault        mockRunLoadTestDefaultPPS
+	GetDatumCount             mockGetDatumCount
+	InspectEnterpriseFeatures mockInspectEnterpriseFeatures
+	InspectJobSetInfo         mockInspectJobSetInfo
+	ReprocessPipeline         mockReprocessPipeline
+	DeletePipelines           mockDeletePipelines
+	CreatePipelineDryRun      mockCreatePipelineDryRun
+	ReleaseQuarantine         mockReleaseQuarantine
+	InspectWorkerHeartbeat    mockInspectWorkerHeartbeat
+	InspectClusterLimits      mockInspectClusterLimits
 }
 
 func (api *ppsServerAPI) InspectJob(ctx context.Context, req *pps.InspectJobRequest) (*pps.JobInfo, error) {
@@ -1061,6 +1107,60 @@ func (api *ppsServerAPI) RunLoadTestDefault(ctx context.Context, req *types.Empt
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RunLoadTestDefault")
 }
+func (api *ppsServerAPI) GetDatumCount(ctx context.Context, req *pps.GetDatumCountRequest) (*pps.GetDatumCountResponse, error) {
+	if api.mock.GetDatumCount.handler != nil {
+		return api.mock.GetDatumCount.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.GetDatumCount")
+}
+func (api *ppsServerAPI) InspectEnterpriseFeatures(ctx context.Context, req *pps.InspectEnterpriseFeaturesRequest) (*pps.InspectEnterpriseFeaturesResponse, error) {
+	if api.mock.InspectEnterpriseFeatures.handler != nil {
+		return api.mock.InspectEnterpriseFeatures.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.InspectEnterpriseFeatures")
+}
+func (api *ppsServerAPI) InspectJobSetInfo(ctx context.Context, req *pps.InspectJobSetRequest) (*pps.JobSetInfo, error) {
+	if api.mock.InspectJobSetInfo.handler != nil {
+		return api.mock.InspectJobSetInfo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.InspectJobSetInfo")
+}
+func (api *ppsServerAPI) ReprocessPipeline(ctx context.Context, req *pps.ReprocessPipelineRequest) (*pps.Job, error) {
+	if api.mock.ReprocessPipeline.handler != nil {
+		return api.mock.ReprocessPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ReprocessPipeline")
+}
+func (api *ppsServerAPI) DeletePipelines(ctx context.Context, req *pps.DeletePipelinesRequest) (*types.Empty, error) {
+	if api.mock.DeletePipelines.handler != nil {
+		return api.mock.DeletePipelines.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeletePipelines")
+}
+func (api *ppsServerAPI) CreatePipelineDryRun(ctx context.Context, req *pps.CreatePipelineRequest) (*pps.PipelineInfo, error) {
+	if api.mock.CreatePipelineDryRun.handler != nil {
+		return api.mock.CreatePipelineDryRun.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.CreatePipelineDryRun")
+}
+func (api *ppsServerAPI) ReleaseQuarantine(ctx context.Context, req *pps.ReleaseQuarantineRequest) (*types.Empty, error) {
+	if api.mock.ReleaseQuarantine.handler != nil {
+		return api.mock.ReleaseQuarantine.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ReleaseQuarantine")
+}
+func (api *ppsServerAPI) InspectWorkerHeartbeat(ctx context.Context, req *pps.InspectWorkerHeartbeatRequest) (*pps.WorkerHeartbeats, error) {
+	if api.mock.InspectWorkerHeartbeat.handler != nil {
+		return api.mock.InspectWorkerHeartbeat.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.InspectWorkerHeartbeat")
+}
+func (api *ppsServerAPI) InspectClusterLimits(ctx context.Context, req *pps.InspectClusterLimitsRequest) (*pps.ClusterLimits, error) {
+	if api.mock.InspectClusterLimits.handler != nil {
+		return api.mock.InspectClusterLimits.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.InspectClusterLimits")
+}
 
 /* Transaction Server Mocks */
 
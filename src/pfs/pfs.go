@@ -17,6 +17,10 @@ const (
 	UserRepoType = "user"
 	MetaRepoType = "meta"
 	SpecRepoType = "spec"
+
+	// CompressionHeader is the GetFile response metadata key that reports the
+	// codec the file's contents were sent in, when compression was requested.
+	CompressionHeader = "pfs-compression"
 )
 
 // NewHash returns a hash that PFS uses internally to compute checksums.
@@ -76,3 +80,21 @@ func (b *Branch) NewCommit(id string) *Commit {
 func (b *Branch) String() string {
 	return b.Repo.String() + "@" + b.Name
 }
+
+// CommitSetEdges returns the provenance edges among the commits of a
+// CommitSet.
+func CommitSetEdges(commitInfos []*CommitInfo) []*CommitSetEdge {
+	byBranch := make(map[string]*Commit)
+	for _, ci := range commitInfos {
+		byBranch[ci.Commit.Branch.String()] = ci.Commit
+	}
+	var edges []*CommitSetEdge
+	for _, ci := range commitInfos {
+		for _, b := range ci.DirectProvenance {
+			if from, ok := byBranch[b.String()]; ok {
+				edges = append(edges, &CommitSetEdge{From: from, To: ci.Commit})
+			}
+		}
+	}
+	return edges
+}
@@ -162,6 +162,32 @@ func (Delimiter) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_21a7b2476cbc6216, []int{3}
 }
 
+// Compression is a codec that file contents may be transferred in.
+type Compression int32
+
+const (
+	Compression_UNCOMPRESSED Compression = 0
+	Compression_GZIP         Compression = 1
+)
+
+var Compression_name = map[int32]string{
+	0: "UNCOMPRESSED",
+	1: "GZIP",
+}
+
+var Compression_value = map[string]int32{
+	"UNCOMPRESSED": 0,
+	"GZIP":         1,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{4}
+}
+
 type Repo struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -962,12 +988,16 @@ func (m *CommitInfo) GetDetails() *CommitInfo_Details {
 
 // Details are only provided when explicitly requested
 type CommitInfo_Details struct {
-	SizeBytes            int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	CompactingTime       *types.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
-	ValidatingTime       *types.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	SizeBytes      int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CompactingTime *types.Duration `protobuf:"bytes,2,opt,name=compacting_time,json=compactingTime,proto3" json:"compacting_time,omitempty"`
+	ValidatingTime *types.Duration `protobuf:"bytes,3,opt,name=validating_time,json=validatingTime,proto3" json:"validating_time,omitempty"`
+	// file_count and dir_count are the number of files and directories
+	// (excluding the root) in the commit, counted when it's finished.
+	FileCount            int64    `protobuf:"varint,4,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	DirCount             int64    `protobuf:"varint,5,opt,name=dir_count,json=dirCount,proto3" json:"dir_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitInfo_Details) Reset()         { *m = CommitInfo_Details{} }
@@ -1024,6 +1054,20 @@ func (m *CommitInfo_Details) GetValidatingTime() *types.Duration {
 	return nil
 }
 
+func (m *CommitInfo_Details) GetFileCount() int64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
+func (m *CommitInfo_Details) GetDirCount() int64 {
+	if m != nil {
+		return m.DirCount
+	}
+	return 0
+}
+
 type CommitSet struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1071,19 +1115,78 @@ func (m *CommitSet) GetID() string {
 	return ""
 }
 
+// CommitSetEdge records that the commit 'to' is directly provenant on the
+// commit 'from'.
+type CommitSetEdge struct {
+	From                 *Commit  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *Commit  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitSetEdge) Reset()         { *m = CommitSetEdge{} }
+func (m *CommitSetEdge) String() string { return proto.CompactTextString(m) }
+func (*CommitSetEdge) ProtoMessage()    {}
+func (*CommitSetEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{11}
+}
+func (m *CommitSetEdge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSetEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSetEdge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitSetEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSetEdge.Merge(m, src)
+}
+func (m *CommitSetEdge) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSetEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSetEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitSetEdge proto.InternalMessageInfo
+
+func (m *CommitSetEdge) GetFrom() *Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *CommitSetEdge) GetTo() *Commit {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
 type CommitSetInfo struct {
-	CommitSet            *CommitSet    `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
-	Commits              []*CommitInfo `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	CommitSet *CommitSet    `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
+	Commits   []*CommitInfo `protobuf:"bytes,2,rep,name=commits,proto3" json:"commits,omitempty"`
+	// edges are the provenance relationships among 'commits'.
+	Edges                []*CommitSetEdge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CommitSetInfo) Reset()         { *m = CommitSetInfo{} }
 func (m *CommitSetInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitSetInfo) ProtoMessage()    {}
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{11}
+	return fileDescriptor_21a7b2476cbc6216, []int{12}
 }
 func (m *CommitSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1126,22 +1229,32 @@ func (m *CommitSetInfo) GetCommits() []*CommitInfo {
 	return nil
 }
 
+func (m *CommitSetInfo) GetEdges() []*CommitSetEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
 type FileInfo struct {
-	File                 *File            `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	FileType             FileType         `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=pfs_v2.FileType" json:"file_type,omitempty"`
-	Committed            *types.Timestamp `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
-	SizeBytes            int64            `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Hash                 []byte           `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	File      *File            `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileType  FileType         `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=pfs_v2.FileType" json:"file_type,omitempty"`
+	Committed *types.Timestamp `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	SizeBytes int64            `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Hash      []byte           `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// digests holds the digests of the file's content requested in
+	// InspectFileRequest.digests, keyed by algorithm.
+	Digests              map[string][]byte `protobuf:"bytes,6,rep,name=digests,proto3" json:"digests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{12}
+	return fileDescriptor_21a7b2476cbc6216, []int{13}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,6 +1318,13 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetDigests() map[string][]byte {
+	if m != nil {
+		return m.Digests
+	}
+	return nil
+}
+
 type CreateRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -1218,7 +1338,7 @@ func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{13}
+	return fileDescriptor_21a7b2476cbc6216, []int{14}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1399,7 @@ func (m *InspectRepoRequest) Reset()         { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()    {}
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{14}
+	return fileDescriptor_21a7b2476cbc6216, []int{15}
 }
 func (m *InspectRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1448,7 @@ func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()    {}
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{15}
+	return fileDescriptor_21a7b2476cbc6216, []int{16}
 }
 func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1376,7 +1496,7 @@ func (m *DeleteRepoRequest) Reset()         { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()    {}
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{16}
+	return fileDescriptor_21a7b2476cbc6216, []int{17}
 }
 func (m *DeleteRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +1555,7 @@ func (m *StartCommitRequest) Reset()         { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()    {}
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{17}
+	return fileDescriptor_21a7b2476cbc6216, []int{18}
 }
 func (m *StartCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,7 +1621,7 @@ func (m *FinishCommitRequest) Reset()         { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()    {}
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{18}
+	return fileDescriptor_21a7b2476cbc6216, []int{19}
 }
 func (m *FinishCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1691,7 @@ func (m *InspectCommitRequest) Reset()         { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()    {}
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{19}
+	return fileDescriptor_21a7b2476cbc6216, []int{20}
 }
 func (m *InspectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1622,6 +1742,7 @@ type ListCommitRequest struct {
 	Reverse              bool       `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
 	All                  bool       `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
 	OriginKind           OriginKind `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"`
+	CommitSet            *CommitSet `protobuf:"bytes,8,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1631,7 +1752,7 @@ func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()    {}
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{20}
+	return fileDescriptor_21a7b2476cbc6216, []int{21}
 }
 func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1709,6 +1830,13 @@ func (m *ListCommitRequest) GetOriginKind() OriginKind {
 	return OriginKind_ORIGIN_KIND_UNKNOWN
 }
 
+func (m *ListCommitRequest) GetCommitSet() *CommitSet {
+	if m != nil {
+		return m.CommitSet
+	}
+	return nil
+}
+
 type InspectCommitSetRequest struct {
 	CommitSet            *CommitSet `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
 	Wait                 bool       `protobuf:"varint,2,opt,name=wait,proto3" json:"wait,omitempty"`
@@ -1721,7 +1849,7 @@ func (m *InspectCommitSetRequest) Reset()         { *m = InspectCommitSetRequest
 func (m *InspectCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitSetRequest) ProtoMessage()    {}
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{21}
+	return fileDescriptor_21a7b2476cbc6216, []int{22}
 }
 func (m *InspectCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,7 +1902,7 @@ func (m *ListCommitSetRequest) Reset()         { *m = ListCommitSetRequest{} }
 func (m *ListCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitSetRequest) ProtoMessage()    {}
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{22}
+	return fileDescriptor_21a7b2476cbc6216, []int{23}
 }
 func (m *ListCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1814,7 +1942,7 @@ func (m *SquashCommitSetRequest) Reset()         { *m = SquashCommitSetRequest{}
 func (m *SquashCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*SquashCommitSetRequest) ProtoMessage()    {}
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{23}
+	return fileDescriptor_21a7b2476cbc6216, []int{24}
 }
 func (m *SquashCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,7 +1989,7 @@ func (m *DropCommitSetRequest) Reset()         { *m = DropCommitSetRequest{} }
 func (m *DropCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCommitSetRequest) ProtoMessage()    {}
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{24}
+	return fileDescriptor_21a7b2476cbc6216, []int{25}
 }
 func (m *DropCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1897,6 +2025,53 @@ func (m *DropCommitSetRequest) GetCommitSet() *CommitSet {
 	return nil
 }
 
+type DeleteCommitRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommitRequest) Reset()         { *m = DeleteCommitRequest{} }
+func (m *DeleteCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommitRequest) ProtoMessage()    {}
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{26}
+}
+func (m *DeleteCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommitRequest.Merge(m, src)
+}
+func (m *DeleteCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCommitRequest proto.InternalMessageInfo
+
+func (m *DeleteCommitRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
 type SubscribeCommitRequest struct {
 	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -1915,7 +2090,7 @@ func (m *SubscribeCommitRequest) Reset()         { *m = SubscribeCommitRequest{}
 func (m *SubscribeCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()    {}
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{25}
+	return fileDescriptor_21a7b2476cbc6216, []int{27}
 }
 func (m *SubscribeCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1997,7 +2172,7 @@ func (m *ClearCommitRequest) Reset()         { *m = ClearCommitRequest{} }
 func (m *ClearCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ClearCommitRequest) ProtoMessage()    {}
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{26}
+	return fileDescriptor_21a7b2476cbc6216, []int{28}
 }
 func (m *ClearCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2223,7 @@ func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
 func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBranchRequest) ProtoMessage()    {}
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{27}
+	return fileDescriptor_21a7b2476cbc6216, []int{29}
 }
 func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2123,7 +2298,7 @@ func (m *InspectBranchRequest) Reset()         { *m = InspectBranchRequest{} }
 func (m *InspectBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectBranchRequest) ProtoMessage()    {}
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{28}
+	return fileDescriptor_21a7b2476cbc6216, []int{30}
 }
 func (m *InspectBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2171,7 +2346,7 @@ func (m *ListBranchRequest) Reset()         { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()    {}
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{29}
+	return fileDescriptor_21a7b2476cbc6216, []int{31}
 }
 func (m *ListBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,7 +2401,7 @@ func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()    {}
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{30}
+	return fileDescriptor_21a7b2476cbc6216, []int{32}
 }
 func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2285,7 +2460,7 @@ func (m *AddFile) Reset()         { *m = AddFile{} }
 func (m *AddFile) String() string { return proto.CompactTextString(m) }
 func (*AddFile) ProtoMessage()    {}
 func (*AddFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31}
+	return fileDescriptor_21a7b2476cbc6216, []int{33}
 }
 func (m *AddFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2560,7 @@ func (m *AddFile_URLSource) Reset()         { *m = AddFile_URLSource{} }
 func (m *AddFile_URLSource) String() string { return proto.CompactTextString(m) }
 func (*AddFile_URLSource) ProtoMessage()    {}
 func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{33, 0}
 }
 func (m *AddFile_URLSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2440,7 +2615,7 @@ func (m *DeleteFile) Reset()         { *m = DeleteFile{} }
 func (m *DeleteFile) String() string { return proto.CompactTextString(m) }
 func (*DeleteFile) ProtoMessage()    {}
 func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{32}
+	return fileDescriptor_21a7b2476cbc6216, []int{34}
 }
 func (m *DeleteFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2672,7 @@ func (m *CopyFile) Reset()         { *m = CopyFile{} }
 func (m *CopyFile) String() string { return proto.CompactTextString(m) }
 func (*CopyFile) ProtoMessage()    {}
 func (*CopyFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{33}
+	return fileDescriptor_21a7b2476cbc6216, []int{35}
 }
 func (m *CopyFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2570,7 +2745,7 @@ func (m *ModifyFileRequest) Reset()         { *m = ModifyFileRequest{} }
 func (m *ModifyFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyFileRequest) ProtoMessage()    {}
 func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{34}
+	return fileDescriptor_21a7b2476cbc6216, []int{36}
 }
 func (m *ModifyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2669,9 +2844,20 @@ func (*ModifyFileRequest) XXX_OneofWrappers() []interface{} {
 }
 
 type GetFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
-	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	File   *File  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	URL    string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// compression, if set, requests the file's contents in the given codec
+	// rather than decompressed. The contents are compressed by pachd for each
+	// request, rather than sent as stored. The codec used is reported in the
+	// response's CompressionHeader metadata, which is only set when compression
+	// is requested. GetFileTAR compresses the whole tar stream in the given
+	// codec.
+	Compression Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=pfs_v2.Compression" json:"compression,omitempty"`
+	// size_bytes, if non-zero, limits the response to at most this many bytes
+	// of the file, starting at offset. Only the chunks covering that range are
+	// read.
+	SizeBytes            int64    `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2681,7 +2867,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{35}
+	return fileDescriptor_21a7b2476cbc6216, []int{37}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2731,8 +2917,25 @@ func (m *GetFileRequest) GetOffset() int64 {
 	return 0
 }
 
+func (m *GetFileRequest) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return Compression_UNCOMPRESSED
+}
+
+func (m *GetFileRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
 type InspectFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// digests lists additional digest algorithms ("md5", "sha256") to compute
+	// over the file's content. They are returned in FileInfo.digests.
+	Digests              []string `protobuf:"bytes,2,rep,name=digests,proto3" json:"digests,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2742,7 +2945,7 @@ func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()    {}
 func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{36}
+	return fileDescriptor_21a7b2476cbc6216, []int{38}
 }
 func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2778,12 +2981,24 @@ func (m *InspectFileRequest) GetFile() *File {
 	return nil
 }
 
+func (m *InspectFileRequest) GetDigests() []string {
+	if m != nil {
+		return m.Digests
+	}
+	return nil
+}
+
 type ListFileRequest struct {
 	// File is the parent directory of the files we want to list. This sets the
 	// repo, the commit/branch, and path prefix of files we're interested in
 	// If the "path" field is omitted, a list of files at the top level of the repo
 	// is returned
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// page_token, if set, resumes a listing after the file or directory with
+	// this path, which is the last one returned by the previous page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size, if greater than zero, limits the number of files returned.
+	PageSize             int64    `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2793,7 +3008,7 @@ func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()    {}
 func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{37}
+	return fileDescriptor_21a7b2476cbc6216, []int{39}
 }
 func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2829,6 +3044,20 @@ func (m *ListFileRequest) GetFile() *File {
 	return nil
 }
 
+func (m *ListFileRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListFileRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
 type WalkFileRequest struct {
 	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2840,7 +3069,7 @@ func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
 func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
 func (*WalkFileRequest) ProtoMessage()    {}
 func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{38}
+	return fileDescriptor_21a7b2476cbc6216, []int{40}
 }
 func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2888,7 +3117,7 @@ func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()    {}
 func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{39}
+	return fileDescriptor_21a7b2476cbc6216, []int{41}
 }
 func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2946,7 +3175,7 @@ func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()    {}
 func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{40}
+	return fileDescriptor_21a7b2476cbc6216, []int{42}
 }
 func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3008,7 +3237,7 @@ func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()    {}
 func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{41}
+	return fileDescriptor_21a7b2476cbc6216, []int{43}
 }
 func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3281,9 @@ func (m *DiffFileResponse) GetOldFile() *FileInfo {
 }
 
 type FsckRequest struct {
-	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	// repo, if set, limits the check (and any fixes) to a single repo.
+	Repo                 *Repo    `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3062,7 +3293,7 @@ func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
 func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
 func (*FsckRequest) ProtoMessage()    {}
 func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{42}
+	return fileDescriptor_21a7b2476cbc6216, []int{44}
 }
 func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3098,9 +3329,19 @@ func (m *FsckRequest) GetFix() bool {
 	return false
 }
 
+func (m *FsckRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
 type FsckResponse struct {
-	Fix                  string   `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
-	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Fix   string `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// commit, if set, is the commit the problem or fix relates to. Problems
+	// with a branch refer to the branch's head commit.
+	Commit               *Commit  `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3110,7 +3351,7 @@ func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
 func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
 func (*FsckResponse) ProtoMessage()    {}
 func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{43}
+	return fileDescriptor_21a7b2476cbc6216, []int{45}
 }
 func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3153,6 +3394,13 @@ func (m *FsckResponse) GetError() string {
 	return ""
 }
 
+func (m *FsckResponse) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
 type CreateFileSetResponse struct {
 	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3164,7 +3412,7 @@ func (m *CreateFileSetResponse) Reset()         { *m = CreateFileSetResponse{} }
 func (m *CreateFileSetResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateFileSetResponse) ProtoMessage()    {}
 func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{44}
+	return fileDescriptor_21a7b2476cbc6216, []int{46}
 }
 func (m *CreateFileSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3211,7 +3459,7 @@ func (m *GetFileSetRequest) Reset()         { *m = GetFileSetRequest{} }
 func (m *GetFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileSetRequest) ProtoMessage()    {}
 func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{45}
+	return fileDescriptor_21a7b2476cbc6216, []int{47}
 }
 func (m *GetFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3507,7 @@ func (m *AddFileSetRequest) Reset()         { *m = AddFileSetRequest{} }
 func (m *AddFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*AddFileSetRequest) ProtoMessage()    {}
 func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{46}
+	return fileDescriptor_21a7b2476cbc6216, []int{48}
 }
 func (m *AddFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3314,7 +3562,7 @@ func (m *RenewFileSetRequest) Reset()         { *m = RenewFileSetRequest{} }
 func (m *RenewFileSetRequest) String() string { return proto.CompactTextString(m) }
 func (*RenewFileSetRequest) ProtoMessage()    {}
 func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{47}
+	return fileDescriptor_21a7b2476cbc6216, []int{49}
 }
 func (m *RenewFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3367,7 +3615,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{48}
+	return fileDescriptor_21a7b2476cbc6216, []int{50}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3406,7 +3654,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{49}
+	return fileDescriptor_21a7b2476cbc6216, []int{51}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3448,7 +3696,7 @@ func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
 func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
 func (*RunLoadTestRequest) ProtoMessage()    {}
 func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{50}
+	return fileDescriptor_21a7b2476cbc6216, []int{52}
 }
 func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3513,7 +3761,7 @@ func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
 func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
 func (*RunLoadTestResponse) ProtoMessage()    {}
 func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{51}
+	return fileDescriptor_21a7b2476cbc6216, []int{53}
 }
 func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3582,6 +3830,7 @@ func init() {
 	proto.RegisterEnum("pfs_v2.FileType", FileType_name, FileType_value)
 	proto.RegisterEnum("pfs_v2.CommitState", CommitState_name, CommitState_value)
 	proto.RegisterEnum("pfs_v2.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterEnum("pfs_v2.Compression", Compression_name, Compression_value)
 	proto.RegisterType((*Repo)(nil), "pfs_v2.Repo")
 	proto.RegisterType((*Branch)(nil), "pfs_v2.Branch")
 	proto.RegisterType((*File)(nil), "pfs_v2.File")
@@ -3595,8 +3844,10 @@ func init() {
 	proto.RegisterType((*CommitInfo)(nil), "pfs_v2.CommitInfo")
 	proto.RegisterType((*CommitInfo_Details)(nil), "pfs_v2.CommitInfo.Details")
 	proto.RegisterType((*CommitSet)(nil), "pfs_v2.CommitSet")
+	proto.RegisterType((*CommitSetEdge)(nil), "pfs_v2.CommitSetEdge")
 	proto.RegisterType((*CommitSetInfo)(nil), "pfs_v2.CommitSetInfo")
 	proto.RegisterType((*FileInfo)(nil), "pfs_v2.FileInfo")
+	proto.RegisterMapType((map[string][]byte)(nil), "pfs_v2.FileInfo.DigestsEntry")
 	proto.RegisterType((*CreateRepoRequest)(nil), "pfs_v2.CreateRepoRequest")
 	proto.RegisterType((*InspectRepoRequest)(nil), "pfs_v2.InspectRepoRequest")
 	proto.RegisterType((*ListRepoRequest)(nil), "pfs_v2.ListRepoRequest")
@@ -3609,6 +3860,7 @@ func init() {
 	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs_v2.ListCommitSetRequest")
 	proto.RegisterType((*SquashCommitSetRequest)(nil), "pfs_v2.SquashCommitSetRequest")
 	proto.RegisterType((*DropCommitSetRequest)(nil), "pfs_v2.DropCommitSetRequest")
+	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs_v2.DeleteCommitRequest")
 	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs_v2.SubscribeCommitRequest")
 	proto.RegisterType((*ClearCommitRequest)(nil), "pfs_v2.ClearCommitRequest")
 	proto.RegisterType((*CreateBranchRequest)(nil), "pfs_v2.CreateBranchRequest")
@@ -3642,180 +3894,195 @@ func init() {
 func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
 
 var fileDescriptor_21a7b2476cbc6216 = []byte{
-	// 2764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x08, 0x8a, 0x1f, 0x8f, 0xfa, 0x80, 0x56, 0x8a, 0xc2, 0xd0, 0x89, 0xec, 0x41, 0x5b,
-	0xc7, 0x71, 0x12, 0xc9, 0x95, 0x63, 0xa7, 0xa9, 0x9b, 0x76, 0x28, 0x91, 0xb6, 0x18, 0xc9, 0x54,
-	0x0a, 0x4a, 0x4e, 0xdb, 0x64, 0x86, 0x03, 0x01, 0x4b, 0x11, 0x35, 0x08, 0x20, 0x00, 0x28, 0x55,
-	0x9d, 0x69, 0x8f, 0xed, 0xa1, 0xff, 0x40, 0x8f, 0xf9, 0x13, 0x3a, 0xfd, 0x2b, 0x72, 0xec, 0xb9,
-	0x87, 0x4e, 0xc6, 0xa7, 0x9e, 0x7b, 0xe8, 0xb9, 0xb3, 0x1f, 0xc0, 0x02, 0x20, 0x48, 0x51, 0x6e,
-	0x2e, 0x9c, 0xc5, 0xee, 0x7b, 0x6f, 0xdf, 0xbe, 0xaf, 0xfd, 0xbd, 0x25, 0x2c, 0x7b, 0x83, 0x60,
-	0xc7, 0x1b, 0x04, 0xdb, 0x9e, 0xef, 0x86, 0x2e, 0x2a, 0x79, 0x83, 0xa0, 0x7f, 0xb1, 0xdb, 0xb8,
-	0x75, 0xee, 0xba, 0xe7, 0x36, 0xde, 0xa1, 0xb3, 0x67, 0xe3, 0xc1, 0x0e, 0x1e, 0x79, 0xe1, 0x15,
-	0x23, 0x6a, 0xdc, 0xce, 0x2e, 0x86, 0xd6, 0x08, 0x07, 0xa1, 0x3e, 0xf2, 0x38, 0xc1, 0x56, 0x96,
-	0xe0, 0xd2, 0xd7, 0x3d, 0x0f, 0xfb, 0xc1, 0xb4, 0x75, 0x73, 0xec, 0xeb, 0xa1, 0xe5, 0x3a, 0x7c,
-	0x7d, 0xe3, 0xdc, 0x3d, 0x77, 0xe9, 0x70, 0x87, 0x8c, 0xf8, 0xec, 0xaa, 0x3e, 0x0e, 0x87, 0x3b,
-	0xe4, 0x87, 0x4d, 0xa8, 0x1f, 0x41, 0x51, 0xc3, 0x9e, 0x8b, 0x10, 0x14, 0x1d, 0x7d, 0x84, 0xeb,
-	0xd2, 0x1d, 0xe9, 0x5e, 0x55, 0xa3, 0x63, 0x32, 0x17, 0x5e, 0x79, 0xb8, 0x5e, 0x60, 0x73, 0x64,
-	0xfc, 0xd3, 0xe2, 0x5f, 0xbf, 0xb9, 0xbd, 0xa0, 0xb6, 0xa0, 0xb4, 0xe7, 0xeb, 0x8e, 0x31, 0x44,
-	0x77, 0xa0, 0xe8, 0x63, 0xcf, 0xa5, 0x7c, 0xb5, 0xdd, 0xa5, 0x6d, 0x76, 0xf6, 0x6d, 0x22, 0x53,
-	0xa3, 0x2b, 0xb1, 0xe4, 0x82, 0x90, 0xcc, 0xa5, 0xfc, 0x0a, 0x8a, 0x4f, 0x2d, 0x1b, 0xa3, 0xbb,
-	0x50, 0x32, 0xdc, 0xd1, 0xc8, 0x0a, 0xb9, 0x94, 0x95, 0x48, 0xca, 0x3e, 0x9d, 0xd5, 0xf8, 0x2a,
-	0x91, 0xe4, 0xe9, 0xe1, 0x30, 0x92, 0x44, 0xc6, 0x68, 0x03, 0x16, 0x4d, 0x3d, 0x1c, 0x8f, 0xea,
-	0x32, 0x9d, 0x64, 0x1f, 0xea, 0x7f, 0x0b, 0x50, 0x21, 0x2a, 0x74, 0x9c, 0x81, 0x3b, 0x87, 0x8a,
-	0x1f, 0x41, 0xd9, 0xf0, 0xb1, 0x1e, 0x62, 0x93, 0xca, 0xae, 0xed, 0x36, 0xb6, 0x99, 0x75, 0xb7,
-	0x23, 0xeb, 0x6e, 0x9f, 0x44, 0xee, 0xd1, 0x22, 0x52, 0xf4, 0x10, 0x36, 0x03, 0xeb, 0xf7, 0xb8,
-	0x7f, 0x76, 0x15, 0xe2, 0xa0, 0x3f, 0x26, 0xce, 0xe9, 0x9f, 0xb9, 0x63, 0xc7, 0xa4, 0xba, 0xc8,
-	0xda, 0x3a, 0x59, 0xdd, 0x23, 0x8b, 0xa7, 0x64, 0x6d, 0x8f, 0x2c, 0xa1, 0x3b, 0x50, 0x33, 0x71,
-	0x60, 0xf8, 0x96, 0x47, 0x7c, 0x55, 0x2f, 0x52, 0xad, 0x93, 0x53, 0xe8, 0x3e, 0x54, 0xce, 0xa8,
-	0x6d, 0x71, 0x50, 0x5f, 0xbc, 0x23, 0x27, 0xed, 0xc1, 0x6c, 0xae, 0xc5, 0xeb, 0xe8, 0xc7, 0x50,
-	0x25, 0xbe, 0xec, 0x5b, 0xce, 0xc0, 0xad, 0x97, 0xa8, 0xea, 0x1b, 0xc9, 0xf3, 0x35, 0xc7, 0xe1,
-	0x90, 0xd8, 0x40, 0xab, 0xe8, 0x7c, 0x84, 0x76, 0xa1, 0x6c, 0xe2, 0x50, 0xb7, 0xec, 0xa0, 0x5e,
-	0xa6, 0x0c, 0xf5, 0x24, 0x03, 0x21, 0xd9, 0x6e, 0xb1, 0x75, 0x2d, 0x22, 0x6c, 0xdc, 0x83, 0x32,
-	0x9f, 0x43, 0xef, 0x00, 0x88, 0x43, 0x53, 0x93, 0xca, 0x5a, 0x35, 0x3e, 0xa8, 0xfa, 0x25, 0x2c,
-	0x25, 0xf7, 0x45, 0x8f, 0xa0, 0xe6, 0x61, 0x7f, 0x64, 0x05, 0x81, 0xe5, 0x3a, 0x84, 0x5e, 0xbe,
-	0xb7, 0xb2, 0xbb, 0xbe, 0x4d, 0x95, 0xbe, 0xd8, 0xdd, 0xfe, 0x3c, 0x5e, 0xd3, 0x92, 0x74, 0xc4,
-	0xab, 0xbe, 0x6b, 0xe3, 0xa0, 0x5e, 0xb8, 0x23, 0x13, 0xaf, 0xd2, 0x0f, 0xf5, 0x9b, 0x02, 0x00,
-	0x33, 0x01, 0x95, 0x7d, 0x17, 0x4a, 0xcc, 0x10, 0xd9, 0xb0, 0xe1, 0x66, 0xe2, 0xab, 0x48, 0x85,
-	0xe2, 0x10, 0xeb, 0x91, 0x6b, 0xb3, 0xc1, 0x45, 0xd7, 0xd0, 0x36, 0x80, 0xe7, 0xbb, 0x17, 0xd8,
-	0xd1, 0x1d, 0x03, 0xd7, 0xe5, 0x5c, 0xb3, 0x27, 0x28, 0x08, 0x7d, 0x30, 0x3e, 0x8b, 0xe8, 0x8b,
-	0xf9, 0xf4, 0x82, 0x02, 0x3d, 0x81, 0x35, 0xd3, 0xf2, 0xb1, 0x11, 0xf6, 0x13, 0xdb, 0xe4, 0x7b,
-	0x57, 0x61, 0x84, 0x9f, 0x8b, 0xcd, 0xde, 0x83, 0x72, 0xe8, 0x5b, 0xe7, 0xe7, 0xd8, 0xe7, 0x3e,
-	0x5e, 0x8d, 0x58, 0x4e, 0xd8, 0xb4, 0x16, 0xad, 0xab, 0x7f, 0x84, 0x32, 0x9f, 0x43, 0x9b, 0x29,
-	0xf3, 0x54, 0x63, 0x73, 0x28, 0x20, 0xeb, 0xb6, 0x4d, 0xad, 0x51, 0xd1, 0xc8, 0x10, 0xdd, 0x82,
-	0xaa, 0xe1, 0xbb, 0x4e, 0x3f, 0xf0, 0xb0, 0xc1, 0xf3, 0xa8, 0x42, 0x26, 0x7a, 0x1e, 0x36, 0x48,
-	0xd2, 0x11, 0xf7, 0xf2, 0x48, 0xa5, 0x63, 0x54, 0x87, 0x32, 0x4b, 0x49, 0x12, 0xa1, 0x24, 0x02,
-	0xa2, 0x4f, 0xf5, 0x31, 0x2c, 0x31, 0xbb, 0x1e, 0xfb, 0xd6, 0xb9, 0xe5, 0xa0, 0xbb, 0x50, 0x7c,
-	0x69, 0x39, 0x26, 0x55, 0x61, 0x65, 0x17, 0x45, 0x7a, 0xb3, 0xd5, 0x43, 0xcb, 0x31, 0x35, 0xba,
-	0xae, 0x76, 0xa1, 0xc4, 0xf8, 0xe6, 0xf6, 0xea, 0x26, 0x14, 0x2c, 0xe6, 0xd3, 0xea, 0x5e, 0xe9,
-	0xd5, 0xbf, 0x6e, 0x17, 0x3a, 0x2d, 0xad, 0x60, 0x99, 0xbc, 0xb4, 0xfc, 0xb9, 0x04, 0xc0, 0x04,
-	0x46, 0xa1, 0x32, 0x57, 0x85, 0xf9, 0x00, 0x4a, 0x2e, 0x55, 0x8d, 0x07, 0xcb, 0x46, 0x9a, 0x8e,
-	0xa9, 0xad, 0x71, 0x9a, 0x6c, 0x2e, 0xcb, 0x93, 0xb9, 0xfc, 0x10, 0x96, 0x3d, 0xdd, 0xc7, 0x4e,
-	0xd8, 0xe7, 0xdb, 0x17, 0x73, 0xb7, 0x5f, 0x62, 0x44, 0xdc, 0x02, 0x0f, 0x61, 0xd9, 0x18, 0x5a,
-	0xb6, 0xd9, 0x17, 0x36, 0x96, 0xf3, 0x98, 0x28, 0x11, 0xfb, 0x08, 0x48, 0x09, 0x0b, 0x42, 0xdd,
-	0x27, 0x25, 0xac, 0x74, 0x7d, 0x09, 0xe3, 0xa4, 0xe8, 0x27, 0x50, 0x1d, 0x58, 0x8e, 0x15, 0x0c,
-	0x2d, 0xe7, 0x9c, 0x97, 0x83, 0x59, 0x7c, 0x82, 0x18, 0x3d, 0x86, 0x0a, 0xfb, 0xc0, 0x66, 0xbd,
-	0x72, 0x2d, 0x63, 0x4c, 0x9b, 0x9f, 0x08, 0xd5, 0x39, 0x13, 0x61, 0x03, 0x16, 0xb1, 0xef, 0xbb,
-	0x7e, 0x1d, 0x58, 0xb1, 0xa7, 0x1f, 0x33, 0xea, 0x70, 0x6d, 0x7a, 0x1d, 0xfe, 0x48, 0x94, 0xc1,
-	0x25, 0xae, 0x7e, 0xca, 0xbc, 0xf9, 0x85, 0xf0, 0x6f, 0xd2, 0xbc, 0x95, 0x10, 0xed, 0xc1, 0xaa,
-	0xe1, 0x8e, 0x3c, 0xdd, 0x08, 0x2d, 0xe7, 0xbc, 0x4f, 0x6e, 0x77, 0x1e, 0x53, 0x6f, 0x4d, 0xd8,
-	0xa9, 0xc5, 0x6f, 0x6e, 0x6d, 0x45, 0x70, 0x10, 0xdb, 0x11, 0x19, 0x17, 0xba, 0x6d, 0x99, 0xba,
-	0x90, 0x21, 0x5f, 0x2b, 0x43, 0x70, 0x10, 0x19, 0xea, 0x0f, 0xa0, 0xca, 0x4e, 0xd4, 0xc3, 0x21,
-	0x4f, 0x1a, 0x29, 0x9b, 0x34, 0xaa, 0x0b, 0xcb, 0x31, 0x11, 0x4d, 0x98, 0x07, 0x00, 0x2c, 0xfa,
-	0xfa, 0x01, 0x8e, 0x92, 0x66, 0x2d, 0x6d, 0xa1, 0x1e, 0x0e, 0xb5, 0xaa, 0x11, 0x8b, 0xfe, 0x40,
-	0xd4, 0x84, 0x02, 0x75, 0x27, 0x9a, 0x34, 0xa8, 0xa8, 0x13, 0xdf, 0x4a, 0x50, 0x21, 0x77, 0x7f,
-	0x74, 0x41, 0x0f, 0x2c, 0x1b, 0x67, 0x2f, 0x68, 0xb2, 0xae, 0xd1, 0x15, 0xf4, 0x21, 0x89, 0x53,
-	0x1b, 0xf7, 0x63, 0x38, 0xb2, 0xb2, 0xab, 0x24, 0xc9, 0x4e, 0xae, 0x3c, 0x4c, 0x82, 0x8c, 0x8d,
-	0x48, 0x58, 0xb3, 0x8d, 0x48, 0x3a, 0xc8, 0xd7, 0x87, 0x75, 0x4c, 0x9c, 0x71, 0x6a, 0x31, 0xeb,
-	0x54, 0x04, 0xc5, 0xa1, 0x1e, 0x0c, 0x69, 0xd5, 0x5b, 0xd2, 0xe8, 0x58, 0x75, 0x61, 0x6d, 0x9f,
-	0x22, 0x02, 0x0a, 0x28, 0xf0, 0xd7, 0x63, 0x1c, 0x84, 0x73, 0x60, 0x8e, 0x4c, 0xf1, 0x28, 0x4c,
-	0x16, 0x8f, 0x4d, 0x28, 0x8d, 0x3d, 0x53, 0x0f, 0x99, 0xd3, 0x2b, 0x1a, 0xff, 0x52, 0x1f, 0x03,
-	0xea, 0x38, 0xa4, 0x56, 0x87, 0x37, 0xda, 0x51, 0xfd, 0x11, 0xac, 0x1e, 0x59, 0x41, 0x8a, 0x29,
-	0x42, 0x78, 0x92, 0x40, 0x78, 0xea, 0x21, 0xac, 0xb5, 0xb0, 0x8d, 0x6f, 0x7a, 0x9e, 0x0d, 0x58,
-	0x1c, 0xb8, 0xbe, 0x81, 0xf9, 0xc5, 0xc2, 0x3e, 0xd4, 0x3f, 0x49, 0x80, 0x7a, 0xa4, 0xd8, 0xf0,
-	0xa2, 0xc5, 0xc5, 0xdd, 0x85, 0x12, 0x2b, 0x79, 0xd3, 0xea, 0x31, 0x5b, 0x9d, 0xc3, 0x48, 0xe2,
-	0xba, 0x90, 0x67, 0x5d, 0x17, 0xea, 0x5f, 0x24, 0x58, 0x7f, 0x4a, 0x8b, 0xd0, 0x84, 0x26, 0x73,
-	0xdd, 0x0c, 0xd7, 0x6b, 0x12, 0x17, 0x27, 0x39, 0x59, 0x9c, 0x62, 0xb3, 0x14, 0x93, 0x66, 0x39,
-	0x87, 0x0d, 0xee, 0xc2, 0xd7, 0xd3, 0xe6, 0x5d, 0x28, 0x5e, 0xea, 0x56, 0xc8, 0x53, 0x61, 0x3d,
-	0x93, 0x98, 0x21, 0x09, 0x46, 0x4a, 0xa0, 0xfe, 0x47, 0x82, 0x35, 0xe2, 0xf4, 0xf4, 0x36, 0xd7,
-	0x7b, 0x53, 0x85, 0xe2, 0xc0, 0x77, 0x47, 0xd3, 0x30, 0x13, 0x59, 0x43, 0x5b, 0x50, 0x08, 0xdd,
-	0xac, 0xd9, 0x39, 0x45, 0x21, 0x74, 0x49, 0xfc, 0x3a, 0xe3, 0xd1, 0x19, 0xf6, 0x79, 0x1e, 0xf1,
-	0x2f, 0x82, 0x1e, 0x7c, 0x7c, 0x81, 0xfd, 0x00, 0xd3, 0x3c, 0xaa, 0x68, 0xd1, 0x67, 0x04, 0x4d,
-	0x4a, 0x02, 0x9a, 0x3c, 0x84, 0x1a, 0xbb, 0x6c, 0xfb, 0x14, 0x46, 0x94, 0xa7, 0xc2, 0x08, 0x70,
-	0xe3, 0xb1, 0xda, 0x87, 0x37, 0x53, 0xd6, 0x25, 0x95, 0x8a, 0x9f, 0xfc, 0xe6, 0x75, 0x0d, 0x25,
-	0x4c, 0x5d, 0xe1, 0x56, 0xdd, 0x84, 0x0d, 0x61, 0x54, 0x21, 0x5d, 0xfd, 0x0c, 0x36, 0x7b, 0x5f,
-	0x8f, 0xf5, 0x28, 0xc6, 0xfe, 0x9f, 0x7d, 0xd5, 0x03, 0xd8, 0x68, 0xf9, 0xae, 0xf7, 0x3d, 0x48,
-	0xfa, 0xb7, 0x04, 0x9b, 0xbd, 0xf1, 0x19, 0x89, 0xd4, 0x33, 0x7c, 0xd3, 0x40, 0x10, 0x28, 0xb2,
-	0x90, 0x42, 0x91, 0x51, 0x80, 0xc8, 0x33, 0x02, 0xe4, 0x3d, 0x58, 0x0c, 0x48, 0x2c, 0x52, 0xff,
-	0x4f, 0x09, 0x53, 0x46, 0x11, 0x79, 0x7e, 0x71, 0xaa, 0xe7, 0x4b, 0x73, 0x79, 0xfe, 0x67, 0x80,
-	0xf6, 0x6d, 0xac, 0xfb, 0xaf, 0x95, 0x55, 0xea, 0x2b, 0x09, 0xd6, 0x59, 0x29, 0xe7, 0xc5, 0x83,
-	0xf3, 0x47, 0x0d, 0x84, 0x34, 0xa3, 0x81, 0xb8, 0x9b, 0xb2, 0xd3, 0x74, 0xd8, 0x7a, 0xd3, 0x46,
-	0x23, 0x81, 0xfd, 0x8b, 0xb3, 0xb1, 0x3f, 0xfa, 0x21, 0xac, 0x38, 0xf8, 0xb2, 0x9f, 0x88, 0x0e,
-	0x66, 0xce, 0x25, 0x07, 0x5f, 0xc6, 0x81, 0xa1, 0xfe, 0x3c, 0x2e, 0x3d, 0xe9, 0x43, 0xce, 0x89,
-	0xbb, 0xd5, 0x63, 0x56, 0x50, 0xd2, 0xcc, 0xd7, 0xc7, 0x51, 0x22, 0xe9, 0x0b, 0xa9, 0xa4, 0x57,
-	0x7b, 0xb0, 0xce, 0xee, 0x9b, 0xd7, 0xd2, 0x67, 0xca, 0xbd, 0xf3, 0x4f, 0x09, 0xca, 0x4d, 0xd3,
-	0xa4, 0xcf, 0x0b, 0xd1, 0xb3, 0x81, 0x94, 0xf7, 0x6c, 0x50, 0x48, 0x3c, 0x1b, 0xa0, 0x1d, 0x90,
-	0x7d, 0xfd, 0x92, 0xc7, 0xf4, 0xad, 0x09, 0xc4, 0x40, 0x31, 0xc0, 0x0b, 0xdd, 0x1e, 0xe3, 0x83,
-	0x05, 0x8d, 0x50, 0xa2, 0x0f, 0x41, 0x1e, 0xfb, 0x36, 0xf7, 0xcc, 0x5b, 0x91, 0x86, 0x7c, 0xe3,
-	0xed, 0x53, 0xed, 0xa8, 0xe7, 0x8e, 0x7d, 0x83, 0x92, 0x8f, 0x7d, 0xbb, 0xf1, 0x04, 0xaa, 0xf1,
-	0x1c, 0x09, 0xf9, 0x53, 0xed, 0x88, 0x6b, 0x45, 0x86, 0xe8, 0x6d, 0xa8, 0xfa, 0xd8, 0x18, 0xfb,
-	0x81, 0x75, 0x11, 0x1d, 0x47, 0x4c, 0xec, 0x55, 0xa0, 0x14, 0x50, 0x4e, 0xf5, 0x31, 0x00, 0xb3,
-	0xd8, 0xcd, 0x8e, 0xa7, 0xfe, 0x16, 0x2a, 0xfb, 0xae, 0x77, 0x45, 0xb9, 0x14, 0x90, 0xcd, 0x20,
-	0x8c, 0x76, 0x37, 0x83, 0x70, 0x8a, 0x49, 0xb6, 0x40, 0x0e, 0x7c, 0x83, 0x9b, 0x24, 0x0d, 0xcd,
-	0xc8, 0x02, 0xa9, 0x0f, 0xba, 0xe7, 0x61, 0xc7, 0xe4, 0x17, 0x1c, 0xff, 0x22, 0xb9, 0xb4, 0xf6,
-	0xdc, 0x35, 0xad, 0x01, 0xdd, 0x2e, 0x72, 0xea, 0x0e, 0x40, 0x80, 0xe3, 0x66, 0x28, 0x37, 0x9f,
-	0x0e, 0x16, 0xb4, 0x6a, 0x80, 0xa3, 0x5e, 0xe8, 0x03, 0xa8, 0xe8, 0xa6, 0xd9, 0xa7, 0xf0, 0xb0,
-	0x90, 0x8e, 0x7f, 0x6e, 0xe5, 0x83, 0x05, 0xad, 0xac, 0x73, 0x4f, 0x3f, 0x22, 0x97, 0x34, 0x31,
-	0x0c, 0x63, 0x60, 0x4a, 0xc7, 0x35, 0x43, 0xd8, 0xec, 0x60, 0x41, 0x03, 0x53, 0x58, 0x70, 0x87,
-	0xc0, 0x45, 0xef, 0x8a, 0x31, 0x31, 0x5f, 0x2a, 0x42, 0x29, 0x66, 0xb0, 0x83, 0x05, 0xad, 0x62,
-	0xf0, 0xf1, 0x5e, 0x09, 0x8a, 0x67, 0xae, 0x79, 0xa5, 0x7e, 0x05, 0x2b, 0xcf, 0x70, 0x98, 0x3c,
-	0xe0, 0xf5, 0x50, 0x96, 0xbb, 0xbd, 0x20, 0xdc, 0xbe, 0x09, 0x25, 0x77, 0x30, 0x20, 0xf9, 0xca,
-	0xde, 0x8d, 0xf8, 0x57, 0x02, 0xe7, 0xdd, 0x68, 0x07, 0xf5, 0x13, 0x86, 0xf3, 0x6e, 0xc4, 0xf4,
-	0x59, 0xb1, 0x52, 0x50, 0x64, 0xf5, 0x21, 0xac, 0x7e, 0xa1, 0xdb, 0x2f, 0x6f, 0xb6, 0x5f, 0x0f,
-	0x56, 0x9f, 0xd9, 0xee, 0x59, 0x92, 0x69, 0x5e, 0x1c, 0x53, 0x87, 0xb2, 0xa7, 0x87, 0x21, 0xf6,
-	0x23, 0x44, 0x15, 0x7d, 0xaa, 0x7f, 0x80, 0xd5, 0x96, 0x35, 0x18, 0x24, 0x85, 0xbe, 0x0b, 0x15,
-	0x52, 0xdf, 0xa6, 0x6a, 0x53, 0x76, 0xf0, 0x25, 0xf5, 0xe7, 0xbb, 0x50, 0x71, 0xed, 0x54, 0xd0,
-	0x64, 0x08, 0x5d, 0x9b, 0xc5, 0x4b, 0x1d, 0xca, 0xc1, 0x50, 0xb7, 0x6d, 0xf7, 0x92, 0x43, 0xec,
-	0xe8, 0x53, 0xb5, 0x41, 0x11, 0xdb, 0x07, 0x9e, 0xeb, 0x04, 0x18, 0xbd, 0x3f, 0xb1, 0x7f, 0xaa,
-	0x07, 0x61, 0x0d, 0x4e, 0xa4, 0xc3, 0xfb, 0x13, 0x3a, 0xe4, 0x10, 0x73, 0x3d, 0xd4, 0xdb, 0x50,
-	0x7b, 0x1a, 0x18, 0x2f, 0xa3, 0x83, 0x2a, 0x20, 0x0f, 0xac, 0xdf, 0xd1, 0x3d, 0x2a, 0x1a, 0x19,
-	0xaa, 0x8f, 0x61, 0x89, 0x11, 0x70, 0x55, 0x12, 0x14, 0x55, 0x4a, 0x21, 0xd0, 0x67, 0x21, 0x81,
-	0x3e, 0xd5, 0x8f, 0xe1, 0x0d, 0x76, 0xa1, 0x91, 0x6d, 0x28, 0x88, 0xe0, 0x02, 0xb6, 0xa0, 0x46,
-	0x1b, 0x2a, 0x92, 0x8d, 0x51, 0x47, 0xa8, 0xd1, 0x1e, 0x8b, 0x74, 0x80, 0xa6, 0xfa, 0x04, 0xd6,
-	0x78, 0x64, 0x27, 0xa0, 0xc7, 0xbc, 0xf7, 0xe8, 0x97, 0xb0, 0xc6, 0x93, 0xf3, 0xe6, 0xcc, 0x59,
-	0xcd, 0x0a, 0x59, 0xcd, 0x5e, 0xc0, 0xba, 0x86, 0xb9, 0x95, 0x13, 0xe2, 0xaf, 0x39, 0x10, 0xba,
-	0x0d, 0xb5, 0x30, 0xb4, 0xfb, 0x01, 0x36, 0x5c, 0xc7, 0x0c, 0xa8, 0x58, 0x59, 0x83, 0x30, 0xb4,
-	0x7b, 0x6c, 0x46, 0x7d, 0x03, 0xd6, 0x9b, 0x46, 0x68, 0x5d, 0xe8, 0x21, 0x6e, 0x8e, 0xc3, 0xe8,
-	0x1a, 0x22, 0x50, 0x2f, 0x3d, 0xcd, 0x0c, 0xa8, 0x9a, 0x80, 0xb4, 0xb1, 0x73, 0xe4, 0xea, 0xe6,
-	0x09, 0x0e, 0xc2, 0x44, 0x3f, 0x45, 0x1f, 0xd1, 0x78, 0x2d, 0x26, 0xe3, 0xb9, 0x91, 0x01, 0xe1,
-	0xc5, 0x38, 0x7a, 0x3c, 0xa6, 0x63, 0xf5, 0xef, 0x12, 0xac, 0xa7, 0xb6, 0xe1, 0xee, 0xfb, 0x9e,
-	0xf7, 0x11, 0xd1, 0x53, 0x4c, 0xf6, 0x2e, 0x8f, 0xa0, 0x12, 0xfd, 0xa9, 0x40, 0xa1, 0xc4, 0xcc,
-	0x77, 0x87, 0x98, 0xf4, 0x7e, 0x17, 0x40, 0xc0, 0x33, 0xf4, 0x26, 0xac, 0x1f, 0x6b, 0x9d, 0x67,
-	0x9d, 0x6e, 0xff, 0xb0, 0xd3, 0x6d, 0xf5, 0x4f, 0xbb, 0x87, 0xdd, 0xe3, 0x2f, 0xba, 0xca, 0x02,
-	0xaa, 0x40, 0xf1, 0xb4, 0xd7, 0xd6, 0x14, 0x89, 0x8c, 0x9a, 0xa7, 0x27, 0xc7, 0x4a, 0x81, 0x8c,
-	0x9e, 0xf6, 0xf6, 0x0f, 0x15, 0x19, 0x55, 0x61, 0xb1, 0x79, 0xd4, 0x69, 0xf6, 0x94, 0xe2, 0xfd,
-	0xf7, 0xd9, 0x53, 0x01, 0xed, 0xec, 0x97, 0xa0, 0xa2, 0xb5, 0x7b, 0x6d, 0xed, 0x45, 0xbb, 0xc5,
-	0x44, 0x3c, 0xed, 0x1c, 0xb5, 0x15, 0x09, 0x95, 0x41, 0x6e, 0x75, 0x34, 0xa5, 0x70, 0xff, 0x2b,
-	0xa8, 0x25, 0xe0, 0x25, 0xaa, 0xc3, 0xc6, 0xfe, 0xf1, 0xf3, 0xe7, 0x9d, 0x93, 0x7e, 0xef, 0xa4,
-	0x79, 0xd2, 0x4e, 0x6c, 0x5f, 0x83, 0x72, 0xef, 0xa4, 0xa9, 0x9d, 0xb4, 0x5b, 0x8a, 0x44, 0x76,
-	0xd3, 0xda, 0xcd, 0xd6, 0xaf, 0x95, 0x02, 0x5a, 0x86, 0xea, 0xd3, 0x4e, 0xb7, 0xd3, 0x3b, 0xe8,
-	0x74, 0x9f, 0x29, 0x32, 0xd9, 0x90, 0x7d, 0xb6, 0x5b, 0x4a, 0xf1, 0xfe, 0x13, 0xa8, 0xb6, 0xb0,
-	0x6d, 0x8d, 0xac, 0x10, 0xfb, 0x64, 0xf7, 0xee, 0x71, 0xb7, 0xcd, 0xf4, 0xf8, 0xac, 0x77, 0xdc,
-	0x65, 0x47, 0x39, 0xea, 0x74, 0xdb, 0x4a, 0x81, 0x68, 0xd4, 0xfb, 0xe5, 0x91, 0x22, 0x93, 0xc1,
-	0x7e, 0xef, 0x85, 0x52, 0xdc, 0xfd, 0x0e, 0x81, 0xdc, 0xfc, 0xbc, 0x83, 0x9a, 0x00, 0xe2, 0xc1,
-	0x00, 0xc5, 0xa8, 0x61, 0xe2, 0x11, 0xa1, 0xb1, 0x39, 0x61, 0xed, 0xf6, 0xc8, 0x0b, 0xaf, 0xd4,
-	0x05, 0xf4, 0x29, 0xd4, 0x12, 0x4f, 0x00, 0x28, 0x7e, 0xbb, 0x9a, 0x7c, 0x17, 0x68, 0x28, 0xd9,
-	0xe7, 0x7d, 0x75, 0x01, 0x7d, 0x02, 0x95, 0xe8, 0x25, 0x00, 0xbd, 0x19, 0xad, 0x67, 0xde, 0x06,
-	0xf2, 0x18, 0x1f, 0x48, 0x44, 0x79, 0xf1, 0x3a, 0x20, 0x94, 0x9f, 0x78, 0x31, 0x98, 0xa1, 0xfc,
-	0x13, 0xa8, 0x25, 0x9e, 0x04, 0x84, 0xf2, 0x93, 0xef, 0x04, 0x8d, 0x4c, 0x91, 0x50, 0x17, 0x50,
-	0x1b, 0x96, 0x92, 0x6d, 0x3c, 0xba, 0x25, 0xaa, 0xea, 0x44, 0x73, 0x3f, 0x43, 0x87, 0x7d, 0xa8,
-	0x25, 0x1a, 0x05, 0xa1, 0xc3, 0x64, 0xf7, 0x30, 0x53, 0xc8, 0x72, 0xaa, 0xcf, 0x44, 0x6f, 0x67,
-	0xfc, 0x90, 0x16, 0x94, 0xf3, 0x20, 0xa6, 0x2e, 0xa0, 0x5f, 0x00, 0x88, 0x5e, 0x52, 0x18, 0x74,
-	0xa2, 0x69, 0xcf, 0x67, 0x7f, 0x20, 0xa1, 0x0e, 0xac, 0x66, 0xba, 0x3b, 0xb4, 0x15, 0x9b, 0x34,
-	0xb7, 0xed, 0x9b, 0x2a, 0xea, 0x10, 0x94, 0x6c, 0xe3, 0x8c, 0x6e, 0xe7, 0x9e, 0x49, 0x54, 0xde,
-	0xa9, 0xc2, 0x0e, 0x60, 0x39, 0xd5, 0x24, 0x0b, 0xeb, 0xe4, 0xf5, 0xce, 0x8d, 0x37, 0x26, 0x7a,
-	0xd8, 0x84, 0x5a, 0xab, 0x99, 0xb6, 0x3a, 0x71, 0xc2, 0xdc, 0x7e, 0x7b, 0x86, 0xd3, 0x9e, 0xc1,
-	0x72, 0xaa, 0xaf, 0x16, 0x6a, 0xe5, 0xb5, 0xdb, 0x33, 0x04, 0xb5, 0x61, 0x29, 0xd9, 0x2c, 0x8a,
-	0x48, 0xcc, 0x69, 0x21, 0xe7, 0x0a, 0x22, 0x2e, 0x27, 0x1b, 0x44, 0x69, 0x41, 0x28, 0x5d, 0xd5,
-	0xd3, 0x41, 0xc4, 0x25, 0xa4, 0x82, 0x68, 0x0e, 0xf6, 0x07, 0x12, 0x39, 0x4c, 0xb2, 0x09, 0x13,
-	0x87, 0xc9, 0x69, 0xcd, 0x66, 0x1e, 0x06, 0x04, 0xe8, 0x17, 0x7a, 0x4c, 0x34, 0x02, 0xd3, 0x45,
-	0xdc, 0x93, 0xd0, 0x1e, 0x94, 0x39, 0xf6, 0x40, 0x9b, 0x91, 0x84, 0x34, 0xcc, 0x6e, 0xcc, 0xea,
-	0xcd, 0xf8, 0x79, 0x80, 0xb3, 0x9c, 0x34, 0xb5, 0xd7, 0x17, 0x23, 0xea, 0x2c, 0x55, 0x27, 0x5b,
-	0x67, 0x93, 0xb2, 0x26, 0xe0, 0x9d, 0xa8, 0xb3, 0x94, 0x37, 0x55, 0x67, 0xaf, 0x61, 0x7c, 0x20,
-	0x11, 0xd6, 0x08, 0x89, 0x0b, 0xd6, 0x0c, 0x36, 0x9f, 0xce, 0x1a, 0xe1, 0x71, 0xc1, 0x9a, 0x41,
-	0xe8, 0x53, 0x58, 0x9b, 0x50, 0x89, 0x60, 0xaf, 0x60, 0xcd, 0xe0, 0xf0, 0x46, 0x7d, 0x72, 0x81,
-	0x83, 0x22, 0x96, 0xac, 0x4b, 0x49, 0xc0, 0x24, 0x22, 0x29, 0x07, 0x5d, 0x35, 0xde, 0xce, 0x5f,
-	0x8c, 0xc4, 0xa1, 0x4f, 0xe9, 0x7d, 0x8b, 0x43, 0xdc, 0xb4, 0x6d, 0x34, 0x25, 0x66, 0x66, 0x84,
-	0xe3, 0x23, 0x28, 0x12, 0xd8, 0x8c, 0xe2, 0x97, 0xa7, 0x04, 0xca, 0x6e, 0x6c, 0xa4, 0x27, 0x13,
-	0x47, 0x78, 0x0e, 0xcb, 0x29, 0xd4, 0x3c, 0x2b, 0x90, 0xdf, 0x49, 0x67, 0x7d, 0x06, 0x67, 0xd3,
-	0x78, 0x3e, 0x88, 0x63, 0x31, 0x25, 0x6b, 0x02, 0x5f, 0x5f, 0x2b, 0x8b, 0x5c, 0xbe, 0x02, 0x58,
-	0xa3, 0xec, 0x7b, 0xc3, 0xbc, 0x55, 0x2b, 0x09, 0x9f, 0x85, 0x7b, 0x72, 0x40, 0xf5, 0x0c, 0x31,
-	0x07, 0x50, 0x4b, 0xe0, 0x52, 0x91, 0x18, 0x93, 0x98, 0xb8, 0x71, 0x2b, 0x77, 0x2d, 0x3e, 0xd3,
-	0x61, 0x0a, 0x48, 0xb7, 0xf0, 0x40, 0x1f, 0xdb, 0xe1, 0x54, 0x5f, 0xcf, 0x16, 0xb6, 0xf7, 0xf1,
-	0xb7, 0xaf, 0xb6, 0xa4, 0x7f, 0xbc, 0xda, 0x92, 0xbe, 0x7b, 0xb5, 0x25, 0xfd, 0xe6, 0xbd, 0x73,
-	0x2b, 0x1c, 0x8e, 0xcf, 0xb6, 0x0d, 0x77, 0xb4, 0xe3, 0xe9, 0xc6, 0xf0, 0xca, 0xc4, 0x7e, 0x72,
-	0x74, 0xb1, 0xbb, 0x13, 0xf8, 0xc6, 0x8e, 0x37, 0x08, 0xce, 0x4a, 0x74, 0x9f, 0x87, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xb7, 0x78, 0xc3, 0x97, 0xcb, 0x23, 0x00, 0x00,
+	// 3007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x19, 0x4d, 0x73, 0xdb, 0xc6,
+	0x55, 0x00, 0x28, 0x7e, 0x3c, 0x52, 0x16, 0xb4, 0x52, 0x14, 0x86, 0x8e, 0x65, 0x0f, 0xda, 0x3a,
+	0xfe, 0x48, 0x24, 0x57, 0x8e, 0x9d, 0x34, 0x6e, 0xda, 0xa1, 0x44, 0xda, 0x62, 0x24, 0x53, 0x2e,
+	0x28, 0x39, 0x6d, 0xd2, 0x29, 0x07, 0x22, 0x96, 0x14, 0x6a, 0x10, 0x40, 0x00, 0x50, 0xaa, 0x32,
+	0xd3, 0x1e, 0x7b, 0xe9, 0x1f, 0xe8, 0xa1, 0x87, 0x1c, 0x3a, 0xd3, 0x7b, 0xef, 0x3d, 0xf5, 0xd2,
+	0x63, 0xcf, 0x3d, 0x74, 0x3a, 0x3e, 0xf5, 0xd0, 0x73, 0xcf, 0x9d, 0xfd, 0x00, 0x16, 0x00, 0x3f,
+	0x44, 0xb9, 0xb9, 0x70, 0x16, 0xfb, 0x3e, 0xf6, 0xed, 0xfb, 0xda, 0xf7, 0x1e, 0x61, 0xc9, 0xeb,
+	0x07, 0x5b, 0x5e, 0x3f, 0xd8, 0xf4, 0x7c, 0x37, 0x74, 0x51, 0xde, 0xeb, 0x07, 0xdd, 0xb3, 0xed,
+	0xda, 0xf5, 0x81, 0xeb, 0x0e, 0x6c, 0xbc, 0x45, 0x77, 0x4f, 0x46, 0xfd, 0x2d, 0x3c, 0xf4, 0xc2,
+	0x0b, 0x86, 0x54, 0xbb, 0x99, 0x05, 0x86, 0xd6, 0x10, 0x07, 0xa1, 0x31, 0xf4, 0x38, 0xc2, 0x46,
+	0x16, 0xe1, 0xdc, 0x37, 0x3c, 0x0f, 0xfb, 0xc1, 0x34, 0xb8, 0x39, 0xf2, 0x8d, 0xd0, 0x72, 0x1d,
+	0x0e, 0x5f, 0x1b, 0xb8, 0x03, 0x97, 0x2e, 0xb7, 0xc8, 0x8a, 0xef, 0x2e, 0x1b, 0xa3, 0xf0, 0x74,
+	0x8b, 0xfc, 0xb0, 0x0d, 0xed, 0x43, 0xc8, 0xe9, 0xd8, 0x73, 0x11, 0x82, 0x9c, 0x63, 0x0c, 0x71,
+	0x55, 0xba, 0x25, 0xdd, 0x29, 0xe9, 0x74, 0x4d, 0xf6, 0xc2, 0x0b, 0x0f, 0x57, 0x65, 0xb6, 0x47,
+	0xd6, 0x9f, 0xe4, 0x7e, 0xff, 0xcd, 0xcd, 0x05, 0xad, 0x01, 0xf9, 0x1d, 0xdf, 0x70, 0x7a, 0xa7,
+	0xe8, 0x16, 0xe4, 0x7c, 0xec, 0xb9, 0x94, 0xae, 0xbc, 0x5d, 0xd9, 0x64, 0x77, 0xdf, 0x24, 0x3c,
+	0x75, 0x0a, 0x89, 0x39, 0xcb, 0x82, 0x33, 0xe7, 0xf2, 0x53, 0xc8, 0x3d, 0xb5, 0x6c, 0x8c, 0x6e,
+	0x43, 0xbe, 0xe7, 0x0e, 0x87, 0x56, 0xc8, 0xb9, 0x5c, 0x8b, 0xb8, 0xec, 0xd2, 0x5d, 0x9d, 0x43,
+	0x09, 0x27, 0xcf, 0x08, 0x4f, 0x23, 0x4e, 0x64, 0x8d, 0xd6, 0x60, 0xd1, 0x34, 0xc2, 0xd1, 0xb0,
+	0xaa, 0xd0, 0x4d, 0xf6, 0xa1, 0xfd, 0x57, 0x86, 0x22, 0x11, 0xa1, 0xe5, 0xf4, 0xdd, 0x39, 0x44,
+	0xfc, 0x10, 0x0a, 0x3d, 0x1f, 0x1b, 0x21, 0x36, 0x29, 0xef, 0xf2, 0x76, 0x6d, 0x93, 0x69, 0x77,
+	0x33, 0xd2, 0xee, 0xe6, 0x51, 0x64, 0x1e, 0x3d, 0x42, 0x45, 0x0f, 0x61, 0x3d, 0xb0, 0xbe, 0xc6,
+	0xdd, 0x93, 0x8b, 0x10, 0x07, 0xdd, 0x11, 0x31, 0x4e, 0xf7, 0xc4, 0x1d, 0x39, 0x26, 0x95, 0x45,
+	0xd1, 0x57, 0x09, 0x74, 0x87, 0x00, 0x8f, 0x09, 0x6c, 0x87, 0x80, 0xd0, 0x2d, 0x28, 0x9b, 0x38,
+	0xe8, 0xf9, 0x96, 0x47, 0x6c, 0x55, 0xcd, 0x51, 0xa9, 0x93, 0x5b, 0xe8, 0x1e, 0x14, 0x4f, 0xa8,
+	0x6e, 0x71, 0x50, 0x5d, 0xbc, 0xa5, 0x24, 0xf5, 0xc1, 0x74, 0xae, 0xc7, 0x70, 0xf4, 0x7d, 0x28,
+	0x11, 0x5b, 0x76, 0x2d, 0xa7, 0xef, 0x56, 0xf3, 0x54, 0xf4, 0xb5, 0xe4, 0xfd, 0xea, 0xa3, 0xf0,
+	0x94, 0xe8, 0x40, 0x2f, 0x1a, 0x7c, 0x85, 0xb6, 0xa1, 0x60, 0xe2, 0xd0, 0xb0, 0xec, 0xa0, 0x5a,
+	0xa0, 0x04, 0xd5, 0x24, 0x01, 0x41, 0xd9, 0x6c, 0x30, 0xb8, 0x1e, 0x21, 0xd6, 0xee, 0x40, 0x81,
+	0xef, 0xa1, 0x1b, 0x00, 0xe2, 0xd2, 0x54, 0xa5, 0x8a, 0x5e, 0x8a, 0x2f, 0xaa, 0x7d, 0x09, 0x95,
+	0xe4, 0xb9, 0xe8, 0x11, 0x94, 0x3d, 0xec, 0x0f, 0xad, 0x20, 0xb0, 0x5c, 0x87, 0xe0, 0x2b, 0x77,
+	0xae, 0x6d, 0xaf, 0x6e, 0x52, 0xa1, 0xcf, 0xb6, 0x37, 0x5f, 0xc4, 0x30, 0x3d, 0x89, 0x47, 0xac,
+	0xea, 0xbb, 0x36, 0x0e, 0xaa, 0xf2, 0x2d, 0x85, 0x58, 0x95, 0x7e, 0x68, 0xdf, 0xc8, 0x00, 0x4c,
+	0x05, 0x94, 0xf7, 0x6d, 0xc8, 0x33, 0x45, 0x64, 0xdd, 0x86, 0xab, 0x89, 0x43, 0x91, 0x06, 0xb9,
+	0x53, 0x6c, 0x44, 0xa6, 0xcd, 0x3a, 0x17, 0x85, 0xa1, 0x4d, 0x00, 0xcf, 0x77, 0xcf, 0xb0, 0x63,
+	0x38, 0x3d, 0x5c, 0x55, 0x26, 0xaa, 0x3d, 0x81, 0x41, 0xf0, 0x83, 0xd1, 0x49, 0x84, 0x9f, 0x9b,
+	0x8c, 0x2f, 0x30, 0xd0, 0x13, 0x58, 0x31, 0x2d, 0x1f, 0xf7, 0xc2, 0x6e, 0xe2, 0x98, 0xc9, 0xd6,
+	0x55, 0x19, 0xe2, 0x0b, 0x71, 0xd8, 0x5d, 0x28, 0x84, 0xbe, 0x35, 0x18, 0x60, 0x9f, 0xdb, 0x78,
+	0x39, 0x22, 0x39, 0x62, 0xdb, 0x7a, 0x04, 0xd7, 0x7e, 0x03, 0x05, 0xbe, 0x87, 0xd6, 0x53, 0xea,
+	0x29, 0xc5, 0xea, 0x50, 0x41, 0x31, 0x6c, 0x9b, 0x6a, 0xa3, 0xa8, 0x93, 0x25, 0xba, 0x0e, 0xa5,
+	0x9e, 0xef, 0x3a, 0xdd, 0xc0, 0xc3, 0x3d, 0x1e, 0x47, 0x45, 0xb2, 0xd1, 0xf1, 0x70, 0x8f, 0x04,
+	0x1d, 0x31, 0x2f, 0xf7, 0x54, 0xba, 0x46, 0x55, 0x28, 0xb0, 0x90, 0x24, 0x1e, 0x4a, 0x3c, 0x20,
+	0xfa, 0xd4, 0x1e, 0x43, 0x85, 0xe9, 0xf5, 0xd0, 0xb7, 0x06, 0x96, 0x83, 0x6e, 0x43, 0xee, 0x95,
+	0xe5, 0x98, 0x54, 0x84, 0x6b, 0xdb, 0x28, 0x92, 0x9b, 0x41, 0xf7, 0x2d, 0xc7, 0xd4, 0x29, 0x5c,
+	0x6b, 0x43, 0x9e, 0xd1, 0xcd, 0x6d, 0xd5, 0x75, 0x90, 0x2d, 0x66, 0xd3, 0xd2, 0x4e, 0xfe, 0xf5,
+	0x3f, 0x6f, 0xca, 0xad, 0x86, 0x2e, 0x5b, 0x26, 0x4f, 0x2d, 0x7f, 0xcd, 0x03, 0x30, 0x86, 0x91,
+	0xab, 0xcc, 0x95, 0x61, 0xde, 0x87, 0xbc, 0x4b, 0x45, 0xe3, 0xce, 0xb2, 0x96, 0xc6, 0x63, 0x62,
+	0xeb, 0x1c, 0x27, 0x1b, 0xcb, 0xca, 0x78, 0x2c, 0x3f, 0x84, 0x25, 0xcf, 0xf0, 0xb1, 0x13, 0x76,
+	0xf9, 0xf1, 0xb9, 0x89, 0xc7, 0x57, 0x18, 0x12, 0xd7, 0xc0, 0x43, 0x58, 0xea, 0x9d, 0x5a, 0xb6,
+	0xd9, 0x15, 0x3a, 0x56, 0x26, 0x11, 0x51, 0x24, 0xf6, 0x11, 0x90, 0x14, 0x16, 0x84, 0x86, 0x4f,
+	0x52, 0x58, 0xfe, 0xf2, 0x14, 0xc6, 0x51, 0xd1, 0xc7, 0x50, 0xea, 0x5b, 0x8e, 0x15, 0x9c, 0x5a,
+	0xce, 0x80, 0xa7, 0x83, 0x59, 0x74, 0x02, 0x19, 0x3d, 0x86, 0x22, 0xfb, 0xc0, 0x66, 0xb5, 0x78,
+	0x29, 0x61, 0x8c, 0x3b, 0x39, 0x10, 0x4a, 0x73, 0x06, 0xc2, 0x1a, 0x2c, 0x62, 0xdf, 0x77, 0xfd,
+	0x2a, 0xb0, 0x64, 0x4f, 0x3f, 0x66, 0xe4, 0xe1, 0xf2, 0xf4, 0x3c, 0xfc, 0xa1, 0x48, 0x83, 0x15,
+	0x2e, 0x7e, 0x4a, 0xbd, 0x93, 0x13, 0xe1, 0x7f, 0xa4, 0x79, 0x33, 0x21, 0xda, 0x81, 0xe5, 0x9e,
+	0x3b, 0xf4, 0x8c, 0x5e, 0x68, 0x39, 0x83, 0x2e, 0x79, 0xdd, 0xb9, 0x4f, 0xbd, 0x33, 0xa6, 0xa7,
+	0x06, 0x7f, 0xb9, 0xf5, 0x6b, 0x82, 0x82, 0xe8, 0x8e, 0xf0, 0x38, 0x33, 0x6c, 0xcb, 0x34, 0x04,
+	0x0f, 0xe5, 0x52, 0x1e, 0x82, 0x82, 0xf2, 0xb8, 0x01, 0xd0, 0xb7, 0x6c, 0xdc, 0xed, 0xb9, 0x23,
+	0x87, 0xf9, 0x9f, 0x42, 0xec, 0x68, 0xe3, 0x5d, 0xb2, 0x41, 0x62, 0xdf, 0xb4, 0x7c, 0x0e, 0x65,
+	0xc1, 0x5c, 0x34, 0x2d, 0x9f, 0x02, 0xb5, 0xef, 0x40, 0x89, 0x69, 0xa3, 0x83, 0x43, 0x1e, 0x70,
+	0x52, 0x36, 0xe0, 0xb4, 0x0e, 0x2c, 0xc5, 0x48, 0x4d, 0x73, 0x80, 0x49, 0xbe, 0xed, 0xfb, 0xee,
+	0x70, 0x4a, 0xa8, 0x51, 0x18, 0xda, 0x00, 0x39, 0x74, 0xa7, 0x64, 0x64, 0x39, 0x74, 0xb5, 0x3f,
+	0x48, 0x09, 0xae, 0x34, 0x84, 0x1f, 0x00, 0xb0, 0x78, 0xe8, 0x06, 0x38, 0x0a, 0xe3, 0x95, 0x34,
+	0x65, 0x07, 0x87, 0x7a, 0xa9, 0x17, 0x0b, 0xfc, 0xbe, 0xc8, 0x52, 0x32, 0x75, 0x30, 0x34, 0x6e,
+	0xe2, 0x38, 0x73, 0xa1, 0xfb, 0xb0, 0x88, 0xcd, 0x01, 0x0e, 0x78, 0xf2, 0x7f, 0x6b, 0x8c, 0x35,
+	0xb9, 0x9b, 0xce, 0x70, 0xb4, 0xbf, 0xc8, 0x50, 0x24, 0xa5, 0x4b, 0x54, 0x5f, 0x10, 0x7d, 0x66,
+	0xeb, 0x0b, 0x02, 0xd7, 0x29, 0x04, 0x7d, 0x00, 0x54, 0xe3, 0xdd, 0xb8, 0x9a, 0xba, 0xb6, 0xad,
+	0x26, 0xd1, 0x8e, 0x2e, 0x3c, 0x4c, 0x62, 0x84, 0xad, 0x48, 0x54, 0x32, 0xa9, 0x48, 0x34, 0x2b,
+	0x97, 0x47, 0x65, 0x8c, 0x9c, 0xf1, 0xc9, 0x5c, 0xd6, 0x27, 0x11, 0xe4, 0x4e, 0x8d, 0xe0, 0x94,
+	0xda, 0xb9, 0xa2, 0xd3, 0x35, 0xfa, 0x08, 0x0a, 0xa6, 0x35, 0xc0, 0x41, 0x18, 0x54, 0xf3, 0xf4,
+	0xe6, 0x37, 0x92, 0x92, 0xb1, 0x30, 0x60, 0xf0, 0xa6, 0x13, 0xfa, 0x17, 0x7a, 0x84, 0x5d, 0xfb,
+	0x04, 0x2a, 0x49, 0x00, 0x79, 0x57, 0x5e, 0xe1, 0x0b, 0xfe, 0xd8, 0x90, 0x25, 0x09, 0xd7, 0x33,
+	0xc3, 0x1e, 0xb1, 0x2b, 0x57, 0x74, 0xf6, 0xf1, 0x89, 0xfc, 0xb1, 0xa4, 0xb9, 0xb0, 0xb2, 0x4b,
+	0xab, 0x28, 0x5a, 0x84, 0xe1, 0xaf, 0x46, 0x38, 0x08, 0xe7, 0xa8, 0xd3, 0x32, 0x09, 0x57, 0x1e,
+	0x4f, 0xb8, 0xeb, 0x90, 0x1f, 0x79, 0xa6, 0x11, 0xb2, 0x40, 0x29, 0xea, 0xfc, 0x4b, 0x7b, 0x0c,
+	0xa8, 0xe5, 0x90, 0xf7, 0x2d, 0xbc, 0xd2, 0x89, 0xda, 0xf7, 0x60, 0xf9, 0xc0, 0x0a, 0x52, 0x44,
+	0x51, 0x55, 0x2c, 0x89, 0xaa, 0x58, 0xdb, 0x87, 0x95, 0x06, 0xb6, 0xf1, 0x55, 0xef, 0xb3, 0x06,
+	0x8b, 0x7d, 0xd7, 0xef, 0x61, 0xfe, 0x18, 0xb3, 0x0f, 0xed, 0xb7, 0x12, 0xa0, 0x0e, 0x49, 0xd0,
+	0x3c, 0x1e, 0x38, 0xbb, 0xdb, 0x90, 0x67, 0xcf, 0xc4, 0xb4, 0x37, 0x8c, 0x41, 0xe7, 0x50, 0x92,
+	0x78, 0x62, 0x95, 0x59, 0x4f, 0xac, 0xf6, 0x3b, 0x09, 0x56, 0x9f, 0xd2, 0xc4, 0x3d, 0x26, 0xc9,
+	0x5c, 0xaf, 0xe9, 0xe5, 0x92, 0xc4, 0x09, 0x5d, 0x49, 0x26, 0xf4, 0x58, 0x2d, 0xb9, 0xa4, 0x5a,
+	0x06, 0xb0, 0xc6, 0x4d, 0xf8, 0x66, 0xd2, 0xbc, 0x07, 0xb9, 0x73, 0xc3, 0x0a, 0x79, 0xfc, 0xad,
+	0x66, 0xe2, 0x3b, 0x24, 0xce, 0x48, 0x11, 0xb4, 0x3f, 0xca, 0xb0, 0x42, 0x8c, 0x9e, 0x3e, 0xe6,
+	0x72, 0x6b, 0x46, 0x79, 0x4f, 0xbe, 0x34, 0xef, 0x29, 0xd3, 0xf2, 0x1e, 0xf1, 0x5f, 0x67, 0x34,
+	0x3c, 0xc1, 0x3e, 0x0f, 0x5e, 0xfe, 0x45, 0x2a, 0x2e, 0x1f, 0x9f, 0x61, 0x3f, 0xc0, 0x34, 0x78,
+	0x8b, 0x7a, 0xf4, 0x19, 0x95, 0x73, 0x79, 0x51, 0xce, 0x3d, 0x84, 0x32, 0x2b, 0x50, 0xba, 0xb4,
+	0xf4, 0x2a, 0x4c, 0x2d, 0xbd, 0xc0, 0x8d, 0xd7, 0x99, 0xf4, 0x5a, 0xbc, 0x3c, 0xbd, 0x6a, 0x5d,
+	0x78, 0x3b, 0x65, 0x0f, 0x02, 0xe6, 0xba, 0xba, 0x7a, 0xae, 0x46, 0x09, 0xe3, 0x14, 0xb9, 0x1d,
+	0xd6, 0x61, 0x4d, 0x98, 0x41, 0x70, 0xd7, 0x3e, 0x83, 0xf5, 0xce, 0x57, 0x23, 0x23, 0xf2, 0xca,
+	0xff, 0xe7, 0x5c, 0x6d, 0x0f, 0xd6, 0x1a, 0xbe, 0xeb, 0x7d, 0x0b, 0x9c, 0x3e, 0x85, 0x55, 0x96,
+	0x02, 0xde, 0xc8, 0x3b, 0xb5, 0x7f, 0x4b, 0xb0, 0xde, 0x19, 0x9d, 0x90, 0xd0, 0x38, 0xc1, 0x57,
+	0xf5, 0x3c, 0x51, 0xea, 0xcb, 0xa9, 0x52, 0x3f, 0xf2, 0x48, 0x65, 0x86, 0x47, 0xde, 0x85, 0xc5,
+	0x80, 0x38, 0x3f, 0x75, 0xb8, 0x29, 0x71, 0xc1, 0x30, 0x22, 0x57, 0x5b, 0x9c, 0xea, 0x6a, 0xf9,
+	0x79, 0x5c, 0x4d, 0xfb, 0x21, 0xa0, 0x5d, 0x1b, 0x1b, 0xfe, 0x9b, 0x29, 0xea, 0xb5, 0x04, 0xab,
+	0xec, 0xed, 0xe0, 0xd9, 0x8a, 0xd3, 0x47, 0x5d, 0x9e, 0x34, 0xa3, 0xcb, 0xbb, 0x9d, 0xd2, 0xd3,
+	0xf4, 0xde, 0xe2, 0xaa, 0xdd, 0x60, 0xa2, 0x41, 0xcb, 0xcd, 0x6e, 0xd0, 0xd0, 0x77, 0xe1, 0x9a,
+	0x83, 0xcf, 0xbb, 0x09, 0xe7, 0x62, 0xea, 0xac, 0x38, 0xf8, 0x3c, 0xf6, 0x2b, 0xed, 0x47, 0x71,
+	0xae, 0x4b, 0x5f, 0x72, 0xce, 0xe6, 0x48, 0x3b, 0x64, 0x19, 0x2c, 0x4d, 0x7c, 0xb9, 0x1f, 0x25,
+	0xb2, 0x8c, 0x9c, 0xca, 0x32, 0x5a, 0x27, 0xf2, 0xee, 0x37, 0x92, 0x67, 0xca, 0x43, 0xf7, 0x0f,
+	0x09, 0x0a, 0x75, 0xd3, 0xa4, 0x33, 0xa0, 0x68, 0xb6, 0x23, 0x4d, 0x9a, 0xed, 0xc8, 0x89, 0xd9,
+	0x0e, 0xda, 0x02, 0xc5, 0x37, 0xce, 0xb9, 0x4f, 0x5f, 0x1f, 0xab, 0x8b, 0x68, 0xa5, 0xf3, 0x92,
+	0x54, 0x1a, 0x7b, 0x0b, 0x3a, 0xc1, 0x44, 0x1f, 0x80, 0x32, 0xf2, 0x6d, 0x6e, 0x99, 0x77, 0x22,
+	0x09, 0xf9, 0xc1, 0x9b, 0xc7, 0xfa, 0x41, 0xc7, 0x1d, 0xf9, 0x3d, 0x8a, 0x3e, 0xf2, 0xed, 0xda,
+	0x13, 0x28, 0xc5, 0x7b, 0xc4, 0xe5, 0x8f, 0xf5, 0x83, 0xa8, 0xa8, 0x39, 0xd6, 0x0f, 0xd0, 0xbb,
+	0x50, 0xf2, 0x71, 0x6f, 0xe4, 0x07, 0xd6, 0x59, 0x74, 0x1d, 0xb1, 0xb1, 0x53, 0x84, 0x7c, 0x40,
+	0x29, 0xb5, 0xc7, 0x00, 0x4c, 0x63, 0x57, 0xbb, 0x9e, 0xf6, 0x4b, 0x28, 0xee, 0xba, 0xde, 0x05,
+	0xa5, 0x52, 0x41, 0x31, 0x83, 0x30, 0x3a, 0xdd, 0x0c, 0xc2, 0x29, 0x2a, 0xd9, 0x00, 0x25, 0xf0,
+	0x7b, 0x5c, 0x25, 0xe9, 0x02, 0x94, 0x00, 0x48, 0x7e, 0x30, 0x3c, 0x0f, 0x3b, 0x26, 0x7f, 0x51,
+	0xf9, 0x17, 0x89, 0xa5, 0x95, 0xe7, 0xae, 0x69, 0xf5, 0xe9, 0x71, 0x91, 0x51, 0xb7, 0x00, 0x02,
+	0x1c, 0x77, 0xac, 0x13, 0xe3, 0x69, 0x6f, 0x41, 0x2f, 0x05, 0x38, 0x6a, 0x58, 0xdf, 0x87, 0xa2,
+	0x61, 0x9a, 0x5d, 0x5a, 0x04, 0xcb, 0x69, 0xff, 0xe7, 0x5a, 0xde, 0x5b, 0xd0, 0x0b, 0x06, 0xb7,
+	0xf4, 0x23, 0x52, 0x15, 0x10, 0xc5, 0x30, 0x02, 0x26, 0x74, 0x9c, 0x33, 0x84, 0xce, 0xf6, 0x16,
+	0x74, 0x30, 0x85, 0x06, 0xb7, 0x48, 0x51, 0xec, 0x5d, 0x30, 0x22, 0x66, 0x4b, 0x55, 0x08, 0xc5,
+	0x14, 0xb6, 0xb7, 0xa0, 0x17, 0x7b, 0x7c, 0xbd, 0x93, 0x87, 0xdc, 0x89, 0x6b, 0x5e, 0x68, 0x7f,
+	0x96, 0xe0, 0xda, 0x33, 0x1c, 0x26, 0x6f, 0x78, 0x79, 0xc5, 0xce, 0xed, 0x2e, 0x0b, 0xbb, 0xaf,
+	0x43, 0xde, 0xed, 0xf7, 0x49, 0xc0, 0xb2, 0xe9, 0x1e, 0xff, 0x22, 0xd7, 0x21, 0x5d, 0x9b, 0x8f,
+	0xe9, 0xe8, 0x6a, 0x42, 0x16, 0x8d, 0x40, 0x7a, 0x12, 0x2f, 0x53, 0xa9, 0x2f, 0x66, 0xe7, 0x68,
+	0x2f, 0xe2, 0x7a, 0xf5, 0x6a, 0x72, 0x57, 0x45, 0x35, 0xcf, 0x46, 0x67, 0xd1, 0xa7, 0x36, 0x62,
+	0x95, 0xec, 0xd5, 0xd8, 0xdd, 0x00, 0xf0, 0x8c, 0x01, 0xee, 0x86, 0xee, 0x2b, 0x1c, 0x0d, 0x2b,
+	0x4b, 0x64, 0xe7, 0x88, 0x6c, 0x90, 0xe6, 0x91, 0x82, 0xe9, 0x80, 0x88, 0x37, 0x8f, 0x64, 0xa3,
+	0x63, 0x7d, 0x8d, 0x3f, 0xcb, 0x15, 0x65, 0x55, 0xd1, 0x1e, 0xc2, 0xf2, 0xe7, 0x86, 0xfd, 0xea,
+	0x4a, 0xc7, 0x6a, 0x1d, 0x58, 0x7e, 0x66, 0xbb, 0x27, 0x49, 0xa2, 0x79, 0xab, 0xbc, 0x2a, 0x14,
+	0x3c, 0x23, 0x0c, 0xb1, 0x1f, 0xd5, 0x9b, 0xd1, 0xa7, 0xf6, 0x6b, 0x58, 0x6e, 0x58, 0xfd, 0x7e,
+	0x92, 0xe9, 0x7b, 0x50, 0x24, 0xc9, 0x78, 0xaa, 0x34, 0x05, 0x07, 0x9f, 0x53, 0xe7, 0x7b, 0x0f,
+	0x8a, 0xae, 0x9d, 0xf2, 0xf0, 0x0c, 0xa2, 0x6b, 0x33, 0xe7, 0xae, 0x42, 0x21, 0x38, 0x35, 0x6c,
+	0xdb, 0x3d, 0xe7, 0x0d, 0x48, 0xf4, 0xa9, 0xd9, 0xa0, 0x8a, 0xe3, 0x03, 0xcf, 0x75, 0x02, 0x8c,
+	0xee, 0x8f, 0x9d, 0xaf, 0x66, 0x9b, 0x2f, 0x21, 0xc3, 0xfd, 0x31, 0x19, 0x26, 0x20, 0x73, 0x39,
+	0xb4, 0x3a, 0x94, 0x9f, 0x06, 0xbd, 0x57, 0xd1, 0x45, 0x55, 0x50, 0xfa, 0xd6, 0xaf, 0xe8, 0x19,
+	0x45, 0x9d, 0x2c, 0xe3, 0xc7, 0x40, 0x9e, 0xda, 0xfa, 0xfc, 0x02, 0x2a, 0x8c, 0x05, 0x17, 0x36,
+	0xc1, 0xa3, 0xc4, 0x78, 0xc4, 0xd5, 0xbb, 0x9c, 0xac, 0xde, 0x85, 0xa5, 0x94, 0x99, 0x0f, 0xf9,
+	0x47, 0xf0, 0x16, 0x7b, 0xc7, 0x89, 0xc0, 0xb4, 0xf4, 0xe2, 0x07, 0x6d, 0x40, 0x99, 0x76, 0xcb,
+	0x24, 0x09, 0x45, 0x13, 0x07, 0x36, 0xb2, 0xe8, 0xe0, 0xb0, 0x65, 0x6a, 0x4f, 0x60, 0x85, 0xc7,
+	0x73, 0xa2, 0x60, 0x9b, 0xb7, 0x7c, 0xf8, 0x12, 0x56, 0x78, 0x4e, 0xba, 0x3a, 0x71, 0x56, 0x32,
+	0x39, 0x2b, 0xd9, 0x4b, 0x58, 0xd5, 0x31, 0xb7, 0x57, 0x82, 0xfd, 0x25, 0x17, 0x42, 0x37, 0xa1,
+	0x1c, 0x86, 0x76, 0x37, 0xc0, 0x3d, 0xd7, 0x31, 0x03, 0xca, 0x56, 0xd1, 0x21, 0x0c, 0xed, 0x0e,
+	0xdb, 0xd1, 0xde, 0x82, 0xd5, 0x7a, 0x2f, 0xb4, 0xce, 0x8c, 0x10, 0xd7, 0x47, 0x61, 0xf4, 0xfa,
+	0x92, 0x02, 0x39, 0xbd, 0xcd, 0x14, 0xa8, 0x99, 0x80, 0xf4, 0x91, 0x73, 0xe0, 0x1a, 0xe6, 0x11,
+	0x0e, 0xc2, 0x44, 0xdf, 0x4a, 0x07, 0xbc, 0xfc, 0x09, 0x22, 0xeb, 0xb9, 0x0b, 0x22, 0x42, 0x8b,
+	0x71, 0xf4, 0xc7, 0x06, 0x5d, 0x93, 0xbc, 0xba, 0x9a, 0x3a, 0x86, 0x9b, 0xef, 0x5b, 0x3e, 0x47,
+	0x78, 0x59, 0x2e, 0xe9, 0x65, 0x8f, 0xa0, 0x18, 0xfd, 0xe1, 0x45, 0x33, 0xcf, 0xcc, 0x99, 0x58,
+	0x8c, 0x7a, 0xaf, 0x0d, 0x20, 0xaa, 0x52, 0xf4, 0x36, 0xac, 0x1e, 0xea, 0xad, 0x67, 0xad, 0x76,
+	0x77, 0xbf, 0xd5, 0x6e, 0x74, 0x8f, 0xdb, 0xfb, 0xed, 0xc3, 0xcf, 0xdb, 0xea, 0x02, 0x2a, 0x42,
+	0xee, 0xb8, 0xd3, 0xd4, 0x55, 0x89, 0xac, 0xea, 0xc7, 0x47, 0x87, 0xaa, 0x4c, 0x56, 0x4f, 0x3b,
+	0xbb, 0xfb, 0xaa, 0x82, 0x4a, 0xb0, 0x58, 0x3f, 0x68, 0xd5, 0x3b, 0x6a, 0xee, 0xde, 0x7d, 0x36,
+	0x07, 0xa2, 0x63, 0x9b, 0x0a, 0x14, 0xf5, 0x66, 0xa7, 0xa9, 0xbf, 0x6c, 0x36, 0x18, 0x8b, 0xa7,
+	0xad, 0x83, 0xa6, 0x2a, 0xa1, 0x02, 0x28, 0x8d, 0x96, 0xae, 0xca, 0xf7, 0x7e, 0x0e, 0xe5, 0x44,
+	0x55, 0x8d, 0xaa, 0xb0, 0xb6, 0x7b, 0xf8, 0xfc, 0x79, 0xeb, 0xa8, 0xdb, 0x39, 0xaa, 0x1f, 0x35,
+	0x13, 0xc7, 0x97, 0xa1, 0xd0, 0x39, 0xaa, 0xeb, 0x47, 0xcd, 0x86, 0x2a, 0x91, 0xd3, 0xf4, 0x66,
+	0xbd, 0xf1, 0x33, 0x55, 0x46, 0x4b, 0x50, 0x7a, 0xda, 0x6a, 0xb7, 0x3a, 0x7b, 0xad, 0xf6, 0x33,
+	0x55, 0x21, 0x07, 0xb2, 0xcf, 0x66, 0x43, 0xcd, 0xdd, 0x7b, 0x02, 0xa5, 0x06, 0xb6, 0xad, 0xa1,
+	0x15, 0x62, 0x9f, 0x9c, 0xde, 0x3e, 0x6c, 0x37, 0x99, 0x1c, 0x9f, 0x75, 0x0e, 0xdb, 0xec, 0x2a,
+	0x07, 0xad, 0x76, 0x53, 0x95, 0x89, 0x44, 0x9d, 0x9f, 0x1c, 0xa8, 0x0a, 0x59, 0xec, 0x76, 0x5e,
+	0xaa, 0xb9, 0x7b, 0x77, 0xa9, 0x68, 0xf1, 0xeb, 0xa4, 0x42, 0xe5, 0xb8, 0xbd, 0x7b, 0xf8, 0xfc,
+	0x85, 0xde, 0xec, 0x74, 0xa2, 0xeb, 0x3c, 0xfb, 0xa2, 0xf5, 0x42, 0x95, 0xb6, 0xff, 0xb4, 0x0a,
+	0x4a, 0xfd, 0x45, 0x0b, 0xd5, 0x01, 0xc4, 0x0c, 0x07, 0xc5, 0x75, 0xd5, 0xd8, 0x5c, 0xa7, 0xb6,
+	0x3e, 0x66, 0x98, 0xe6, 0xd0, 0x0b, 0x2f, 0xb4, 0x05, 0xf4, 0x29, 0x94, 0x13, 0x53, 0x19, 0x14,
+	0x8f, 0x60, 0xc7, 0x47, 0x35, 0x35, 0x35, 0xfb, 0x2f, 0x95, 0xb6, 0x80, 0x7e, 0x00, 0xc5, 0x68,
+	0x38, 0x83, 0xde, 0x8e, 0xe0, 0x99, 0x71, 0xcd, 0x24, 0xc2, 0x07, 0x12, 0x11, 0x5e, 0x0c, 0x6c,
+	0x84, 0xf0, 0x63, 0x43, 0x9c, 0x19, 0xc2, 0x3f, 0x81, 0x72, 0x62, 0x4a, 0x23, 0x84, 0x1f, 0x1f,
+	0xdd, 0xd4, 0x32, 0xf9, 0x44, 0x5b, 0x40, 0x4d, 0xa8, 0x24, 0x27, 0x2b, 0xe8, 0xba, 0x48, 0xe5,
+	0x63, 0xf3, 0x96, 0x19, 0x32, 0xec, 0x42, 0x39, 0xd1, 0x4a, 0x09, 0x19, 0xc6, 0xfb, 0xab, 0x99,
+	0x4c, 0x96, 0x52, 0x8d, 0x3c, 0x7a, 0x37, 0x63, 0x87, 0x34, 0xa3, 0x09, 0x53, 0x54, 0x6d, 0x01,
+	0xfd, 0x18, 0x40, 0x34, 0xeb, 0x42, 0xa1, 0x63, 0x73, 0x94, 0xc9, 0xe4, 0x0f, 0x24, 0xd4, 0x82,
+	0xe5, 0x4c, 0xff, 0x8b, 0x36, 0x62, 0x95, 0x4e, 0x6c, 0x8c, 0xa7, 0xb2, 0xda, 0x07, 0x35, 0x3b,
+	0x99, 0x40, 0x37, 0x27, 0xde, 0x49, 0x24, 0xe9, 0xa9, 0xcc, 0xf6, 0x60, 0x29, 0x35, 0x85, 0x10,
+	0xda, 0x99, 0x34, 0x9c, 0xa8, 0x8d, 0xcf, 0x8d, 0x13, 0x62, 0x2d, 0x67, 0xe6, 0x16, 0x89, 0x1b,
+	0x4e, 0x1c, 0x68, 0xcc, 0x30, 0xda, 0x33, 0x58, 0x4a, 0x0d, 0x2e, 0x84, 0x58, 0x93, 0xe6, 0x19,
+	0x33, 0x18, 0x35, 0xa1, 0x92, 0x9c, 0x5b, 0x08, 0x4f, 0x9c, 0x30, 0xcd, 0x98, 0xcd, 0x26, 0xd9,
+	0x95, 0x0b, 0x36, 0x13, 0x7a, 0xf5, 0xb9, 0x7c, 0x91, 0xf3, 0xc9, 0xfa, 0x62, 0x9a, 0x11, 0x4a,
+	0xbf, 0x23, 0x69, 0x5f, 0xe4, 0x1c, 0x52, 0xbe, 0x38, 0x07, 0xf9, 0x03, 0x49, 0xe8, 0x24, 0x7b,
+	0x99, 0x09, 0x3d, 0xf0, 0xcc, 0xcb, 0x80, 0xe8, 0xae, 0x84, 0x1c, 0x63, 0x1d, 0xd7, 0x74, 0x16,
+	0x77, 0x24, 0xb4, 0x03, 0x05, 0x5e, 0xed, 0xa0, 0xf5, 0x88, 0x43, 0xba, 0x9d, 0xa9, 0xcd, 0x6a,
+	0x82, 0xf9, 0x7d, 0x80, 0x93, 0x1c, 0xd5, 0xf5, 0x37, 0x67, 0x23, 0xd2, 0x35, 0x15, 0x27, 0x9b,
+	0xae, 0x93, 0xbc, 0xc6, 0x4a, 0x53, 0x91, 0xae, 0x29, 0x6d, 0x2a, 0x5d, 0x5f, 0x42, 0xf8, 0x40,
+	0x22, 0xa4, 0x51, 0x17, 0x21, 0x48, 0x33, 0x7d, 0xc5, 0x74, 0xd2, 0xa8, 0x97, 0x10, 0xa4, 0x99,
+	0xee, 0x62, 0x0a, 0x69, 0x1d, 0x8a, 0x51, 0xc9, 0x2e, 0x48, 0x33, 0x3d, 0x44, 0xad, 0x3a, 0x0e,
+	0xe0, 0x65, 0x18, 0x8b, 0xf9, 0x4a, 0xb2, 0x44, 0x13, 0x9e, 0x34, 0xa1, 0x9e, 0xab, 0xbd, 0x3b,
+	0x19, 0x18, 0xb1, 0x43, 0x9f, 0xd2, 0x17, 0x1e, 0x87, 0xb8, 0x6e, 0xdb, 0x68, 0x8a, 0xcf, 0xcc,
+	0x70, 0xc7, 0x47, 0x90, 0x23, 0x05, 0x3d, 0x8a, 0x9b, 0xd3, 0x44, 0x87, 0x50, 0x5b, 0x4b, 0x6f,
+	0x26, 0xae, 0xf0, 0x1c, 0x96, 0x52, 0x75, 0xfa, 0x2c, 0x47, 0xbe, 0x91, 0x8e, 0xfa, 0x4c, 0x65,
+	0x4f, 0xfd, 0x79, 0x2f, 0xf6, 0xc5, 0x14, 0xaf, 0xb1, 0x8a, 0xfe, 0x52, 0x5e, 0xe4, 0x0d, 0x17,
+	0xa5, 0x3c, 0xca, 0x0e, 0x76, 0xe6, 0x4d, 0x7e, 0xc9, 0x82, 0x5d, 0x98, 0x67, 0x42, 0x19, 0x3f,
+	0x83, 0xcd, 0x1e, 0x94, 0x13, 0x95, 0xb0, 0x08, 0x8c, 0xf1, 0x2a, 0xbc, 0x76, 0x7d, 0x22, 0x2c,
+	0xbe, 0xd3, 0x7e, 0xaa, 0x74, 0x6f, 0xe0, 0xbe, 0x31, 0xb2, 0xc3, 0xa9, 0xb6, 0x9e, 0xcd, 0x6c,
+	0xe7, 0xa3, 0xbf, 0xbd, 0xde, 0x90, 0xfe, 0xfe, 0x7a, 0x43, 0xfa, 0xd7, 0xeb, 0x0d, 0xe9, 0x8b,
+	0xbb, 0x03, 0x2b, 0x3c, 0x1d, 0x9d, 0x6c, 0xf6, 0xdc, 0xe1, 0x96, 0x67, 0xf4, 0x4e, 0x2f, 0x4c,
+	0xec, 0x27, 0x57, 0x67, 0xdb, 0x5b, 0x81, 0xdf, 0xdb, 0xf2, 0xfa, 0xc1, 0x49, 0x9e, 0x9e, 0xf3,
+	0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x7c, 0x71, 0xf0, 0xd9, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3858,6 +4125,10 @@ type APIClient interface {
 	SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
 	DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DeleteCommit deletes a single commit, reparenting its children onto its
+	// parent. It fails if other commits in the commit's CommitSet are downstream
+	// of it.
+	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// CreateBranch creates a new branch.
 	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// InspectBranch returns info about a branch.
@@ -4152,6 +4423,15 @@ func (c *aPIClient) DropCommitSet(ctx context.Context, in *DropCommitSetRequest,
 	return out, nil
 }
 
+func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateBranch", in, out, opts...)
@@ -4605,6 +4885,10 @@ type APIServer interface {
 	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*types.Empty, error)
 	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
 	DropCommitSet(context.Context, *DropCommitSetRequest) (*types.Empty, error)
+	// DeleteCommit deletes a single commit, reparenting its children onto its
+	// parent. It fails if other commits in the commit's CommitSet are downstream
+	// of it.
+	DeleteCommit(context.Context, *DeleteCommitRequest) (*types.Empty, error)
 	// CreateBranch creates a new branch.
 	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
 	// InspectBranch returns info about a branch.
@@ -4696,6 +4980,9 @@ func (*UnimplementedAPIServer) SquashCommitSet(ctx context.Context, req *SquashC
 func (*UnimplementedAPIServer) DropCommitSet(ctx context.Context, req *DropCommitSetRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
 }
+func (*UnimplementedAPIServer) DeleteCommit(ctx context.Context, req *DeleteCommitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommit not implemented")
+}
 func (*UnimplementedAPIServer) CreateBranch(ctx context.Context, req *CreateBranchRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
 }
@@ -5031,20 +5318,38 @@ func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBranchRequest)
+func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).CreateBranch(ctx, in)
+		return srv.(APIServer).DeleteCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pfs_v2.API/CreateBranch",
+		FullMethod: "/pfs_v2.API/DeleteCommit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+		return srv.(APIServer).DeleteCommit(ctx, req.(*DeleteCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CreateBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5489,6 +5794,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropCommitSet",
 			Handler:    _API_DropCommitSet_Handler,
 		},
+		{
+			MethodName: "DeleteCommit",
+			Handler:    _API_DeleteCommit_Handler,
+		},
 		{
 			MethodName: "CreateBranch",
 			Handler:    _API_CreateBranch_Handler,
@@ -6369,6 +6678,16 @@ func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DirCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.DirCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FileCount != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ValidatingTime != nil {
 		{
 			size, err := m.ValidatingTime.MarshalToSizedBuffer(dAtA[:i])
@@ -6435,6 +6754,57 @@ func (m *CommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitSetEdge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSetEdge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitSetEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6459,6 +6829,20 @@ func (m *CommitSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Commits) > 0 {
 		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6512,6 +6896,27 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Digests) > 0 {
+		for k := range m.Digests {
+			v := m.Digests[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -6923,6 +7328,18 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CommitSet != nil {
+		{
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.OriginKind != 0 {
 		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
 		i--
@@ -7146,6 +7563,45 @@ func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7871,6 +8327,16 @@ func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Offset != 0 {
 		i = encodeVarintPfs(dAtA, i, uint64(m.Offset))
 		i--
@@ -7922,6 +8388,15 @@ func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Digests) > 0 {
+		for iNdEx := len(m.Digests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Digests[iNdEx])
+			copy(dAtA[i:], m.Digests[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Digests[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.File != nil {
 		{
 			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
@@ -7961,6 +8436,18 @@ func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PageSize != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.File != nil {
 		{
 			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
@@ -8197,6 +8684,18 @@ func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Fix {
 		i--
 		if m.Fix {
@@ -8234,6 +8733,18 @@ func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -8927,6 +9438,12 @@ func (m *CommitInfo_Details) Size() (n int) {
 		l = m.ValidatingTime.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfs(uint64(m.FileCount))
+	}
+	if m.DirCount != 0 {
+		n += 1 + sovPfs(uint64(m.DirCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8949,6 +9466,26 @@ func (m *CommitSet) Size() (n int) {
 	return n
 }
 
+func (m *CommitSetEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CommitSetInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8965,6 +9502,12 @@ func (m *CommitSetInfo) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8995,6 +9538,18 @@ func (m *FileInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.Digests) > 0 {
+		for k, v := range m.Digests {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovPfs(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9175,6 +9730,10 @@ func (m *ListCommitRequest) Size() (n int) {
 	if m.OriginKind != 0 {
 		n += 1 + sovPfs(uint64(m.OriginKind))
 	}
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9244,6 +9803,22 @@ func (m *DropCommitSetRequest) Size() (n int) {
 	return n
 }
 
+func (m *DeleteCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SubscribeCommitRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9573,6 +10148,12 @@ func (m *GetFileRequest) Size() (n int) {
 	if m.Offset != 0 {
 		n += 1 + sovPfs(uint64(m.Offset))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovPfs(uint64(m.Compression))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9589,6 +10170,12 @@ func (m *InspectFileRequest) Size() (n int) {
 		l = m.File.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.Digests) > 0 {
+		for _, s := range m.Digests {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9605,6 +10192,13 @@ func (m *ListFileRequest) Size() (n int) {
 		l = m.File.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovPfs(uint64(m.PageSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9699,6 +10293,10 @@ func (m *FsckRequest) Size() (n int) {
 	if m.Fix {
 		n += 2
 	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9719,6 +10317,10 @@ func (m *FsckResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11977,14 +12579,52 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirCount", wireType)
+			}
+			m.DirCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DirCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -12082,6 +12722,129 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitSetEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitSetEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitSetEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &Commit{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12181,6 +12944,40 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Edges = append(m.Edges, &CommitSetEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12376,6 +13173,134 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Digests == nil {
+				m.Digests = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Digests[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13461,6 +14386,42 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13567,7 +14528,58 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			m.Wait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13590,7 +14602,7 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13613,12 +14625,48 @@ func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13641,7 +14689,7 @@ func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13664,10 +14712,10 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13728,7 +14776,7 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13751,15 +14799,15 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13786,10 +14834,10 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15510,6 +16558,44 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15597,6 +16683,38 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digests = append(m.Digests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15684,6 +16802,57 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16227,6 +17396,42 @@ func (m *FsckRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Fix = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16342,6 +17547,42 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
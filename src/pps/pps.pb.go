@@ -79,12 +79,13 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 type DatumState int32
 
 const (
-	DatumState_UNKNOWN   DatumState = 0
-	DatumState_FAILED    DatumState = 1
-	DatumState_SUCCESS   DatumState = 2
-	DatumState_SKIPPED   DatumState = 3
-	DatumState_STARTING  DatumState = 4
-	DatumState_RECOVERED DatumState = 5
+	DatumState_UNKNOWN     DatumState = 0
+	DatumState_FAILED      DatumState = 1
+	DatumState_SUCCESS     DatumState = 2
+	DatumState_SKIPPED     DatumState = 3
+	DatumState_STARTING    DatumState = 4
+	DatumState_RECOVERED   DatumState = 5
+	DatumState_QUARANTINED DatumState = 6
 )
 
 var DatumState_name = map[int32]string{
@@ -94,15 +95,17 @@ var DatumState_name = map[int32]string{
 	3: "SKIPPED",
 	4: "STARTING",
 	5: "RECOVERED",
+	6: "QUARANTINED",
 }
 
 var DatumState_value = map[string]int32{
-	"UNKNOWN":   0,
-	"FAILED":    1,
-	"SUCCESS":   2,
-	"SKIPPED":   3,
-	"STARTING":  4,
-	"RECOVERED": 5,
+	"UNKNOWN":     0,
+	"FAILED":      1,
+	"SUCCESS":     2,
+	"SKIPPED":     3,
+	"STARTING":    4,
+	"RECOVERED":   5,
+	"QUARANTINED": 6,
 }
 
 func (x DatumState) String() string {
@@ -233,7 +236,7 @@ func (x PipelineInfo_PipelineType) String() string {
 }
 
 func (PipelineInfo_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{35, 0}
 }
 
 type SecretMount struct {
@@ -309,30 +312,121 @@ func (m *SecretMount) GetEnvVar() string {
 	return ""
 }
 
+// ConfigMapMount mounts an existing kubernetes ConfigMap into a pipeline's
+// workers, as files under mount_path and/or a single key as env_var.
+type ConfigMapMount struct {
+	// Name must be the name of the ConfigMap in kubernetes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Key of the ConfigMap to load into env_var, this field only has meaning if EnvVar != "".
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	MountPath            string   `protobuf:"bytes,3,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	EnvVar               string   `protobuf:"bytes,4,opt,name=env_var,json=envVar,proto3" json:"env_var,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigMapMount) Reset()         { *m = ConfigMapMount{} }
+func (m *ConfigMapMount) String() string { return proto.CompactTextString(m) }
+func (*ConfigMapMount) ProtoMessage()    {}
+func (*ConfigMapMount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{1}
+}
+func (m *ConfigMapMount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigMapMount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigMapMount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigMapMount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigMapMount.Merge(m, src)
+}
+func (m *ConfigMapMount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigMapMount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigMapMount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigMapMount proto.InternalMessageInfo
+
+func (m *ConfigMapMount) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConfigMapMount) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ConfigMapMount) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
+func (m *ConfigMapMount) GetEnvVar() string {
+	if m != nil {
+		return m.EnvVar
+	}
+	return ""
+}
+
 type Transform struct {
-	Image                string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Cmd                  []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
-	ErrCmd               []string          `protobuf:"bytes,3,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
-	Env                  map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Secrets              []*SecretMount    `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	ImagePullSecrets     []string          `protobuf:"bytes,6,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
-	Stdin                []string          `protobuf:"bytes,7,rep,name=stdin,proto3" json:"stdin,omitempty"`
-	ErrStdin             []string          `protobuf:"bytes,8,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
-	AcceptReturnCode     []int64           `protobuf:"varint,9,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
-	Debug                bool              `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`
-	User                 string            `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
-	WorkingDir           string            `protobuf:"bytes,12,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	Dockerfile           string            `protobuf:"bytes,13,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Image            string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Cmd              []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	ErrCmd           []string          `protobuf:"bytes,3,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
+	Env              map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Secrets          []*SecretMount    `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	ImagePullSecrets []string          `protobuf:"bytes,6,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
+	Stdin            []string          `protobuf:"bytes,7,rep,name=stdin,proto3" json:"stdin,omitempty"`
+	ErrStdin         []string          `protobuf:"bytes,8,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
+	AcceptReturnCode []int64           `protobuf:"varint,9,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
+	Debug            bool              `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`
+	User             string            `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
+	WorkingDir       string            `protobuf:"bytes,12,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	Dockerfile       string            `protobuf:"bytes,13,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	// stdin_from_input is a path to a file in the datum's input which is piped
+	// to the command's stdin. Environment variables such as $input_name are
+	// expanded, and relative paths are resolved against the input directory.
+	StdinFromInput string            `protobuf:"bytes,14,opt,name=stdin_from_input,json=stdinFromInput,proto3" json:"stdin_from_input,omitempty"`
+	ConfigMaps     []*ConfigMapMount `protobuf:"bytes,15,rep,name=config_maps,json=configMaps,proto3" json:"config_maps,omitempty"`
+	// preflight_cmd is run once per job, before any datums are processed, with
+	// all of the job's inputs in /pfs. If it exits with a non-zero code, the
+	// job fails with its stderr as the reason.
+	PreflightCmd   []string `protobuf:"bytes,16,rep,name=preflight_cmd,json=preflightCmd,proto3" json:"preflight_cmd,omitempty"`
+	PreflightStdin []string `protobuf:"bytes,17,rep,name=preflight_stdin,json=preflightStdin,proto3" json:"preflight_stdin,omitempty"`
+	// script_from_input is the path of a script in one of the pipeline's
+	// inputs, of the form <input name>/<path>, which is passed to cmd (e.g.
+	// bash) as its last argument. This lets scripts be versioned in PFS. The
+	// input should generally use the "/" glob, so that the script is present
+	// in every datum.
+	ScriptFromInput      string   `protobuf:"bytes,18,opt,name=script_from_input,json=scriptFromInput,proto3" json:"script_from_input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transform) Reset()         { *m = Transform{} }
 func (m *Transform) String() string { return proto.CompactTextString(m) }
 func (*Transform) ProtoMessage()    {}
 func (*Transform) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{1}
+	return fileDescriptor_beade573c128ccc7, []int{2}
 }
 func (m *Transform) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,6 +546,41 @@ func (m *Transform) GetDockerfile() string {
 	return ""
 }
 
+func (m *Transform) GetStdinFromInput() string {
+	if m != nil {
+		return m.StdinFromInput
+	}
+	return ""
+}
+
+func (m *Transform) GetConfigMaps() []*ConfigMapMount {
+	if m != nil {
+		return m.ConfigMaps
+	}
+	return nil
+}
+
+func (m *Transform) GetPreflightCmd() []string {
+	if m != nil {
+		return m.PreflightCmd
+	}
+	return nil
+}
+
+func (m *Transform) GetPreflightStdin() []string {
+	if m != nil {
+		return m.PreflightStdin
+	}
+	return nil
+}
+
+func (m *Transform) GetScriptFromInput() string {
+	if m != nil {
+		return m.ScriptFromInput
+	}
+	return ""
+}
+
 type TFJob struct {
 	// tf_job  is a serialized Kubeflow TFJob spec. Pachyderm sends this directly
 	// to a kubernetes cluster on which kubeflow has been installed, instead of
@@ -466,7 +595,7 @@ func (m *TFJob) Reset()         { *m = TFJob{} }
 func (m *TFJob) String() string { return proto.CompactTextString(m) }
 func (*TFJob) ProtoMessage()    {}
 func (*TFJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{2}
+	return fileDescriptor_beade573c128ccc7, []int{3}
 }
 func (m *TFJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,17 +632,23 @@ func (m *TFJob) GetTFJob() string {
 }
 
 type Egress struct {
-	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	// secret, if set, names a kubernetes secret holding the credentials used to
+	// write to URL (which must be an s3:// URL), with the same keys as
+	// pachyderm's storage secret (AMAZON_REGION, AMAZON_ID, AMAZON_SECRET, and
+	// optionally AMAZON_TOKEN and CUSTOM_ENDPOINT). It's mounted into the
+	// pipeline's workers at mount_path, or /pachyderm-egress-secret if unset.
+	Secret               *SecretMount `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Egress) Reset()         { *m = Egress{} }
 func (m *Egress) String() string { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()    {}
 func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{3}
+	return fileDescriptor_beade573c128ccc7, []int{4}
 }
 func (m *Egress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,6 +684,13 @@ func (m *Egress) GetURL() string {
 	return ""
 }
 
+func (m *Egress) GetSecret() *SecretMount {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
 type Job struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	ID                   string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -560,7 +702,7 @@ type Job struct {
 func (m *Job) Reset()      { *m = Job{} }
 func (*Job) ProtoMessage() {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{4}
+	return fileDescriptor_beade573c128ccc7, []int{5}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +757,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{5}
+	return fileDescriptor_beade573c128ccc7, []int{6}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +814,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{6}
+	return fileDescriptor_beade573c128ccc7, []int{7}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +872,12 @@ func (m *Service) GetType() string {
 }
 
 type Spout struct {
-	Service              *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// commit_marker, if set, lets the spout write its output to /pfs/out
+	// instead of calling put file itself. Whenever a file with this name appears
+	// in /pfs/out, the worker commits everything else in /pfs/out to the output
+	// branch, then clears /pfs/out (including the marker) for the next batch.
+	CommitMarker         string   `protobuf:"bytes,2,opt,name=commit_marker,json=commitMarker,proto3" json:"commit_marker,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -740,7 +887,7 @@ func (m *Spout) Reset()         { *m = Spout{} }
 func (m *Spout) String() string { return proto.CompactTextString(m) }
 func (*Spout) ProtoMessage()    {}
 func (*Spout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{7}
+	return fileDescriptor_beade573c128ccc7, []int{8}
 }
 func (m *Spout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,10 +923,21 @@ func (m *Spout) GetService() *Service {
 	return nil
 }
 
+func (m *Spout) GetCommitMarker() string {
+	if m != nil {
+		return m.CommitMarker
+	}
+	return ""
+}
+
 type PFSInput struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Repo      string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	RepoType  string `protobuf:"bytes,13,opt,name=repo_type,json=repoType,proto3" json:"repo_type,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo     string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RepoType string `protobuf:"bytes,13,opt,name=repo_type,json=repoType,proto3" json:"repo_type,omitempty"`
+	// branch is the branch to read. An input on the pipeline's own output repo
+	// must refer to an ancestor of its output branch, e.g. "master^", and each
+	// job reads that ancestor of its output commit. A job whose output commit
+	// has no such ancestor sees that input as empty.
 	Branch    string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
 	Commit    string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
 	Glob      string `protobuf:"bytes,5,opt,name=glob,proto3" json:"glob,omitempty"`
@@ -799,17 +957,28 @@ type PFSInput struct {
 	S3 bool `protobuf:"varint,11,opt,name=s3,proto3" json:"s3,omitempty"`
 	// Trigger defines when this input is processed by the pipeline, if it's nil
 	// the input is processed anytime something is committed to the input branch.
-	Trigger              *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Trigger *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// globs are additional glob patterns, whose datums are combined with those
+	// of glob. A file matched by more than one pattern produces a single datum.
+	Globs []string `protobuf:"bytes,14,rep,name=globs,proto3" json:"globs,omitempty"`
+	// leaf_dirs, if true, makes each leaf directory beneath the paths matched by
+	// the glob patterns a datum: every directory, including a matched directory
+	// itself, that contains at least one file and no subdirectories. The glob
+	// patterns are applied first, so "/" yields the leaf directories of the
+	// whole repo and "/*" those beneath each top-level directory. Matched files
+	// and files in directories that also have subdirectories are not part of
+	// any datum.
+	LeafDirs             bool     `protobuf:"varint,15,opt,name=leaf_dirs,json=leafDirs,proto3" json:"leaf_dirs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PFSInput) Reset()         { *m = PFSInput{} }
 func (m *PFSInput) String() string { return proto.CompactTextString(m) }
 func (*PFSInput) ProtoMessage()    {}
 func (*PFSInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{8}
+	return fileDescriptor_beade573c128ccc7, []int{9}
 }
 func (m *PFSInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,6 +1098,20 @@ func (m *PFSInput) GetTrigger() *pfs.Trigger {
 	return nil
 }
 
+func (m *PFSInput) GetGlobs() []string {
+	if m != nil {
+		return m.Globs
+	}
+	return nil
+}
+
+func (m *PFSInput) GetLeafDirs() bool {
+	if m != nil {
+		return m.LeafDirs
+	}
+	return false
+}
+
 type CronInput struct {
 	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -936,18 +1119,32 @@ type CronInput struct {
 	Spec   string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
 	// Overwrite, if true, will expose a single datum that gets overwritten each
 	// tick. If false, it will create a new datum for each tick.
-	Overwrite            bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	Start                *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Overwrite bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Start     *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	// format, if set, causes each tick's file to contain the tick time. It may
+	// be "rfc3339", "unix" (seconds since the epoch), or a Go time layout
+	// string. If unset, the tick files are empty.
+	Format string `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	// json, if true, writes the tick time as a JSON object of the form
+	// {"tick": "<time>"}. If format is unset, the time is written in RFC 3339.
+	Json bool `protobuf:"varint,8,opt,name=json,proto3" json:"json,omitempty"`
+	// keep_ticks and keep_duration, if set, limit how many ticks are retained
+	// in the cron repo: after each tick, tick files and commits beyond the
+	// keep_ticks most recent, or older than keep_duration, are removed and
+	// their commit sets squashed. If both are set, a tick is removed once it
+	// falls outside either limit. The most recent tick is always kept.
+	KeepTicks            uint64          `protobuf:"varint,9,opt,name=keep_ticks,json=keepTicks,proto3" json:"keep_ticks,omitempty"`
+	KeepDuration         *types.Duration `protobuf:"bytes,10,opt,name=keep_duration,json=keepDuration,proto3" json:"keep_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CronInput) Reset()         { *m = CronInput{} }
 func (m *CronInput) String() string { return proto.CompactTextString(m) }
 func (*CronInput) ProtoMessage()    {}
 func (*CronInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{9}
+	return fileDescriptor_beade573c128ccc7, []int{10}
 }
 func (m *CronInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,23 +1215,60 @@ func (m *CronInput) GetStart() *types.Timestamp {
 	return nil
 }
 
+func (m *CronInput) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *CronInput) GetJson() bool {
+	if m != nil {
+		return m.Json
+	}
+	return false
+}
+
+func (m *CronInput) GetKeepTicks() uint64 {
+	if m != nil {
+		return m.KeepTicks
+	}
+	return 0
+}
+
+func (m *CronInput) GetKeepDuration() *types.Duration {
+	if m != nil {
+		return m.KeepDuration
+	}
+	return nil
+}
+
 type Input struct {
-	Pfs                  *PFSInput  `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
-	Join                 []*Input   `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
-	Group                []*Input   `protobuf:"bytes,3,rep,name=group,proto3" json:"group,omitempty"`
-	Cross                []*Input   `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
-	Union                []*Input   `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
-	Cron                 *CronInput `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Pfs *PFSInput `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
+	// join produces a datum for each join_on key that all of its inputs (other
+	// than outer joins) produce, crossing the files of inputs that produce a key
+	// more than once.
+	Join  []*Input   `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
+	Group []*Input   `protobuf:"bytes,3,rep,name=group,proto3" json:"group,omitempty"`
+	Cross []*Input   `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
+	Union []*Input   `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
+	Cron  *CronInput `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	// dedup, if true, collapses the datums of a union whose input files have the
+	// same paths into the datum from the first of the union's inputs that
+	// produces them. It's only valid on union inputs. The collapsed datums
+	// aren't part of the job at all, so they aren't listed by ListDatum and
+	// InspectDatumSkipReason doesn't report them as skipped.
+	Dedup                bool     `protobuf:"varint,7,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{10}
+	return fileDescriptor_beade573c128ccc7, []int{11}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,6 +1339,13 @@ func (m *Input) GetCron() *CronInput {
 	return nil
 }
 
+func (m *Input) GetDedup() bool {
+	if m != nil {
+		return m.Dedup
+	}
+	return false
+}
+
 type JobInput struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Commit               *pfs.Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1119,7 +1360,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{11}
+	return fileDescriptor_beade573c128ccc7, []int{12}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1422,14 @@ type ParallelismSpec struct {
 	// zero. If 'constant' is zero (which is the zero value of ParallelismSpec),
 	// then Pachyderm will choose the number of workers that is started,
 	// (currently it chooses the number of workers in the cluster)
-	Constant             uint64   `protobuf:"varint,1,opt,name=constant,proto3" json:"constant,omitempty"`
+	Constant uint64 `protobuf:"varint,1,opt,name=constant,proto3" json:"constant,omitempty"`
+	// If 'datums_per_worker' is set (in which case 'constant' must be zero), the
+	// number of workers is scaled with the number of datums the pipeline's jobs
+	// have yet to process, running one worker per 'datums_per_worker' datums,
+	// and at least one and at most 'max' workers. The current target is
+	// reported in PipelineInfo.parallelism.
+	DatumsPerWorker      int64    `protobuf:"varint,2,opt,name=datums_per_worker,json=datumsPerWorker,proto3" json:"datums_per_worker,omitempty"`
+	Max                  uint64   `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1191,7 +1439,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{13}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,6 +1475,20 @@ func (m *ParallelismSpec) GetConstant() uint64 {
 	return 0
 }
 
+func (m *ParallelismSpec) GetDatumsPerWorker() int64 {
+	if m != nil {
+		return m.DatumsPerWorker
+	}
+	return 0
+}
+
+func (m *ParallelismSpec) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
 type InputFile struct {
 	// This file's absolute path within its pfs repo.
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -1241,7 +1503,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1297,7 +1559,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,21 +1603,24 @@ func (m *Datum) GetID() string {
 }
 
 type DatumInfo struct {
-	Datum                *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
-	State                DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
-	Stats                *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
-	PfsState             *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
-	Data                 []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Datum    *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	State    DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
+	Stats    *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	PfsState *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
+	Data     []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	// worker_id is the ID of the worker that processed the datum, if it was
+	// processed by this job.
+	WorkerID             string   `protobuf:"bytes,6,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,29 +1684,36 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
-type Aggregate struct {
-	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
-	Stddev                float64  `protobuf:"fixed64,3,opt,name=stddev,proto3" json:"stddev,omitempty"`
-	FifthPercentile       float64  `protobuf:"fixed64,4,opt,name=fifth_percentile,json=fifthPercentile,proto3" json:"fifth_percentile,omitempty"`
-	NinetyFifthPercentile float64  `protobuf:"fixed64,5,opt,name=ninety_fifth_percentile,json=ninetyFifthPercentile,proto3" json:"ninety_fifth_percentile,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+func (m *DatumInfo) GetWorkerID() string {
+	if m != nil {
+		return m.WorkerID
+	}
+	return ""
 }
 
-func (m *Aggregate) Reset()         { *m = Aggregate{} }
-func (m *Aggregate) String() string { return proto.CompactTextString(m) }
-func (*Aggregate) ProtoMessage()    {}
-func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+// FailureReport is written to the failure report branch of a pipeline's output
+// repo when one of its jobs fails.
+type FailureReport struct {
+	Job                  *Job           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Reason               string         `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FailedDatums         []*FailedDatum `protobuf:"bytes,3,rep,name=failed_datums,json=failedDatums,proto3" json:"failed_datums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+
+func (m *FailureReport) Reset()         { *m = FailureReport{} }
+func (m *FailureReport) String() string { return proto.CompactTextString(m) }
+func (*FailureReport) ProtoMessage()    {}
+func (*FailureReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{17}
+}
+func (m *FailureReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FailureReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FailureReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1451,50 +1723,196 @@ func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Aggregate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Aggregate.Merge(m, src)
+func (m *FailureReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailureReport.Merge(m, src)
 }
-func (m *Aggregate) XXX_Size() int {
+func (m *FailureReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *Aggregate) XXX_DiscardUnknown() {
-	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+func (m *FailureReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailureReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+var xxx_messageInfo_FailureReport proto.InternalMessageInfo
 
-func (m *Aggregate) GetCount() int64 {
+func (m *FailureReport) GetJob() *Job {
 	if m != nil {
-		return m.Count
+		return m.Job
 	}
-	return 0
+	return nil
 }
 
-func (m *Aggregate) GetMean() float64 {
+func (m *FailureReport) GetReason() string {
 	if m != nil {
-		return m.Mean
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-func (m *Aggregate) GetStddev() float64 {
+func (m *FailureReport) GetFailedDatums() []*FailedDatum {
 	if m != nil {
-		return m.Stddev
+		return m.FailedDatums
 	}
-	return 0
+	return nil
 }
 
-func (m *Aggregate) GetFifthPercentile() float64 {
-	if m != nil {
-		return m.FifthPercentile
-	}
-	return 0
+type FailedDatum struct {
+	Datum  *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	Reason string          `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Data   []*pfs.FileInfo `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// logs are the last lines logged while processing the datum.
+	Logs []string `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	// retries is the number of times the datum was retried before it failed.
+	Retries              int64    `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Aggregate) GetNinetyFifthPercentile() float64 {
-	if m != nil {
-		return m.NinetyFifthPercentile
-	}
+func (m *FailedDatum) Reset()         { *m = FailedDatum{} }
+func (m *FailedDatum) String() string { return proto.CompactTextString(m) }
+func (*FailedDatum) ProtoMessage()    {}
+func (*FailedDatum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{18}
+}
+func (m *FailedDatum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedDatum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedDatum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedDatum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedDatum.Merge(m, src)
+}
+func (m *FailedDatum) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedDatum) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedDatum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedDatum proto.InternalMessageInfo
+
+func (m *FailedDatum) GetDatum() *Datum {
+	if m != nil {
+		return m.Datum
+	}
+	return nil
+}
+
+func (m *FailedDatum) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedDatum) GetData() []*pfs.FileInfo {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FailedDatum) GetLogs() []string {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *FailedDatum) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+type Aggregate struct {
+	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	Stddev                float64  `protobuf:"fixed64,3,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	FifthPercentile       float64  `protobuf:"fixed64,4,opt,name=fifth_percentile,json=fifthPercentile,proto3" json:"fifth_percentile,omitempty"`
+	NinetyFifthPercentile float64  `protobuf:"fixed64,5,opt,name=ninety_fifth_percentile,json=ninetyFifthPercentile,proto3" json:"ninety_fifth_percentile,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{19}
+}
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Aggregate) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *Aggregate) GetStddev() float64 {
+	if m != nil {
+		return m.Stddev
+	}
+	return 0
+}
+
+func (m *Aggregate) GetFifthPercentile() float64 {
+	if m != nil {
+		return m.FifthPercentile
+	}
+	return 0
+}
+
+func (m *Aggregate) GetNinetyFifthPercentile() float64 {
+	if m != nil {
+		return m.NinetyFifthPercentile
+	}
 	return 0
 }
 
@@ -1513,7 +1931,7 @@ func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +2010,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,19 +2075,25 @@ func (m *AggregateProcessStats) GetUploadBytes() *Aggregate {
 }
 
 type WorkerStatus struct {
-	WorkerID             string       `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	JobID                string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	DatumStatus          *DatumStatus `protobuf:"bytes,3,opt,name=datum_status,json=datumStatus,proto3" json:"datum_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	WorkerID    string       `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	JobID       string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	DatumStatus *DatumStatus `protobuf:"bytes,3,opt,name=datum_status,json=datumStatus,proto3" json:"datum_status,omitempty"`
+	// queue_size is the number of datums in the worker's current datum set that
+	// it hasn't finished processing, including the current datum.
+	QueueSize int64 `protobuf:"varint,4,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// datums_per_second is the rate at which the worker finished datums over the
+	// last minute.
+	DatumsPerSecond      float64  `protobuf:"fixed64,5,opt,name=datums_per_second,json=datumsPerSecond,proto3" json:"datums_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,6 +2143,20 @@ func (m *WorkerStatus) GetDatumStatus() *DatumStatus {
 	return nil
 }
 
+func (m *WorkerStatus) GetQueueSize() int64 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
+
+func (m *WorkerStatus) GetDatumsPerSecond() float64 {
+	if m != nil {
+		return m.DatumsPerSecond
+	}
+	return 0
+}
+
 type DatumStatus struct {
 	// Started is the time processing on the current datum began.
 	Started              *types.Timestamp `protobuf:"bytes,1,opt,name=started,proto3" json:"started,omitempty"`
@@ -1732,7 +2170,7 @@ func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
 func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
 func (*DatumStatus) ProtoMessage()    {}
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,7 +2236,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{24}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +2307,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1912,26 +2350,34 @@ func (m *GPUSpec) GetNumber() int64 {
 	return 0
 }
 
-type JobSetInfo struct {
-	JobSet               *JobSet    `protobuf:"bytes,1,opt,name=job_set,json=jobSet,proto3" json:"job_set,omitempty"`
-	Jobs                 []*JobInfo `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+// DatumMemoryScaling sizes the memory allowed to each datum's user code by the
+// size of the datum's inputs: base + per_byte * input bytes, capped at max.
+type DatumMemoryScaling struct {
+	// The memory allowed to every datum (in bytes, with allowed SI suffixes (M,
+	// K, G, Mi, Ki, Gi, etc).
+	Base string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The additional memory allowed per byte of datum input.
+	PerByte float64 `protobuf:"fixed64,2,opt,name=per_byte,json=perByte,proto3" json:"per_byte,omitempty"`
+	// The most memory allowed to any datum (in bytes, with allowed SI suffixes).
+	// If unset, the allowance isn't capped.
+	Max                  string   `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
-func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
-func (*JobSetInfo) ProtoMessage()    {}
-func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+func (m *DatumMemoryScaling) Reset()         { *m = DatumMemoryScaling{} }
+func (m *DatumMemoryScaling) String() string { return proto.CompactTextString(m) }
+func (*DatumMemoryScaling) ProtoMessage()    {}
+func (*DatumMemoryScaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
-func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+func (m *DatumMemoryScaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DatumMemoryScaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DatumMemoryScaling.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1941,73 +2387,64 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobSetInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetInfo.Merge(m, src)
+func (m *DatumMemoryScaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumMemoryScaling.Merge(m, src)
 }
-func (m *JobSetInfo) XXX_Size() int {
+func (m *DatumMemoryScaling) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+func (m *DatumMemoryScaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumMemoryScaling.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+var xxx_messageInfo_DatumMemoryScaling proto.InternalMessageInfo
 
-func (m *JobSetInfo) GetJobSet() *JobSet {
+func (m *DatumMemoryScaling) GetBase() string {
 	if m != nil {
-		return m.JobSet
+		return m.Base
 	}
-	return nil
+	return ""
 }
 
-func (m *JobSetInfo) GetJobs() []*JobInfo {
+func (m *DatumMemoryScaling) GetPerByte() float64 {
 	if m != nil {
-		return m.Jobs
+		return m.PerByte
 	}
-	return nil
+	return 0
 }
 
-// JobInfo is the data stored in the database regarding a given job.  The
-// 'details' field contains more information about the job which is expensive to
-// fetch, requiring querying workers or loading the pipeline spec from object
-// storage.
-type JobInfo struct {
-	Job             *Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	PipelineVersion uint64      `protobuf:"varint,2,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
-	OutputCommit    *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
-	// Job restart count (e.g. due to datum failure)
-	Restart uint64 `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
-	// Counts of how many times we processed or skipped a datum
-	DataProcessed int64 `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped   int64 `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataTotal     int64 `protobuf:"varint,7,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Created              *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
-	Details              *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *DatumMemoryScaling) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
 }
 
-func (m *JobInfo) Reset()         { *m = JobInfo{} }
-func (m *JobInfo) String() string { return proto.CompactTextString(m) }
-func (*JobInfo) ProtoMessage()    {}
-func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+// Heartbeat configures the liveness signal a pipeline's workers emit.
+type Heartbeat struct {
+	// interval is how often each worker records a heartbeat.
+	Interval *types.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// timeout is how long the pipeline's newest heartbeat may go without
+	// advancing before the pipeline is marked CRASHING. Defaults to three times
+	// interval.
+	Timeout              *types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *JobInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
+func (m *Heartbeat) String() string { return proto.CompactTextString(m) }
+func (*Heartbeat) ProtoMessage()    {}
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{27}
+}
+func (m *Heartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Heartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Heartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2017,166 +2454,248 @@ func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobInfo.Merge(m, src)
+func (m *Heartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Heartbeat.Merge(m, src)
 }
-func (m *JobInfo) XXX_Size() int {
+func (m *Heartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobInfo.DiscardUnknown(m)
+func (m *Heartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Heartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobInfo proto.InternalMessageInfo
+var xxx_messageInfo_Heartbeat proto.InternalMessageInfo
 
-func (m *JobInfo) GetJob() *Job {
+func (m *Heartbeat) GetInterval() *types.Duration {
 	if m != nil {
-		return m.Job
+		return m.Interval
 	}
 	return nil
 }
 
-func (m *JobInfo) GetPipelineVersion() uint64 {
-	if m != nil {
-		return m.PipelineVersion
-	}
-	return 0
-}
-
-func (m *JobInfo) GetOutputCommit() *pfs.Commit {
+func (m *Heartbeat) GetTimeout() *types.Duration {
 	if m != nil {
-		return m.OutputCommit
+		return m.Timeout
 	}
 	return nil
 }
 
-func (m *JobInfo) GetRestart() uint64 {
-	if m != nil {
-		return m.Restart
-	}
-	return 0
+// CrashBackoff configures how a CRASHING pipeline is retried. While the
+// pipeline's workers aren't all up, it's rechecked after initial, with the wait
+// growing by multiplier (up to max) after each failed check.
+type CrashBackoff struct {
+	Initial *types.Duration `protobuf:"bytes,1,opt,name=initial,proto3" json:"initial,omitempty"`
+	// max defaults to five minutes (or initial, if that's longer).
+	Max *types.Duration `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	// multiplier defaults to 1.5.
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// max_retries, if set, is the number of failed checks after which the
+	// pipeline is marked FAILURE and its workers are removed.
+	MaxRetries           int64    `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobInfo) GetDataProcessed() int64 {
-	if m != nil {
-		return m.DataProcessed
-	}
-	return 0
+func (m *CrashBackoff) Reset()         { *m = CrashBackoff{} }
+func (m *CrashBackoff) String() string { return proto.CompactTextString(m) }
+func (*CrashBackoff) ProtoMessage()    {}
+func (*CrashBackoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
-
-func (m *JobInfo) GetDataSkipped() int64 {
-	if m != nil {
-		return m.DataSkipped
-	}
-	return 0
+func (m *CrashBackoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrashBackoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrashBackoff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrashBackoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashBackoff.Merge(m, src)
+}
+func (m *CrashBackoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrashBackoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashBackoff.DiscardUnknown(m)
 }
 
-func (m *JobInfo) GetDataTotal() int64 {
+var xxx_messageInfo_CrashBackoff proto.InternalMessageInfo
+
+func (m *CrashBackoff) GetInitial() *types.Duration {
 	if m != nil {
-		return m.DataTotal
+		return m.Initial
 	}
-	return 0
+	return nil
 }
 
-func (m *JobInfo) GetDataFailed() int64 {
+func (m *CrashBackoff) GetMax() *types.Duration {
 	if m != nil {
-		return m.DataFailed
+		return m.Max
 	}
-	return 0
+	return nil
 }
 
-func (m *JobInfo) GetDataRecovered() int64 {
+func (m *CrashBackoff) GetMultiplier() float64 {
 	if m != nil {
-		return m.DataRecovered
+		return m.Multiplier
 	}
 	return 0
 }
 
-func (m *JobInfo) GetStats() *ProcessStats {
+func (m *CrashBackoff) GetMaxRetries() int64 {
 	if m != nil {
-		return m.Stats
+		return m.MaxRetries
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo) GetState() JobState {
-	if m != nil {
-		return m.State
+// MaintenanceSchedule describes the recurring windows during which a pipeline
+// is PAUSED. Jobs for commits that arrive during a window are processed once
+// it ends.
+type MaintenanceSchedule struct {
+	// cron is a cron expression for the start of each window.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// duration is how long each window lasts.
+	Duration             *types.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MaintenanceSchedule) Reset()         { *m = MaintenanceSchedule{} }
+func (m *MaintenanceSchedule) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceSchedule) ProtoMessage()    {}
+func (*MaintenanceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{29}
+}
+func (m *MaintenanceSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return JobState_JOB_STATE_UNKNOWN
+}
+func (m *MaintenanceSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceSchedule.Merge(m, src)
+}
+func (m *MaintenanceSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceSchedule.DiscardUnknown(m)
 }
 
-func (m *JobInfo) GetReason() string {
+var xxx_messageInfo_MaintenanceSchedule proto.InternalMessageInfo
+
+func (m *MaintenanceSchedule) GetCron() string {
 	if m != nil {
-		return m.Reason
+		return m.Cron
 	}
 	return ""
 }
 
-func (m *JobInfo) GetCreated() *types.Timestamp {
+func (m *MaintenanceSchedule) GetDuration() *types.Duration {
 	if m != nil {
-		return m.Created
+		return m.Duration
 	}
 	return nil
 }
 
-func (m *JobInfo) GetStarted() *types.Timestamp {
-	if m != nil {
-		return m.Started
+type MaintenanceWindow struct {
+	Start                *types.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *types.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{30}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
 }
 
-func (m *JobInfo) GetFinished() *types.Timestamp {
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetStart() *types.Timestamp {
 	if m != nil {
-		return m.Finished
+		return m.Start
 	}
 	return nil
 }
 
-func (m *JobInfo) GetDetails() *JobInfo_Details {
+func (m *MaintenanceWindow) GetEnd() *types.Timestamp {
 	if m != nil {
-		return m.Details
+		return m.End
 	}
 	return nil
 }
 
-type JobInfo_Details struct {
-	Transform             *Transform       `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
-	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                *Egress          `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
-	Service               *Service         `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                 *Spout           `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
-	WorkerStatus          []*WorkerStatus  `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
-	ResourceRequests      *ResourceSpec    `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits        *ResourceSpec    `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                 *Input           `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Salt                  string           `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout          *types.Duration  `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout            *types.Duration  `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries            int64            `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec               string           `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch              string           `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+type JobSetInfo struct {
+	JobSet *JobSet    `protobuf:"bytes,1,opt,name=job_set,json=jobSet,proto3" json:"job_set,omitempty"`
+	Jobs   []*JobInfo `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// The aggregate state of the jobs in the set: FAILURE if any job failed or
+	// was killed, RUNNING if any job has not finished, and SUCCESS otherwise.
+	State                JobState `protobuf:"varint,3,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobInfo_Details) Reset()         { *m = JobInfo_Details{} }
-func (m *JobInfo_Details) String() string { return proto.CompactTextString(m) }
-func (*JobInfo_Details) ProtoMessage()    {}
-func (*JobInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24, 0}
+func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
+func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
+func (*JobSetInfo) ProtoMessage()    {}
+func (*JobSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{31}
 }
-func (m *JobInfo_Details) XXX_Unmarshal(b []byte) error {
+func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobInfo_Details) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobInfo_Details.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2186,110 +2705,398 @@ func (m *JobInfo_Details) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *JobInfo_Details) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobInfo_Details.Merge(m, src)
+func (m *JobSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetInfo.Merge(m, src)
 }
-func (m *JobInfo_Details) XXX_Size() int {
+func (m *JobSetInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobInfo_Details) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobInfo_Details.DiscardUnknown(m)
+func (m *JobSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobInfo_Details proto.InternalMessageInfo
+var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
 
-func (m *JobInfo_Details) GetTransform() *Transform {
+func (m *JobSetInfo) GetJobSet() *JobSet {
 	if m != nil {
-		return m.Transform
+		return m.JobSet
 	}
 	return nil
 }
 
-func (m *JobInfo_Details) GetParallelismSpec() *ParallelismSpec {
+func (m *JobSetInfo) GetJobs() []*JobInfo {
 	if m != nil {
-		return m.ParallelismSpec
+		return m.Jobs
 	}
 	return nil
 }
 
-func (m *JobInfo_Details) GetEgress() *Egress {
+func (m *JobSetInfo) GetState() JobState {
 	if m != nil {
-		return m.Egress
+		return m.State
 	}
-	return nil
+	return JobState_JOB_STATE_UNKNOWN
 }
 
-func (m *JobInfo_Details) GetService() *Service {
-	if m != nil {
-		return m.Service
-	}
-	return nil
+// JobInfo is the data stored in the database regarding a given job.  The
+// 'details' field contains more information about the job which is expensive to
+// fetch, requiring querying workers or loading the pipeline spec from object
+// storage.
+type JobInfo struct {
+	Job             *Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	PipelineVersion uint64      `protobuf:"varint,2,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
+	OutputCommit    *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	// Job restart count (e.g. due to datum failure)
+	Restart uint64 `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
+	// Counts of how many times we processed or skipped a datum
+	DataProcessed int64 `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped   int64 `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataTotal     int64 `protobuf:"varint,7,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	// Download/process/upload time and download/upload bytes
+	Stats    *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	State    JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Reason   string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Created  *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Started  *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
+	Finished *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
+	Details  *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
+	// reprocess is true if the job was started by ReprocessPipeline with a
+	// reprocess spec other than 'until_success', in which case every datum is
+	// processed rather than skipped.
+	Reprocess bool `protobuf:"varint,17,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	// data_pending is the number of datums the job has scheduled for processing
+	// but not yet processed.
+	DataPending int64 `protobuf:"varint,18,opt,name=data_pending,json=dataPending,proto3" json:"data_pending,omitempty"`
+	// failed_datums lists the first few datums that failed in the job, so that
+	// the cause of a failed job can be found without reading its logs.
+	FailedDatums         []*FailedDatum `protobuf:"bytes,19,rep,name=failed_datums,json=failedDatums,proto3" json:"failed_datums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *JobInfo_Details) GetSpout() *Spout {
-	if m != nil {
-		return m.Spout
+func (m *JobInfo) Reset()         { *m = JobInfo{} }
+func (m *JobInfo) String() string { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()    {}
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{32}
+}
+func (m *JobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *JobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo.Merge(m, src)
+}
+func (m *JobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo.DiscardUnknown(m)
 }
 
-func (m *JobInfo_Details) GetWorkerStatus() []*WorkerStatus {
+var xxx_messageInfo_JobInfo proto.InternalMessageInfo
+
+func (m *JobInfo) GetJob() *Job {
 	if m != nil {
-		return m.WorkerStatus
+		return m.Job
 	}
 	return nil
 }
 
-func (m *JobInfo_Details) GetResourceRequests() *ResourceSpec {
+func (m *JobInfo) GetPipelineVersion() uint64 {
 	if m != nil {
-		return m.ResourceRequests
+		return m.PipelineVersion
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo_Details) GetResourceLimits() *ResourceSpec {
+func (m *JobInfo) GetOutputCommit() *pfs.Commit {
 	if m != nil {
-		return m.ResourceLimits
+		return m.OutputCommit
 	}
 	return nil
 }
 
-func (m *JobInfo_Details) GetSidecarResourceLimits() *ResourceSpec {
+func (m *JobInfo) GetRestart() uint64 {
 	if m != nil {
-		return m.SidecarResourceLimits
+		return m.Restart
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo_Details) GetInput() *Input {
+func (m *JobInfo) GetDataProcessed() int64 {
 	if m != nil {
-		return m.Input
+		return m.DataProcessed
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo_Details) GetSalt() string {
+func (m *JobInfo) GetDataSkipped() int64 {
 	if m != nil {
-		return m.Salt
+		return m.DataSkipped
 	}
-	return ""
+	return 0
 }
 
-func (m *JobInfo_Details) GetDatumSetSpec() *DatumSetSpec {
+func (m *JobInfo) GetDataTotal() int64 {
 	if m != nil {
-		return m.DatumSetSpec
+		return m.DataTotal
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo_Details) GetDatumTimeout() *types.Duration {
+func (m *JobInfo) GetDataFailed() int64 {
 	if m != nil {
-		return m.DatumTimeout
+		return m.DataFailed
 	}
-	return nil
+	return 0
 }
 
-func (m *JobInfo_Details) GetJobTimeout() *types.Duration {
+func (m *JobInfo) GetDataRecovered() int64 {
+	if m != nil {
+		return m.DataRecovered
+	}
+	return 0
+}
+
+func (m *JobInfo) GetStats() *ProcessStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *JobInfo) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_JOB_STATE_UNKNOWN
+}
+
+func (m *JobInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobInfo) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *JobInfo) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *JobInfo) GetFinished() *types.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *JobInfo) GetDetails() *JobInfo_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *JobInfo) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
+func (m *JobInfo) GetDataPending() int64 {
+	if m != nil {
+		return m.DataPending
+	}
+	return 0
+}
+
+func (m *JobInfo) GetFailedDatums() []*FailedDatum {
+	if m != nil {
+		return m.FailedDatums
+	}
+	return nil
+}
+
+type JobInfo_Details struct {
+	Transform             *Transform       `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
+	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                *Egress          `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	Service               *Service         `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                 *Spout           `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
+	WorkerStatus          []*WorkerStatus  `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
+	ResourceRequests      *ResourceSpec    `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits        *ResourceSpec    `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                 *Input           `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Salt                  string           `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout          *types.Duration  `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout            *types.Duration  `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries            int64            `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec               string           `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch              string           `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	// datum_fileset_id identifies the file set holding the metadata of the
+	// job's datums, once the job has finished. It doesn't change while the
+	// job exists, and can be passed to ListDatum to list the datums the job
+	// saw even after its inputs have changed.
+	DatumFilesetId       string   `protobuf:"bytes,19,opt,name=datum_fileset_id,json=datumFilesetId,proto3" json:"datum_fileset_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobInfo_Details) Reset()         { *m = JobInfo_Details{} }
+func (m *JobInfo_Details) String() string { return proto.CompactTextString(m) }
+func (*JobInfo_Details) ProtoMessage()    {}
+func (*JobInfo_Details) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{32, 0}
+}
+func (m *JobInfo_Details) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobInfo_Details) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobInfo_Details.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobInfo_Details) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo_Details.Merge(m, src)
+}
+func (m *JobInfo_Details) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobInfo_Details) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo_Details.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobInfo_Details proto.InternalMessageInfo
+
+func (m *JobInfo_Details) GetTransform() *Transform {
+	if m != nil {
+		return m.Transform
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetParallelismSpec() *ParallelismSpec {
+	if m != nil {
+		return m.ParallelismSpec
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetEgress() *Egress {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetService() *Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetSpout() *Spout {
+	if m != nil {
+		return m.Spout
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetWorkerStatus() []*WorkerStatus {
+	if m != nil {
+		return m.WorkerStatus
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetResourceRequests() *ResourceSpec {
+	if m != nil {
+		return m.ResourceRequests
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetResourceLimits() *ResourceSpec {
+	if m != nil {
+		return m.ResourceLimits
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetSidecarResourceLimits() *ResourceSpec {
+	if m != nil {
+		return m.SidecarResourceLimits
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetInput() *Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *JobInfo_Details) GetDatumSetSpec() *DatumSetSpec {
+	if m != nil {
+		return m.DatumSetSpec
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetDatumTimeout() *types.Duration {
+	if m != nil {
+		return m.DatumTimeout
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetJobTimeout() *types.Duration {
 	if m != nil {
 		return m.JobTimeout
 	}
@@ -2324,6 +3131,13 @@ func (m *JobInfo_Details) GetPodPatch() string {
 	return ""
 }
 
+func (m *JobInfo_Details) GetDatumFilesetId() string {
+	if m != nil {
+		return m.DatumFilesetId
+	}
+	return ""
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.WorkerState" json:"state,omitempty"`
@@ -2336,7 +3150,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2390,7 +3204,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2445,20 +3259,23 @@ type PipelineInfo struct {
 	LastJobState JobState `protobuf:"varint,8,opt,name=last_job_state,json=lastJobState,proto3,enum=pps_v2.JobState" json:"last_job_state,omitempty"`
 	// parallelism tracks the literal number of workers that this pipeline should
 	// run.
-	Parallelism          uint64                    `protobuf:"varint,9,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	Type                 PipelineInfo_PipelineType `protobuf:"varint,10,opt,name=type,proto3,enum=pps_v2.PipelineInfo_PipelineType" json:"type,omitempty"`
-	AuthToken            string                    `protobuf:"bytes,11,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	Details              *PipelineInfo_Details     `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Parallelism uint64                    `protobuf:"varint,9,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	Type        PipelineInfo_PipelineType `protobuf:"varint,10,opt,name=type,proto3,enum=pps_v2.PipelineInfo_PipelineType" json:"type,omitempty"`
+	AuthToken   string                    `protobuf:"bytes,11,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	Details     *PipelineInfo_Details     `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
+	// recent_jobs summarizes the pipeline's most recently created jobs, newest
+	// first. It's only set if requested in InspectPipelineRequest.
+	RecentJobs           []*JobSummary `protobuf:"bytes,13,rep,name=recent_jobs,json=recentJobs,proto3" json:"recent_jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,51 +3381,83 @@ func (m *PipelineInfo) GetDetails() *PipelineInfo_Details {
 	return nil
 }
 
+func (m *PipelineInfo) GetRecentJobs() []*JobSummary {
+	if m != nil {
+		return m.RecentJobs
+	}
+	return nil
+}
+
 type PipelineInfo_Details struct {
 	Transform *Transform `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
 	// when running in a kubernetes cluster on which kubeflow has been installed.
 	// Exactly one of 'tf_job' and 'transform' should be set
-	TFJob                 *TFJob           `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
-	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                *Egress          `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
-	CreatedAt             *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	RecentError           string           `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
-	WorkersRequested      int64            `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
-	WorkersAvailable      int64            `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
-	OutputBranch          string           `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	ResourceRequests      *ResourceSpec    `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits        *ResourceSpec    `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                 *Input           `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
-	Description           string           `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	Salt                  string           `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
-	Reason                string           `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
-	Service               *Service         `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                 *Spout           `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout          *types.Duration  `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout            *types.Duration  `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries            int64            `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec               string           `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch              string           `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                 bool             `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata              *Metadata        `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec         string           `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	UnclaimedTasks        int64            `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
-	WorkerRc              string           `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
-	Autoscaling           bool             `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	TFJob                 *TFJob              `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
+	ParallelismSpec       *ParallelismSpec    `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                *Egress             `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
+	CreatedAt             *types.Timestamp    `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RecentError           string              `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
+	WorkersRequested      int64               `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
+	WorkersAvailable      int64               `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
+	OutputBranch          string              `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	ResourceRequests      *ResourceSpec       `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits        *ResourceSpec       `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits *ResourceSpec       `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                 *Input              `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
+	Description           string              `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Salt                  string              `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
+	Reason                string              `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
+	Service               *Service            `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                 *Spout              `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec          *DatumSetSpec       `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout          *types.Duration     `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout            *types.Duration     `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries            int64               `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec        *SchedulingSpec     `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec               string              `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch              string              `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out                 bool                `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata              *Metadata           `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec         string              `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	UnclaimedTasks        int64               `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
+	WorkerRc              string              `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
+	Autoscaling           bool                `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DedupAgainst          string              `protobuf:"bytes,34,opt,name=dedup_against,json=dedupAgainst,proto3" json:"dedup_against,omitempty"`
+	FailureReport         bool                `protobuf:"varint,35,opt,name=failure_report,json=failureReport,proto3" json:"failure_report,omitempty"`
+	NodePool              string              `protobuf:"bytes,36,opt,name=node_pool,json=nodePool,proto3" json:"node_pool,omitempty"`
+	Passthrough           bool                `protobuf:"varint,37,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	PreviousOutput        string              `protobuf:"bytes,38,opt,name=previous_output,json=previousOutput,proto3" json:"previous_output,omitempty"`
+	ShardByKey            bool                `protobuf:"varint,39,opt,name=shard_by_key,json=shardByKey,proto3" json:"shard_by_key,omitempty"`
+	DatumMemoryScaling    *DatumMemoryScaling `protobuf:"bytes,40,opt,name=datum_memory_scaling,json=datumMemoryScaling,proto3" json:"datum_memory_scaling,omitempty"`
+	QuarantineAfter       int64               `protobuf:"varint,41,opt,name=quarantine_after,json=quarantineAfter,proto3" json:"quarantine_after,omitempty"`
+	Heartbeat             *Heartbeat          `protobuf:"bytes,42,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	JobHistoryLimit       int64               `protobuf:"varint,43,opt,name=job_history_limit,json=jobHistoryLimit,proto3" json:"job_history_limit,omitempty"`
+	CrashBackoff          *CrashBackoff       `protobuf:"bytes,44,opt,name=crash_backoff,json=crashBackoff,proto3" json:"crash_backoff,omitempty"`
+	MaxInFlightJobs       int64               `protobuf:"varint,45,opt,name=max_in_flight_jobs,json=maxInFlightJobs,proto3" json:"max_in_flight_jobs,omitempty"`
+	// jobs_in_flight is the number of the pipeline's jobs that have started
+	// and not yet finished processing.
+	JobsInFlight int64 `protobuf:"varint,46,opt,name=jobs_in_flight,json=jobsInFlight,proto3" json:"jobs_in_flight,omitempty"`
+	// no_meta, if set, stops the pipeline from keeping a meta repo, so its
+	// jobs' datums can't be listed or inspected.
+	NoMeta              bool                 `protobuf:"varint,47,opt,name=no_meta,json=noMeta,proto3" json:"no_meta,omitempty"`
+	MaintenanceSchedule *MaintenanceSchedule `protobuf:"bytes,48,opt,name=maintenance_schedule,json=maintenanceSchedule,proto3" json:"maintenance_schedule,omitempty"`
+	// maintenance_window is the pipeline's current maintenance window, or if
+	// it's not in one, its next maintenance window.
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,49,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	// reprocess_glob is the reprocess_glob this version of the pipeline was
+	// created with, if any. Only the version's first job uses it.
+	ReprocessGlob        string   `protobuf:"bytes,50,opt,name=reprocess_glob,json=reprocessGlob,proto3" json:"reprocess_glob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PipelineInfo_Details) Reset()         { *m = PipelineInfo_Details{} }
 func (m *PipelineInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo_Details) ProtoMessage()    {}
 func (*PipelineInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{35, 0}
 }
 func (m *PipelineInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2854,19 +3703,203 @@ func (m *PipelineInfo_Details) GetAutoscaling() bool {
 	return false
 }
 
-type PipelineInfos struct {
-	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *PipelineInfo_Details) GetDedupAgainst() string {
+	if m != nil {
+		return m.DedupAgainst
+	}
+	return ""
 }
 
-func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
-func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
-func (*PipelineInfos) ProtoMessage()    {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
-}
+func (m *PipelineInfo_Details) GetFailureReport() bool {
+	if m != nil {
+		return m.FailureReport
+	}
+	return false
+}
+
+func (m *PipelineInfo_Details) GetNodePool() string {
+	if m != nil {
+		return m.NodePool
+	}
+	return ""
+}
+
+func (m *PipelineInfo_Details) GetPassthrough() bool {
+	if m != nil {
+		return m.Passthrough
+	}
+	return false
+}
+
+func (m *PipelineInfo_Details) GetPreviousOutput() string {
+	if m != nil {
+		return m.PreviousOutput
+	}
+	return ""
+}
+
+func (m *PipelineInfo_Details) GetShardByKey() bool {
+	if m != nil {
+		return m.ShardByKey
+	}
+	return false
+}
+
+func (m *PipelineInfo_Details) GetDatumMemoryScaling() *DatumMemoryScaling {
+	if m != nil {
+		return m.DatumMemoryScaling
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetQuarantineAfter() int64 {
+	if m != nil {
+		return m.QuarantineAfter
+	}
+	return 0
+}
+
+func (m *PipelineInfo_Details) GetHeartbeat() *Heartbeat {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetJobHistoryLimit() int64 {
+	if m != nil {
+		return m.JobHistoryLimit
+	}
+	return 0
+}
+
+func (m *PipelineInfo_Details) GetCrashBackoff() *CrashBackoff {
+	if m != nil {
+		return m.CrashBackoff
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetMaxInFlightJobs() int64 {
+	if m != nil {
+		return m.MaxInFlightJobs
+	}
+	return 0
+}
+
+func (m *PipelineInfo_Details) GetJobsInFlight() int64 {
+	if m != nil {
+		return m.JobsInFlight
+	}
+	return 0
+}
+
+func (m *PipelineInfo_Details) GetNoMeta() bool {
+	if m != nil {
+		return m.NoMeta
+	}
+	return false
+}
+
+func (m *PipelineInfo_Details) GetMaintenanceSchedule() *MaintenanceSchedule {
+	if m != nil {
+		return m.MaintenanceSchedule
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetMaintenanceWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindow
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetReprocessGlob() string {
+	if m != nil {
+		return m.ReprocessGlob
+	}
+	return ""
+}
+
+// JobSummary is a brief description of a job, returned in
+// PipelineInfo.recent_jobs.
+type JobSummary struct {
+	Job                  *Job             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	State                JobState         `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Finished             *types.Timestamp `protobuf:"bytes,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *JobSummary) Reset()         { *m = JobSummary{} }
+func (m *JobSummary) String() string { return proto.CompactTextString(m) }
+func (*JobSummary) ProtoMessage()    {}
+func (*JobSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{36}
+}
+func (m *JobSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSummary.Merge(m, src)
+}
+func (m *JobSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSummary proto.InternalMessageInfo
+
+func (m *JobSummary) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *JobSummary) GetState() JobState {
+	if m != nil {
+		return m.State
+	}
+	return JobState_JOB_STATE_UNKNOWN
+}
+
+func (m *JobSummary) GetFinished() *types.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+type PipelineInfos struct {
+	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
+func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
+func (*PipelineInfos) ProtoMessage()    {}
+func (*PipelineInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{37}
+}
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
@@ -2912,7 +3945,7 @@ func (m *JobSet) Reset()         { *m = JobSet{} }
 func (m *JobSet) String() string { return proto.CompactTextString(m) }
 func (*JobSet) ProtoMessage()    {}
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *JobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2961,7 +3994,7 @@ func (m *InspectJobSetRequest) Reset()         { *m = InspectJobSetRequest{} }
 func (m *InspectJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobSetRequest) ProtoMessage()    {}
 func (*InspectJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *InspectJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3022,7 +4055,7 @@ func (m *ListJobSetRequest) Reset()         { *m = ListJobSetRequest{} }
 func (m *ListJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobSetRequest) ProtoMessage()    {}
 func (*ListJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *ListJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3072,7 +4105,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3150,7 +4183,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3216,18 +4249,22 @@ func (m *ListJobRequest) GetJqFilter() string {
 
 // Streams open jobs until canceled
 type SubscribeJobRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Details              bool      `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Details  bool      `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
+	// If true, every job in the pipeline is sent each time it's created or its
+	// state changes, starting with the current state of existing jobs, rather
+	// than each open job being sent once.
+	Transitions          bool     `protobuf:"varint,3,opt,name=transitions,proto3" json:"transitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SubscribeJobRequest) Reset()         { *m = SubscribeJobRequest{} }
 func (m *SubscribeJobRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeJobRequest) ProtoMessage()    {}
 func (*SubscribeJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{43}
 }
 func (m *SubscribeJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3270,6 +4307,13 @@ func (m *SubscribeJobRequest) GetDetails() bool {
 	return false
 }
 
+func (m *SubscribeJobRequest) GetTransitions() bool {
+	if m != nil {
+		return m.Transitions
+	}
+	return false
+}
+
 type DeleteJobRequest struct {
 	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3281,7 +4325,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{44}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3329,7 +4373,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3392,7 +4436,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{46}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3517,17 +4561,20 @@ type GetLogsRequest struct {
 	// setting the LOKI_LOGGING feature flag.
 	UseLokiBackend bool `protobuf:"varint,8,opt,name=use_loki_backend,json=useLokiBackend,proto3" json:"use_loki_backend,omitempty"`
 	// Since specifies how far in the past to return logs from. It defaults to 24 hours.
-	Since                *types.Duration `protobuf:"bytes,9,opt,name=since,proto3" json:"since,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Since *types.Duration `protobuf:"bytes,9,opt,name=since,proto3" json:"since,omitempty"`
+	// If true only return log lines from the user's code, dropping the
+	// worker's own log lines before they are sent.
+	UserOnly             bool     `protobuf:"varint,10,opt,name=user_only,json=userOnly,proto3" json:"user_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{47}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3619,6 +4666,13 @@ func (m *GetLogsRequest) GetSince() *types.Duration {
 	return nil
 }
 
+func (m *GetLogsRequest) GetUserOnly() bool {
+	if m != nil {
+		return m.UserOnly
+	}
+	return false
+}
+
 // LogMessage is a log line from a PPS worker, annotated with metadata
 // indicating when and why the line was logged.
 type LogMessage struct {
@@ -3645,7 +4699,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3749,7 +4803,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3803,7 +4857,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{50}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +4901,11 @@ type ListDatumRequest struct {
 	// Input is the input to list datums from.
 	// The datums listed are the ones that would be run if a pipeline was created
 	// with the provided input.
-	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Input *Input `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// datum_fileset_id lists the datums recorded in a job's datum file set
+	// (see JobInfo.Details.datum_fileset_id). Each datum is reported with the
+	// state it was given by the job that processed it.
+	DatumFilesetId       string   `protobuf:"bytes,3,opt,name=datum_fileset_id,json=datumFilesetId,proto3" json:"datum_fileset_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3857,7 +4915,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{51}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3900,36 +4958,36 @@ func (m *ListDatumRequest) GetInput() *Input {
 	return nil
 }
 
-// DatumSetSpec specifies how a pipeline should split its datums into datum sets.
-type DatumSetSpec struct {
-	// number, if nonzero, specifies that each datum set should contain `number`
-	// datums. Datum sets may contain fewer if the total number of datums don't
-	// divide evenly.
-	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
-	// size_bytes, if nonzero, specifies a target size for each datum set.
-	// Datum sets may be larger or smaller than size_bytes, but will usually be
-	// pretty close to size_bytes in size.
-	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	// per_worker, if nonzero, specifies how many datum sets should be created
-	// for each worker. It can't be set with number or size_bytes.
-	PerWorker            int64    `protobuf:"varint,3,opt,name=per_worker,json=perWorker,proto3" json:"per_worker,omitempty"`
+func (m *ListDatumRequest) GetDatumFilesetId() string {
+	if m != nil {
+		return m.DatumFilesetId
+	}
+	return ""
+}
+
+type GetDatumCountRequest struct {
+	// Pipeline, if set and input is unset, counts the datums of the pipeline's
+	// input.
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Input is the input to count datums for.
+	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DatumSetSpec) Reset()         { *m = DatumSetSpec{} }
-func (m *DatumSetSpec) String() string { return proto.CompactTextString(m) }
-func (*DatumSetSpec) ProtoMessage()    {}
-func (*DatumSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+func (m *GetDatumCountRequest) Reset()         { *m = GetDatumCountRequest{} }
+func (m *GetDatumCountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDatumCountRequest) ProtoMessage()    {}
+func (*GetDatumCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
-func (m *DatumSetSpec) XXX_Unmarshal(b []byte) error {
+func (m *GetDatumCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DatumSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDatumCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DatumSetSpec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDatumCountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3939,52 +4997,359 @@ func (m *DatumSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DatumSetSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DatumSetSpec.Merge(m, src)
+func (m *GetDatumCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDatumCountRequest.Merge(m, src)
 }
-func (m *DatumSetSpec) XXX_Size() int {
+func (m *GetDatumCountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DatumSetSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_DatumSetSpec.DiscardUnknown(m)
+func (m *GetDatumCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDatumCountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DatumSetSpec proto.InternalMessageInfo
+var xxx_messageInfo_GetDatumCountRequest proto.InternalMessageInfo
 
-func (m *DatumSetSpec) GetNumber() int64 {
+func (m *GetDatumCountRequest) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.Number
+		return m.Pipeline
 	}
-	return 0
+	return nil
 }
 
-func (m *DatumSetSpec) GetSizeBytes() int64 {
+func (m *GetDatumCountRequest) GetInput() *Input {
 	if m != nil {
-		return m.SizeBytes
+		return m.Input
 	}
-	return 0
+	return nil
 }
 
-func (m *DatumSetSpec) GetPerWorker() int64 {
-	if m != nil {
-		return m.PerWorker
-	}
-	return 0
+type GetDatumCountResponse struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type SchedulingSpec struct {
-	NodeSelector         map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	PriorityClassName    string            `protobuf:"bytes,2,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *GetDatumCountResponse) Reset()         { *m = GetDatumCountResponse{} }
+func (m *GetDatumCountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDatumCountResponse) ProtoMessage()    {}
+func (*GetDatumCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
-
-func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
-func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
-func (*SchedulingSpec) ProtoMessage()    {}
-func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+func (m *GetDatumCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDatumCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDatumCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDatumCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDatumCountResponse.Merge(m, src)
+}
+func (m *GetDatumCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDatumCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDatumCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDatumCountResponse proto.InternalMessageInfo
+
+func (m *GetDatumCountResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type InspectEnterpriseFeaturesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectEnterpriseFeaturesRequest) Reset()         { *m = InspectEnterpriseFeaturesRequest{} }
+func (m *InspectEnterpriseFeaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectEnterpriseFeaturesRequest) ProtoMessage()    {}
+func (*InspectEnterpriseFeaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{54}
+}
+func (m *InspectEnterpriseFeaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectEnterpriseFeaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectEnterpriseFeaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectEnterpriseFeaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectEnterpriseFeaturesRequest.Merge(m, src)
+}
+func (m *InspectEnterpriseFeaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectEnterpriseFeaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectEnterpriseFeaturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectEnterpriseFeaturesRequest proto.InternalMessageInfo
+
+type InspectEnterpriseFeaturesResponse struct {
+	// enterprise is true if the cluster has an active enterprise license.
+	Enterprise bool `protobuf:"varint,1,opt,name=enterprise,proto3" json:"enterprise,omitempty"`
+	// loki_logs is true if logs can be read from Loki.
+	LokiLogs             bool     `protobuf:"varint,2,opt,name=loki_logs,json=lokiLogs,proto3" json:"loki_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectEnterpriseFeaturesResponse) Reset()         { *m = InspectEnterpriseFeaturesResponse{} }
+func (m *InspectEnterpriseFeaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*InspectEnterpriseFeaturesResponse) ProtoMessage()    {}
+func (*InspectEnterpriseFeaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{55}
+}
+func (m *InspectEnterpriseFeaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectEnterpriseFeaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectEnterpriseFeaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectEnterpriseFeaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectEnterpriseFeaturesResponse.Merge(m, src)
+}
+func (m *InspectEnterpriseFeaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectEnterpriseFeaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectEnterpriseFeaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectEnterpriseFeaturesResponse proto.InternalMessageInfo
+
+func (m *InspectEnterpriseFeaturesResponse) GetEnterprise() bool {
+	if m != nil {
+		return m.Enterprise
+	}
+	return false
+}
+
+func (m *InspectEnterpriseFeaturesResponse) GetLokiLogs() bool {
+	if m != nil {
+		return m.LokiLogs
+	}
+	return false
+}
+
+type InspectClusterLimitsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectClusterLimitsRequest) Reset()         { *m = InspectClusterLimitsRequest{} }
+func (m *InspectClusterLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectClusterLimitsRequest) ProtoMessage()    {}
+func (*InspectClusterLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{56}
+}
+func (m *InspectClusterLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectClusterLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectClusterLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectClusterLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectClusterLimitsRequest.Merge(m, src)
+}
+func (m *InspectClusterLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectClusterLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectClusterLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectClusterLimitsRequest proto.InternalMessageInfo
+
+// ClusterLimits describes the maximums that CreatePipeline enforces on this
+// cluster. A value of 0 means there is no limit.
+type ClusterLimits struct {
+	// max_pipelines is the maximum number of pipelines the cluster may have.
+	MaxPipelines int64 `protobuf:"varint,1,opt,name=max_pipelines,json=maxPipelines,proto3" json:"max_pipelines,omitempty"`
+	// max_parallelism is the maximum number of workers a single pipeline may
+	// request through its parallelism_spec.
+	MaxParallelism       int64    `protobuf:"varint,2,opt,name=max_parallelism,json=maxParallelism,proto3" json:"max_parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterLimits) Reset()         { *m = ClusterLimits{} }
+func (m *ClusterLimits) String() string { return proto.CompactTextString(m) }
+func (*ClusterLimits) ProtoMessage()    {}
+func (*ClusterLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{57}
+}
+func (m *ClusterLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterLimits.Merge(m, src)
+}
+func (m *ClusterLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterLimits proto.InternalMessageInfo
+
+func (m *ClusterLimits) GetMaxPipelines() int64 {
+	if m != nil {
+		return m.MaxPipelines
+	}
+	return 0
+}
+
+func (m *ClusterLimits) GetMaxParallelism() int64 {
+	if m != nil {
+		return m.MaxParallelism
+	}
+	return 0
+}
+
+// DatumSetSpec specifies how a pipeline should split its datums into datum sets.
+type DatumSetSpec struct {
+	// number, if nonzero, specifies that each datum set should contain `number`
+	// datums. Datum sets may contain fewer if the total number of datums don't
+	// divide evenly.
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// size_bytes, if nonzero, specifies a target size for each datum set.
+	// Datum sets may be larger or smaller than size_bytes, but will usually be
+	// pretty close to size_bytes in size.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// per_worker, if nonzero, specifies how many datum sets should be created
+	// for each worker. It can't be set with number or size_bytes.
+	PerWorker            int64    `protobuf:"varint,3,opt,name=per_worker,json=perWorker,proto3" json:"per_worker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumSetSpec) Reset()         { *m = DatumSetSpec{} }
+func (m *DatumSetSpec) String() string { return proto.CompactTextString(m) }
+func (*DatumSetSpec) ProtoMessage()    {}
+func (*DatumSetSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{58}
+}
+func (m *DatumSetSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumSetSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumSetSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumSetSpec.Merge(m, src)
+}
+func (m *DatumSetSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumSetSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumSetSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumSetSpec proto.InternalMessageInfo
+
+func (m *DatumSetSpec) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *DatumSetSpec) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *DatumSetSpec) GetPerWorker() int64 {
+	if m != nil {
+		return m.PerWorker
+	}
+	return 0
+}
+
+type SchedulingSpec struct {
+	NodeSelector         map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PriorityClassName    string            `protobuf:"bytes,2,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
+func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
+func (*SchedulingSpec) ProtoMessage()    {}
+func (*SchedulingSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4051,31 +5416,96 @@ type CreatePipelineRequest struct {
 	Description           string        `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Service              *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec         *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumTries           int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	Autoscaling          bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess    bool          `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Service      *Service      `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
+	Spout        *Spout        `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec *DatumSetSpec `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	// datum_timeout, if set, fails any datum that runs for longer than it.
+	DatumTimeout *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	// job_timeout, if set, fails any job that runs for longer than it, with a
+	// reason explaining the timeout.
+	JobTimeout     *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumTries     int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	Autoscaling    bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	// dedup_against, if set, names a branch in the pipeline's output repo. Output
+	// files that are identical to the same path in the head of that branch are
+	// copied from it rather than uploaded again. If it names the output branch,
+	// the previous output commit is used.
+	DedupAgainst string `protobuf:"bytes,31,opt,name=dedup_against,json=dedupAgainst,proto3" json:"dedup_against,omitempty"`
+	// failure_report, if true, causes a report of the failed datums of a failed
+	// job to be written to the failure report branch of the output repo.
+	FailureReport bool `protobuf:"varint,32,opt,name=failure_report,json=failureReport,proto3" json:"failure_report,omitempty"`
+	// node_pool, if set, names one of the node pools configured in the cluster.
+	// The pool's node selector is added to the pipeline's worker pods.
+	NodePool string `protobuf:"bytes,33,opt,name=node_pool,json=nodePool,proto3" json:"node_pool,omitempty"`
+	// passthrough, if true, causes the input files of each datum to be copied to
+	// the output unless the transform writes a file at the same path.
+	Passthrough bool `protobuf:"varint,34,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	// previous_output, if set, is the name under /pfs at which the pipeline's
+	// previous output commit is mounted (read-only) while its datums run. The
+	// previous output isn't an input, so it doesn't add to the pipeline's
+	// provenance.
+	PreviousOutput string `protobuf:"bytes,35,opt,name=previous_output,json=previousOutput,proto3" json:"previous_output,omitempty"`
+	// shard_by_key, if true, causes the datums of a job to be ordered by their
+	// join_on or group_by key, and datums that share a key to be processed by
+	// the same worker.
+	ShardByKey bool `protobuf:"varint,36,opt,name=shard_by_key,json=shardByKey,proto3" json:"shard_by_key,omitempty"`
+	// datum_memory_scaling, if set, limits the memory each datum's user code may
+	// use according to the size of the datum's inputs, so that large datums are
+	// given more memory than small ones.
+	DatumMemoryScaling *DatumMemoryScaling `protobuf:"bytes,37,opt,name=datum_memory_scaling,json=datumMemoryScaling,proto3" json:"datum_memory_scaling,omitempty"`
+	// dry_run, if true, causes the pipeline to be validated as if it were being
+	// created, without creating it or changing any other cluster state.
+	DryRun bool `protobuf:"varint,38,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// quarantine_after, if set, causes a datum that has failed in this many
+	// consecutive jobs to be quarantined: later jobs skip it until its inputs
+	// change or it is released with ReleaseQuarantine.
+	QuarantineAfter int64 `protobuf:"varint,39,opt,name=quarantine_after,json=quarantineAfter,proto3" json:"quarantine_after,omitempty"`
+	// heartbeat, if set, causes each worker to periodically record a heartbeat
+	// (see InspectWorkerHeartbeat), and the pipeline to be marked CRASHING if
+	// its heartbeats stall.
+	Heartbeat *Heartbeat `protobuf:"bytes,40,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// job_history_limit, if set, causes the pipeline's finished jobs beyond the
+	// most recent job_history_limit to be deleted, so that ListJob stays bounded.
+	// The jobs' output commits are kept, as they belong to commitsets that
+	// include their inputs, but their meta commits are squashed into the meta
+	// commits of later jobs.
+	JobHistoryLimit int64 `protobuf:"varint,41,opt,name=job_history_limit,json=jobHistoryLimit,proto3" json:"job_history_limit,omitempty"`
+	// crash_backoff, if set, controls how often a CRASHING pipeline is checked
+	// for recovery, and how many checks it may fail before it's marked FAILURE.
+	CrashBackoff *CrashBackoff `protobuf:"bytes,42,opt,name=crash_backoff,json=crashBackoff,proto3" json:"crash_backoff,omitempty"`
+	// max_in_flight_jobs, if set, is the maximum number of the pipeline's jobs
+	// that may be processed at once. Further jobs stay in JOB_CREATED until an
+	// earlier job finishes.
+	MaxInFlightJobs int64 `protobuf:"varint,43,opt,name=max_in_flight_jobs,json=maxInFlightJobs,proto3" json:"max_in_flight_jobs,omitempty"`
+	// no_meta, if set, stops the pipeline from keeping a meta repo. Without the
+	// datums that each job processed, every job reprocesses all of its datums
+	// and rewrites its output commit from scratch.
+	NoMeta bool `protobuf:"varint,44,opt,name=no_meta,json=noMeta,proto3" json:"no_meta,omitempty"`
+	// maintenance_schedule, if set, pauses the pipeline during the windows it
+	// describes.
+	MaintenanceSchedule *MaintenanceSchedule `protobuf:"bytes,45,opt,name=maintenance_schedule,json=maintenanceSchedule,proto3" json:"maintenance_schedule,omitempty"`
+	// reprocess_glob, if set when updating a pipeline, limits reprocessing to
+	// the datums that have an input path matching it. The new version's first
+	// job skips the other datums, keeping their outputs from the old version.
+	ReprocessGlob        string   `protobuf:"bytes,46,opt,name=reprocess_glob,json=reprocessGlob,proto3" json:"reprocess_glob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,24 +5730,139 @@ func (m *CreatePipelineRequest) GetAutoscaling() bool {
 	return false
 }
 
-type InspectPipelineRequest struct {
-	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	// When true, return PipelineInfos with the details field, which requires
-	// loading the pipeline spec from PFS.
-	Details              bool     `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreatePipelineRequest) GetDedupAgainst() string {
+	if m != nil {
+		return m.DedupAgainst
+	}
+	return ""
 }
 
-func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
-func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectPipelineRequest) ProtoMessage()    {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+func (m *CreatePipelineRequest) GetFailureReport() bool {
+	if m != nil {
+		return m.FailureReport
+	}
+	return false
 }
-func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *CreatePipelineRequest) GetNodePool() string {
+	if m != nil {
+		return m.NodePool
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetPassthrough() bool {
+	if m != nil {
+		return m.Passthrough
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetPreviousOutput() string {
+	if m != nil {
+		return m.PreviousOutput
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetShardByKey() bool {
+	if m != nil {
+		return m.ShardByKey
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetDatumMemoryScaling() *DatumMemoryScaling {
+	if m != nil {
+		return m.DatumMemoryScaling
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetQuarantineAfter() int64 {
+	if m != nil {
+		return m.QuarantineAfter
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetHeartbeat() *Heartbeat {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetJobHistoryLimit() int64 {
+	if m != nil {
+		return m.JobHistoryLimit
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetCrashBackoff() *CrashBackoff {
+	if m != nil {
+		return m.CrashBackoff
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetMaxInFlightJobs() int64 {
+	if m != nil {
+		return m.MaxInFlightJobs
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetNoMeta() bool {
+	if m != nil {
+		return m.NoMeta
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetMaintenanceSchedule() *MaintenanceSchedule {
+	if m != nil {
+		return m.MaintenanceSchedule
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetReprocessGlob() string {
+	if m != nil {
+		return m.ReprocessGlob
+	}
+	return ""
+}
+
+type InspectPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// When true, return PipelineInfos with the details field, which requires
+	// loading the pipeline spec from PFS.
+	Details bool `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
+	// recent_jobs is the number of the pipeline's most recent jobs to summarize
+	// in the response. It's capped at 10.
+	RecentJobs           int64    `protobuf:"varint,3,opt,name=recent_jobs,json=recentJobs,proto3" json:"recent_jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
+func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectPipelineRequest) ProtoMessage()    {}
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{61}
+}
+func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -4357,6 +5902,13 @@ func (m *InspectPipelineRequest) GetDetails() bool {
 	return false
 }
 
+func (m *InspectPipelineRequest) GetRecentJobs() int64 {
+	if m != nil {
+		return m.RecentJobs
+	}
+	return 0
+}
+
 type ListPipelineRequest struct {
 	// If non-nil, only return info about a single pipeline, this is redundant
 	// with InspectPipeline unless history is non-zero.
@@ -4382,7 +5934,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4453,7 +6005,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4510,18 +6062,84 @@ func (m *DeletePipelineRequest) GetKeepRepo() bool {
 	return false
 }
 
+type DeletePipelinesRequest struct {
+	Pipelines            []*Pipeline `protobuf:"bytes,1,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	Force                bool        `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	KeepRepo             bool        `protobuf:"varint,3,opt,name=keep_repo,json=keepRepo,proto3" json:"keep_repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DeletePipelinesRequest) Reset()         { *m = DeletePipelinesRequest{} }
+func (m *DeletePipelinesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePipelinesRequest) ProtoMessage()    {}
+func (*DeletePipelinesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{64}
+}
+func (m *DeletePipelinesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePipelinesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePipelinesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePipelinesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePipelinesRequest.Merge(m, src)
+}
+func (m *DeletePipelinesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePipelinesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePipelinesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePipelinesRequest proto.InternalMessageInfo
+
+func (m *DeletePipelinesRequest) GetPipelines() []*Pipeline {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *DeletePipelinesRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *DeletePipelinesRequest) GetKeepRepo() bool {
+	if m != nil {
+		return m.KeepRepo
+	}
+	return false
+}
+
 type StartPipelineRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// pipelines, if set, are started along with pipeline, in a single transaction:
+	// if any of them can't be started, none are.
+	Pipelines            []*Pipeline `protobuf:"bytes,2,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{65}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4557,18 +6175,28 @@ func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *StartPipelineRequest) GetPipelines() []*Pipeline {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
 type StopPipelineRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// pipelines, if set, are stopped along with pipeline, in a single transaction:
+	// if any of them can't be stopped, none are.
+	Pipelines            []*Pipeline `protobuf:"bytes,2,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{66}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4604,6 +6232,13 @@ func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *StopPipelineRequest) GetPipelines() []*Pipeline {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
 type RunPipelineRequest struct {
 	Pipeline             *Pipeline     `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	Provenance           []*pfs.Commit `protobuf:"bytes,2,rep,name=provenance,proto3" json:"provenance,omitempty"`
@@ -4617,7 +6252,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{67}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +6313,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{68}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4714,25 +6349,35 @@ func (m *RunCronRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
-type CreateSecretRequest struct {
-	File                 []byte   `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+type ReprocessPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// reprocess_spec selects the datums the new job processes: "every_job" (the
+	// default) processes every datum, while "until_success" only processes the
+	// datums that didn't succeed in the pipeline's previous job.
+	ReprocessSpec string `protobuf:"bytes,2,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	// job, if set, is the job whose datums are reprocessed. It must be the
+	// pipeline's most recent job, and must have finished. Older jobs aren't
+	// supported because the new job's output is built on the output commit of
+	// the pipeline's most recent job: reprocessing an older job's datums on top
+	// of it would mix that job's inputs with the outputs of the later jobs.
+	Job                  *Job     `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
-func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateSecretRequest) ProtoMessage()    {}
-func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+func (m *ReprocessPipelineRequest) Reset()         { *m = ReprocessPipelineRequest{} }
+func (m *ReprocessPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*ReprocessPipelineRequest) ProtoMessage()    {}
+func (*ReprocessPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{69}
 }
-func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReprocessPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReprocessPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateSecretRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReprocessPipelineRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4742,44 +6387,59 @@ func (m *CreateSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateSecretRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateSecretRequest.Merge(m, src)
+func (m *ReprocessPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessPipelineRequest.Merge(m, src)
 }
-func (m *CreateSecretRequest) XXX_Size() int {
+func (m *ReprocessPipelineRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateSecretRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateSecretRequest.DiscardUnknown(m)
+func (m *ReprocessPipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessPipelineRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateSecretRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReprocessPipelineRequest proto.InternalMessageInfo
 
-func (m *CreateSecretRequest) GetFile() []byte {
+func (m *ReprocessPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.File
+		return m.Pipeline
 	}
 	return nil
 }
 
-type DeleteSecretRequest struct {
-	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReprocessPipelineRequest) GetReprocessSpec() string {
+	if m != nil {
+		return m.ReprocessSpec
+	}
+	return ""
 }
 
-func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
-func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteSecretRequest) ProtoMessage()    {}
-func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+func (m *ReprocessPipelineRequest) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
 }
-func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
+
+type ReleaseQuarantineRequest struct {
+	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	DatumID              string    `protobuf:"bytes,2,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReleaseQuarantineRequest) Reset()         { *m = ReleaseQuarantineRequest{} }
+func (m *ReleaseQuarantineRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseQuarantineRequest) ProtoMessage()    {}
+func (*ReleaseQuarantineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{70}
+}
+func (m *ReleaseQuarantineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReleaseQuarantineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteSecretRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReleaseQuarantineRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4789,44 +6449,51 @@ func (m *DeleteSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteSecretRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteSecretRequest.Merge(m, src)
+func (m *ReleaseQuarantineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseQuarantineRequest.Merge(m, src)
 }
-func (m *DeleteSecretRequest) XXX_Size() int {
+func (m *ReleaseQuarantineRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteSecretRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteSecretRequest.DiscardUnknown(m)
+func (m *ReleaseQuarantineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseQuarantineRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteSecretRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseQuarantineRequest proto.InternalMessageInfo
 
-func (m *DeleteSecretRequest) GetSecret() *Secret {
+func (m *ReleaseQuarantineRequest) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.Secret
+		return m.Pipeline
 	}
 	return nil
 }
 
-type InspectSecretRequest struct {
-	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReleaseQuarantineRequest) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
 }
 
-func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
-func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectSecretRequest) ProtoMessage()    {}
-func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+type InspectWorkerHeartbeatRequest struct {
+	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *InspectWorkerHeartbeatRequest) Reset()         { *m = InspectWorkerHeartbeatRequest{} }
+func (m *InspectWorkerHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectWorkerHeartbeatRequest) ProtoMessage()    {}
+func (*InspectWorkerHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{71}
+}
+func (m *InspectWorkerHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InspectSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectWorkerHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InspectSecretRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectWorkerHeartbeatRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4836,26 +6503,269 @@ func (m *InspectSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *InspectSecretRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InspectSecretRequest.Merge(m, src)
+func (m *InspectWorkerHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectWorkerHeartbeatRequest.Merge(m, src)
 }
-func (m *InspectSecretRequest) XXX_Size() int {
+func (m *InspectWorkerHeartbeatRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InspectSecretRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InspectSecretRequest.DiscardUnknown(m)
+func (m *InspectWorkerHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectWorkerHeartbeatRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InspectSecretRequest proto.InternalMessageInfo
+var xxx_messageInfo_InspectWorkerHeartbeatRequest proto.InternalMessageInfo
 
-func (m *InspectSecretRequest) GetSecret() *Secret {
+func (m *InspectWorkerHeartbeatRequest) GetPipeline() *Pipeline {
 	if m != nil {
-		return m.Secret
+		return m.Pipeline
 	}
 	return nil
 }
 
-type Secret struct {
+type WorkerHeartbeat struct {
+	WorkerID             string           `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Timestamp            *types.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkerHeartbeat) Reset()         { *m = WorkerHeartbeat{} }
+func (m *WorkerHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*WorkerHeartbeat) ProtoMessage()    {}
+func (*WorkerHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{72}
+}
+func (m *WorkerHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerHeartbeat.Merge(m, src)
+}
+func (m *WorkerHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerHeartbeat proto.InternalMessageInfo
+
+func (m *WorkerHeartbeat) GetWorkerID() string {
+	if m != nil {
+		return m.WorkerID
+	}
+	return ""
+}
+
+func (m *WorkerHeartbeat) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type WorkerHeartbeats struct {
+	Heartbeats           []*WorkerHeartbeat `protobuf:"bytes,1,rep,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *WorkerHeartbeats) Reset()         { *m = WorkerHeartbeats{} }
+func (m *WorkerHeartbeats) String() string { return proto.CompactTextString(m) }
+func (*WorkerHeartbeats) ProtoMessage()    {}
+func (*WorkerHeartbeats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{73}
+}
+func (m *WorkerHeartbeats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerHeartbeats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerHeartbeats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerHeartbeats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerHeartbeats.Merge(m, src)
+}
+func (m *WorkerHeartbeats) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerHeartbeats) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerHeartbeats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerHeartbeats proto.InternalMessageInfo
+
+func (m *WorkerHeartbeats) GetHeartbeats() []*WorkerHeartbeat {
+	if m != nil {
+		return m.Heartbeats
+	}
+	return nil
+}
+
+type CreateSecretRequest struct {
+	File                 []byte   `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
+func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSecretRequest) ProtoMessage()    {}
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{74}
+}
+func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSecretRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSecretRequest.Merge(m, src)
+}
+func (m *CreateSecretRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSecretRequest proto.InternalMessageInfo
+
+func (m *CreateSecretRequest) GetFile() []byte {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
+func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSecretRequest) ProtoMessage()    {}
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{75}
+}
+func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSecretRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSecretRequest.Merge(m, src)
+}
+func (m *DeleteSecretRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSecretRequest proto.InternalMessageInfo
+
+func (m *DeleteSecretRequest) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type InspectSecretRequest struct {
+	Secret               *Secret  `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
+func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectSecretRequest) ProtoMessage()    {}
+func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{76}
+}
+func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectSecretRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectSecretRequest.Merge(m, src)
+}
+func (m *InspectSecretRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectSecretRequest proto.InternalMessageInfo
+
+func (m *InspectSecretRequest) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type Secret struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -4866,7 +6776,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{77}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4915,7 +6825,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{78}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +6886,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{79}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5012,6 +6922,75 @@ func (m *SecretInfos) GetSecretInfo() []*SecretInfo {
 	return nil
 }
 
+// PipelineBundle is a portable description of a pipeline, used to move it
+// between clusters.
+type PipelineBundle struct {
+	// spec recreates the pipeline.
+	Spec *CreatePipelineRequest `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// version is the pipeline's version on the cluster it was exported from.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// secrets are the names of the kubernetes secrets the pipeline references.
+	// Their values aren't included.
+	Secrets              []string `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PipelineBundle) Reset()         { *m = PipelineBundle{} }
+func (m *PipelineBundle) String() string { return proto.CompactTextString(m) }
+func (*PipelineBundle) ProtoMessage()    {}
+func (*PipelineBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{80}
+}
+func (m *PipelineBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineBundle.Merge(m, src)
+}
+func (m *PipelineBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineBundle proto.InternalMessageInfo
+
+func (m *PipelineBundle) GetSpec() *CreatePipelineRequest {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *PipelineBundle) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PipelineBundle) GetSecrets() []string {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
 type ActivateAuthRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5022,7 +7001,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{81}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5061,7 +7040,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{82}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5097,6 +7076,7 @@ func init() {
 	proto.RegisterEnum("pps_v2.PipelineState", PipelineState_name, PipelineState_value)
 	proto.RegisterEnum("pps_v2.PipelineInfo_PipelineType", PipelineInfo_PipelineType_name, PipelineInfo_PipelineType_value)
 	proto.RegisterType((*SecretMount)(nil), "pps_v2.SecretMount")
+	proto.RegisterType((*ConfigMapMount)(nil), "pps_v2.ConfigMapMount")
 	proto.RegisterType((*Transform)(nil), "pps_v2.Transform")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Transform.EnvEntry")
 	proto.RegisterType((*TFJob)(nil), "pps_v2.TFJob")
@@ -5115,6 +7095,8 @@ func init() {
 	proto.RegisterType((*InputFile)(nil), "pps_v2.InputFile")
 	proto.RegisterType((*Datum)(nil), "pps_v2.Datum")
 	proto.RegisterType((*DatumInfo)(nil), "pps_v2.DatumInfo")
+	proto.RegisterType((*FailureReport)(nil), "pps_v2.FailureReport")
+	proto.RegisterType((*FailedDatum)(nil), "pps_v2.FailedDatum")
 	proto.RegisterType((*Aggregate)(nil), "pps_v2.Aggregate")
 	proto.RegisterType((*ProcessStats)(nil), "pps_v2.ProcessStats")
 	proto.RegisterType((*AggregateProcessStats)(nil), "pps_v2.AggregateProcessStats")
@@ -5122,6 +7104,11 @@ func init() {
 	proto.RegisterType((*DatumStatus)(nil), "pps_v2.DatumStatus")
 	proto.RegisterType((*ResourceSpec)(nil), "pps_v2.ResourceSpec")
 	proto.RegisterType((*GPUSpec)(nil), "pps_v2.GPUSpec")
+	proto.RegisterType((*DatumMemoryScaling)(nil), "pps_v2.DatumMemoryScaling")
+	proto.RegisterType((*Heartbeat)(nil), "pps_v2.Heartbeat")
+	proto.RegisterType((*CrashBackoff)(nil), "pps_v2.CrashBackoff")
+	proto.RegisterType((*MaintenanceSchedule)(nil), "pps_v2.MaintenanceSchedule")
+	proto.RegisterType((*MaintenanceWindow)(nil), "pps_v2.MaintenanceWindow")
 	proto.RegisterType((*JobSetInfo)(nil), "pps_v2.JobSetInfo")
 	proto.RegisterType((*JobInfo)(nil), "pps_v2.JobInfo")
 	proto.RegisterType((*JobInfo_Details)(nil), "pps_v2.JobInfo.Details")
@@ -5129,6 +7116,7 @@ func init() {
 	proto.RegisterType((*Pipeline)(nil), "pps_v2.Pipeline")
 	proto.RegisterType((*PipelineInfo)(nil), "pps_v2.PipelineInfo")
 	proto.RegisterType((*PipelineInfo_Details)(nil), "pps_v2.PipelineInfo.Details")
+	proto.RegisterType((*JobSummary)(nil), "pps_v2.JobSummary")
 	proto.RegisterType((*PipelineInfos)(nil), "pps_v2.PipelineInfos")
 	proto.RegisterType((*JobSet)(nil), "pps_v2.JobSet")
 	proto.RegisterType((*InspectJobSetRequest)(nil), "pps_v2.InspectJobSetRequest")
@@ -5144,6 +7132,12 @@ func init() {
 	proto.RegisterType((*RestartDatumRequest)(nil), "pps_v2.RestartDatumRequest")
 	proto.RegisterType((*InspectDatumRequest)(nil), "pps_v2.InspectDatumRequest")
 	proto.RegisterType((*ListDatumRequest)(nil), "pps_v2.ListDatumRequest")
+	proto.RegisterType((*GetDatumCountRequest)(nil), "pps_v2.GetDatumCountRequest")
+	proto.RegisterType((*GetDatumCountResponse)(nil), "pps_v2.GetDatumCountResponse")
+	proto.RegisterType((*InspectEnterpriseFeaturesRequest)(nil), "pps_v2.InspectEnterpriseFeaturesRequest")
+	proto.RegisterType((*InspectEnterpriseFeaturesResponse)(nil), "pps_v2.InspectEnterpriseFeaturesResponse")
+	proto.RegisterType((*InspectClusterLimitsRequest)(nil), "pps_v2.InspectClusterLimitsRequest")
+	proto.RegisterType((*ClusterLimits)(nil), "pps_v2.ClusterLimits")
 	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
@@ -5151,16 +7145,23 @@ func init() {
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps_v2.InspectPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps_v2.ListPipelineRequest")
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps_v2.DeletePipelineRequest")
+	proto.RegisterType((*DeletePipelinesRequest)(nil), "pps_v2.DeletePipelinesRequest")
 	proto.RegisterType((*StartPipelineRequest)(nil), "pps_v2.StartPipelineRequest")
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps_v2.StopPipelineRequest")
 	proto.RegisterType((*RunPipelineRequest)(nil), "pps_v2.RunPipelineRequest")
 	proto.RegisterType((*RunCronRequest)(nil), "pps_v2.RunCronRequest")
+	proto.RegisterType((*ReprocessPipelineRequest)(nil), "pps_v2.ReprocessPipelineRequest")
+	proto.RegisterType((*ReleaseQuarantineRequest)(nil), "pps_v2.ReleaseQuarantineRequest")
+	proto.RegisterType((*InspectWorkerHeartbeatRequest)(nil), "pps_v2.InspectWorkerHeartbeatRequest")
+	proto.RegisterType((*WorkerHeartbeat)(nil), "pps_v2.WorkerHeartbeat")
+	proto.RegisterType((*WorkerHeartbeats)(nil), "pps_v2.WorkerHeartbeats")
 	proto.RegisterType((*CreateSecretRequest)(nil), "pps_v2.CreateSecretRequest")
 	proto.RegisterType((*DeleteSecretRequest)(nil), "pps_v2.DeleteSecretRequest")
 	proto.RegisterType((*InspectSecretRequest)(nil), "pps_v2.InspectSecretRequest")
 	proto.RegisterType((*Secret)(nil), "pps_v2.Secret")
 	proto.RegisterType((*SecretInfo)(nil), "pps_v2.SecretInfo")
 	proto.RegisterType((*SecretInfos)(nil), "pps_v2.SecretInfos")
+	proto.RegisterType((*PipelineBundle)(nil), "pps_v2.PipelineBundle")
 	proto.RegisterType((*ActivateAuthRequest)(nil), "pps_v2.ActivateAuthRequest")
 	proto.RegisterType((*ActivateAuthResponse)(nil), "pps_v2.ActivateAuthResponse")
 }
@@ -5168,293 +7169,393 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x73, 0x1b, 0x49,
-	0x72, 0x16, 0xde, 0x40, 0x02, 0x20, 0xc1, 0xe2, 0x43, 0x10, 0xf4, 0xee, 0xf1, 0x6a, 0x25, 0xed,
-	0x0c, 0x39, 0x43, 0xcd, 0xca, 0x3b, 0xf2, 0xce, 0xcc, 0xf2, 0x01, 0x69, 0x29, 0x71, 0x28, 0xba,
-	0x01, 0x6a, 0x62, 0x36, 0xec, 0xe8, 0x6d, 0xa0, 0x0b, 0x60, 0x8b, 0x40, 0x77, 0x6f, 0x3f, 0x28,
-	0x73, 0x2e, 0xf6, 0xd9, 0xe1, 0x93, 0xc7, 0x07, 0x1f, 0x7d, 0xf1, 0xc1, 0x07, 0x87, 0xfd, 0x0f,
-	0x6c, 0x47, 0xf8, 0x60, 0xdf, 0xf6, 0x64, 0x1f, 0x1c, 0x31, 0xe1, 0x50, 0xf8, 0xba, 0x77, 0x87,
-	0x4f, 0x8e, 0xca, 0xaa, 0xea, 0x07, 0xd0, 0x04, 0x5f, 0x73, 0x62, 0x55, 0x66, 0xd6, 0x2b, 0xab,
-	0x32, 0xf3, 0xcb, 0x44, 0x13, 0xea, 0x8e, 0xe3, 0xad, 0x39, 0x8e, 0xb7, 0xea, 0xb8, 0xb6, 0x6f,
-	0x93, 0xa2, 0xe3, 0x78, 0xda, 0xf1, 0x7a, 0xeb, 0xe6, 0xd0, 0xb6, 0x87, 0x23, 0xba, 0x86, 0xd4,
-	0x5e, 0x30, 0x58, 0xa3, 0x63, 0xc7, 0x3f, 0xe1, 0x42, 0xad, 0xbb, 0x93, 0x4c, 0xdf, 0x1c, 0x53,
-	0xcf, 0xd7, 0xc7, 0x8e, 0x10, 0xb8, 0x33, 0x29, 0x60, 0x04, 0xae, 0xee, 0x9b, 0xb6, 0x25, 0xf8,
-	0x4b, 0x43, 0x7b, 0x68, 0x63, 0x73, 0x8d, 0xb5, 0x04, 0xb5, 0xee, 0x0c, 0xbc, 0x35, 0x67, 0x20,
-	0xb6, 0xa2, 0x1c, 0x41, 0xb5, 0x43, 0xfb, 0x2e, 0xf5, 0xbf, 0xb2, 0x03, 0xcb, 0x27, 0x04, 0xf2,
-	0x96, 0x3e, 0xa6, 0xcd, 0xcc, 0xbd, 0xcc, 0xc3, 0x8a, 0x8a, 0x6d, 0xd2, 0x80, 0xdc, 0x11, 0x3d,
-	0x69, 0x66, 0x91, 0xc4, 0x9a, 0xe4, 0x36, 0xc0, 0x98, 0x89, 0x6b, 0x8e, 0xee, 0x1f, 0x36, 0x73,
-	0xc8, 0xa8, 0x20, 0x65, 0x5f, 0xf7, 0x0f, 0xc9, 0x75, 0x28, 0x51, 0xeb, 0x58, 0x3b, 0xd6, 0xdd,
-	0x66, 0x1e, 0x79, 0x45, 0x6a, 0x1d, 0xbf, 0xd1, 0x5d, 0xe5, 0xbf, 0x72, 0x50, 0xe9, 0xba, 0xba,
-	0xe5, 0x0d, 0x6c, 0x77, 0x4c, 0x96, 0xa0, 0x60, 0x8e, 0xf5, 0xa1, 0x5c, 0x8c, 0x77, 0xd8, 0x6a,
-	0xfd, 0xb1, 0xd1, 0xcc, 0xde, 0xcb, 0xb1, 0xd5, 0xfa, 0x63, 0x03, 0xa7, 0x73, 0x5d, 0x8d, 0x51,
-	0x73, 0x48, 0x2d, 0x52, 0xd7, 0xdd, 0x1a, 0x1b, 0xe4, 0x43, 0xc8, 0x51, 0xeb, 0xb8, 0x99, 0xbf,
-	0x97, 0x7b, 0x58, 0x5d, 0x6f, 0xad, 0x72, 0xa5, 0xae, 0x86, 0x0b, 0xac, 0xb6, 0xad, 0xe3, 0xb6,
-	0xe5, 0xbb, 0x27, 0x2a, 0x13, 0x23, 0x1f, 0x41, 0xc9, 0xc3, 0x93, 0x7a, 0xcd, 0x02, 0x8e, 0x58,
-	0x94, 0x23, 0x62, 0x0a, 0x50, 0xa5, 0x0c, 0xf9, 0x10, 0x08, 0x6e, 0x48, 0x73, 0x82, 0xd1, 0x48,
-	0x93, 0x23, 0x8b, 0xb8, 0x81, 0x06, 0x72, 0xf6, 0x83, 0xd1, 0xa8, 0x23, 0xa4, 0x97, 0xa0, 0xe0,
-	0xf9, 0x86, 0x69, 0x35, 0x4b, 0x28, 0xc0, 0x3b, 0xe4, 0x26, 0x54, 0xd8, 0xce, 0x39, 0xa7, 0x8c,
-	0x9c, 0x32, 0x75, 0xdd, 0x0e, 0x32, 0x3f, 0x04, 0xa2, 0xf7, 0xfb, 0xd4, 0xf1, 0x35, 0x97, 0xfa,
-	0x81, 0x6b, 0x69, 0x7d, 0xdb, 0xa0, 0xcd, 0xca, 0xbd, 0xdc, 0xc3, 0x9c, 0xda, 0xe0, 0x1c, 0x15,
-	0x19, 0x5b, 0xb6, 0x41, 0xd9, 0x02, 0x06, 0xed, 0x05, 0xc3, 0x26, 0xdc, 0xcb, 0x3c, 0x2c, 0xab,
-	0xbc, 0xc3, 0xae, 0x2b, 0xf0, 0xa8, 0xdb, 0xac, 0xf2, 0xeb, 0x62, 0x6d, 0x72, 0x17, 0xaa, 0xef,
-	0x6c, 0xf7, 0xc8, 0xb4, 0x86, 0x9a, 0x61, 0xba, 0xcd, 0x1a, 0xb2, 0x40, 0x90, 0xb6, 0x4d, 0x97,
-	0xdc, 0x01, 0x30, 0xec, 0xfe, 0x11, 0x75, 0x07, 0xe6, 0x88, 0x36, 0xeb, 0x9c, 0x1f, 0x51, 0x5a,
-	0x4f, 0xa1, 0x2c, 0x35, 0x27, 0xef, 0x3e, 0x13, 0xdd, 0xfd, 0x12, 0x14, 0x8e, 0xf5, 0x51, 0x40,
-	0xc5, 0x7b, 0xe0, 0x9d, 0x67, 0xd9, 0x9f, 0x65, 0x94, 0x47, 0x50, 0xe8, 0x3e, 0x7f, 0x69, 0xf7,
-	0xc8, 0x3d, 0x28, 0xfa, 0x03, 0xed, 0xad, 0xdd, 0xe3, 0xe3, 0x36, 0x2b, 0xef, 0xbf, 0xbf, 0xcb,
-	0x59, 0x6a, 0xc1, 0x1f, 0xbc, 0xb4, 0x7b, 0x4a, 0x0b, 0x8a, 0xed, 0xa1, 0x4b, 0x3d, 0x8f, 0x2d,
-	0x70, 0xa0, 0xee, 0xca, 0x05, 0x0e, 0xd4, 0x5d, 0xe5, 0x0f, 0x21, 0xc7, 0x26, 0xf9, 0x10, 0xca,
-	0x8e, 0xe9, 0xd0, 0x91, 0x69, 0xf1, 0x07, 0x52, 0x5d, 0x6f, 0xc8, 0xfb, 0xda, 0x17, 0x74, 0x35,
-	0x94, 0x20, 0x2b, 0x90, 0x35, 0x0d, 0xbe, 0xa5, 0xcd, 0xe2, 0xfb, 0xef, 0xef, 0x66, 0x77, 0xb6,
-	0xd5, 0xac, 0x69, 0x3c, 0xcb, 0xff, 0xf5, 0xdf, 0xdc, 0xbd, 0xa6, 0xfc, 0x59, 0x16, 0xca, 0x5f,
-	0x51, 0x5f, 0x37, 0x74, 0x5f, 0x27, 0x5b, 0x50, 0xd5, 0x2d, 0xcb, 0xf6, 0xd1, 0x54, 0xbc, 0x66,
-	0x06, 0xdf, 0xc2, 0x7d, 0x39, 0xb7, 0x14, 0x5b, 0xdd, 0x88, 0x64, 0xf8, 0x23, 0x8a, 0x8f, 0x22,
-	0x9f, 0x42, 0x71, 0xa4, 0xf7, 0xe8, 0xc8, 0xc3, 0x87, 0x5a, 0x5d, 0xbf, 0x35, 0x35, 0x7e, 0x17,
-	0xd9, 0x7c, 0xa8, 0x90, 0x6d, 0x7d, 0x01, 0x8d, 0xc9, 0x69, 0x2f, 0xa2, 0xe1, 0xd6, 0x67, 0x50,
-	0x8d, 0x4d, 0x7b, 0xa1, 0xcb, 0xf9, 0x53, 0x28, 0x75, 0xa8, 0x7b, 0x6c, 0xf6, 0x29, 0xf9, 0x00,
-	0xea, 0xa6, 0xe5, 0x53, 0xd7, 0xd2, 0x47, 0x9a, 0x63, 0xbb, 0x3e, 0x4e, 0x50, 0x50, 0x6b, 0x92,
-	0xb8, 0x6f, 0xbb, 0x3e, 0x13, 0xa2, 0x7f, 0x12, 0x17, 0xca, 0x72, 0x21, 0x49, 0x44, 0x21, 0xa6,
-	0x75, 0x87, 0xdb, 0xbf, 0xd0, 0xfa, 0xbe, 0x9a, 0x35, 0x1d, 0xf6, 0x2c, 0xfd, 0x13, 0x87, 0x0a,
-	0xeb, 0xc7, 0xb6, 0xb2, 0x0e, 0x85, 0x8e, 0x63, 0x07, 0x3e, 0x79, 0xc4, 0xec, 0x10, 0x77, 0x22,
-	0xee, 0x75, 0x3e, 0xb2, 0x43, 0x24, 0xab, 0x92, 0xaf, 0xfc, 0x47, 0x16, 0xca, 0xfb, 0xcf, 0x3b,
-	0x3b, 0x96, 0x13, 0xa4, 0xbb, 0x26, 0x02, 0x79, 0x97, 0x3a, 0xb6, 0x38, 0x2e, 0xb6, 0x99, 0xd1,
-	0xb1, 0xbf, 0x1a, 0xee, 0x80, 0xbf, 0xee, 0x32, 0x23, 0x74, 0x4f, 0x1c, 0xf6, 0x4e, 0x8a, 0x3d,
-	0x57, 0xb7, 0xfa, 0xd2, 0x6b, 0x89, 0x1e, 0xa3, 0xf7, 0xed, 0xf1, 0xd8, 0xf4, 0xa5, 0xc7, 0xe2,
-	0x3d, 0xb6, 0xc0, 0x70, 0x64, 0xf7, 0x9a, 0x05, 0xbe, 0x00, 0x6b, 0x33, 0x7f, 0xf4, 0xd6, 0x36,
-	0x2d, 0xcd, 0xb6, 0x9a, 0x45, 0x2e, 0xcc, 0xba, 0xaf, 0x2d, 0xe6, 0x16, 0xed, 0xc0, 0xa7, 0xae,
-	0xc6, 0xfa, 0xcd, 0x12, 0x1a, 0x6a, 0x05, 0x29, 0x2f, 0x6d, 0xd3, 0x22, 0x37, 0xa0, 0x3c, 0x74,
-	0xed, 0xc0, 0xd1, 0x7a, 0x27, 0xcd, 0x32, 0x0e, 0x2c, 0x61, 0x7f, 0xf3, 0x84, 0x2d, 0x33, 0xd2,
-	0xbf, 0x3d, 0x69, 0x56, 0x70, 0x0c, 0xb6, 0x99, 0x1d, 0x63, 0x38, 0xd0, 0x98, 0x51, 0x7a, 0xc2,
-	0xee, 0x01, 0x49, 0xcf, 0x19, 0x85, 0xcc, 0x41, 0xd6, 0x7b, 0x82, 0xa6, 0x5f, 0x56, 0xb3, 0xde,
-	0x13, 0xa6, 0x58, 0xdf, 0x35, 0x87, 0x43, 0xca, 0x8d, 0x1e, 0x15, 0x3b, 0x10, 0x2e, 0x11, 0xc9,
-	0xaa, 0xe4, 0x2b, 0xff, 0x90, 0x81, 0xca, 0x96, 0x6b, 0x5b, 0x17, 0xd3, 0x6c, 0xa4, 0xa4, 0xdc,
-	0xa4, 0x92, 0x3c, 0x87, 0xf6, 0xe5, 0x75, 0xb3, 0x36, 0xb9, 0x05, 0x15, 0xfb, 0x98, 0xba, 0xef,
-	0x5c, 0xd3, 0xa7, 0xa8, 0x3d, 0xa6, 0x0a, 0x49, 0x20, 0x1f, 0x33, 0x77, 0xa9, 0xbb, 0x3e, 0x2a,
-	0x90, 0xf9, 0x6e, 0x1e, 0xca, 0x56, 0x65, 0x28, 0x5b, 0xed, 0xca, 0x58, 0xa7, 0x72, 0x41, 0xe5,
-	0x7f, 0x32, 0x50, 0xe0, 0xbb, 0x55, 0x20, 0xe7, 0x0c, 0xbc, 0x29, 0x9f, 0x20, 0x9e, 0x89, 0xca,
-	0x98, 0xe4, 0x3e, 0xe4, 0xf1, 0x0e, 0xb8, 0x71, 0xd6, 0xa5, 0x10, 0x97, 0x40, 0x16, 0xf9, 0x00,
-	0x0a, 0xa8, 0x7d, 0x8c, 0x29, 0x53, 0x32, 0x9c, 0xc7, 0x84, 0xfa, 0xae, 0xed, 0x79, 0x22, 0xc6,
-	0x4c, 0x0a, 0x21, 0x8f, 0x09, 0x05, 0x96, 0x69, 0x5b, 0x22, 0xac, 0x4c, 0x0a, 0x21, 0x8f, 0xfc,
-	0x08, 0xf2, 0x7d, 0x57, 0xbc, 0x98, 0xea, 0xfa, 0x82, 0x94, 0x09, 0x2f, 0x41, 0x45, 0xb6, 0x62,
-	0x41, 0xf9, 0xa5, 0xdd, 0x3b, 0xfd, 0x5a, 0x1e, 0x84, 0x57, 0x90, 0xc5, 0x89, 0xe6, 0xe4, 0x15,
-	0x6f, 0x21, 0x75, 0xea, 0xdd, 0xe6, 0x62, 0xef, 0x56, 0x3e, 0xb2, 0x7c, 0xf4, 0xc8, 0x94, 0x8f,
-	0x60, 0x7e, 0x5f, 0x77, 0xf5, 0xd1, 0x88, 0x8e, 0x4c, 0x6f, 0xdc, 0x61, 0x37, 0xd7, 0x82, 0x72,
-	0xdf, 0xb6, 0x3c, 0x5f, 0xb7, 0xb8, 0x67, 0xc8, 0xab, 0x61, 0x5f, 0x79, 0x02, 0x15, 0xdc, 0x1b,
-	0x7b, 0x80, 0x6c, 0x3e, 0x8c, 0xff, 0x62, 0x7f, 0xac, 0xcd, 0x68, 0x87, 0xba, 0x77, 0x88, 0xbb,
-	0xab, 0xa9, 0xd8, 0x56, 0xbe, 0x80, 0xc2, 0xb6, 0xee, 0x07, 0x63, 0x72, 0x1b, 0x72, 0x32, 0x28,
-	0x54, 0xd7, 0xab, 0x52, 0x05, 0x2c, 0x2c, 0x30, 0xfa, 0x69, 0x3e, 0x5c, 0xf9, 0xcf, 0x0c, 0x54,
-	0x70, 0x82, 0x1d, 0x6b, 0x60, 0x33, 0x6d, 0x1b, 0xac, 0x23, 0xa6, 0x09, 0xb5, 0x8d, 0x12, 0x2a,
-	0xe7, 0x91, 0x87, 0xf8, 0xbe, 0x7c, 0xee, 0x07, 0xe7, 0xd6, 0x49, 0x42, 0xa8, 0xc3, 0x38, 0x2a,
-	0x17, 0x20, 0x8f, 0xb9, 0xa4, 0x87, 0x9a, 0xaa, 0xae, 0x2f, 0x85, 0xef, 0xc9, 0xb5, 0xfb, 0xd4,
-	0xf3, 0x98, 0xac, 0xc7, 0x65, 0x3d, 0xf2, 0x08, 0x2a, 0x4c, 0xdb, 0x7c, 0xe6, 0x3c, 0xca, 0xd7,
-	0xa4, 0xfe, 0x99, 0x46, 0xd4, 0xb2, 0x33, 0xc0, 0x11, 0x94, 0xfc, 0x1e, 0xe4, 0x59, 0x14, 0x10,
-	0x4f, 0xa2, 0x11, 0x97, 0x62, 0xa7, 0x50, 0x91, 0xab, 0xfc, 0x63, 0x06, 0x2a, 0x1b, 0xc3, 0xa1,
-	0x4b, 0x87, 0x6c, 0xcc, 0x12, 0x14, 0xfa, 0x0c, 0x83, 0xe0, 0xc9, 0x72, 0x2a, 0xef, 0x30, 0x8d,
-	0x8e, 0xa9, 0x6e, 0xe1, 0x49, 0x32, 0x2a, 0xb6, 0x99, 0x21, 0x7a, 0xbe, 0x61, 0xd0, 0x63, 0xdc,
-	0x75, 0x46, 0x15, 0x3d, 0xf2, 0x08, 0x1a, 0x03, 0x73, 0xe0, 0x1f, 0x6a, 0x0e, 0x75, 0xfb, 0xd4,
-	0xf2, 0x59, 0x7c, 0xcf, 0xa3, 0xc4, 0x3c, 0xd2, 0xf7, 0x43, 0x32, 0x79, 0x0a, 0xd7, 0x2d, 0xd3,
-	0xa2, 0xe8, 0x5e, 0x26, 0x46, 0x14, 0x70, 0xc4, 0x32, 0x67, 0x3f, 0x4f, 0x8e, 0x53, 0xfe, 0x32,
-	0x0b, 0xb5, 0xb8, 0x6e, 0xc8, 0x17, 0x50, 0x37, 0xec, 0x77, 0xd6, 0xc8, 0xd6, 0x0d, 0x8d, 0x21,
-	0x54, 0x71, 0x2f, 0x37, 0xa6, 0x4c, 0x7a, 0x5b, 0xa0, 0x53, 0xb5, 0x26, 0xe5, 0x99, 0x91, 0x93,
-	0x9f, 0x43, 0xcd, 0xe1, 0xf3, 0xf1, 0xe1, 0xd9, 0xb3, 0x86, 0x57, 0x85, 0x38, 0x8e, 0x7e, 0x06,
-	0xd5, 0xc0, 0x89, 0xd6, 0xce, 0x9d, 0x35, 0x18, 0xb8, 0x34, 0x8e, 0xfd, 0x11, 0xcc, 0x85, 0x3b,
-	0xef, 0x9d, 0xf8, 0xd4, 0x43, 0x5d, 0xe5, 0xd4, 0xf0, 0x3c, 0x9b, 0x8c, 0x48, 0xee, 0x43, 0x4d,
-	0x2c, 0xc1, 0x85, 0x0a, 0x28, 0x24, 0x96, 0x45, 0x11, 0xe5, 0xef, 0xb2, 0xb0, 0x1c, 0xde, 0x63,
-	0x42, 0x3b, 0x4f, 0xd3, 0xb5, 0x13, 0xda, 0x7f, 0x38, 0x6a, 0x42, 0x2b, 0x9f, 0xa6, 0x6a, 0x25,
-	0x65, 0x58, 0x42, 0x1b, 0xeb, 0x69, 0xda, 0x48, 0x19, 0x14, 0xd7, 0xc2, 0xcf, 0x52, 0xb5, 0x90,
-	0x3a, 0x6c, 0x42, 0x31, 0x9f, 0xa6, 0x28, 0x26, 0x7d, 0x8f, 0x71, 0x5d, 0x7d, 0x97, 0x81, 0xda,
-	0xd7, 0xb6, 0x7b, 0x44, 0x5d, 0xa6, 0xa1, 0x00, 0xad, 0xea, 0x1d, 0xf6, 0x35, 0xd3, 0x10, 0x80,
-	0xb1, 0xf6, 0xfe, 0xfb, 0xbb, 0x65, 0x2e, 0xb4, 0xb3, 0xad, 0x96, 0x39, 0x7b, 0xc7, 0x60, 0xc0,
-	0xf2, 0xad, 0xdd, 0xd3, 0x42, 0x2f, 0x81, 0xc0, 0x92, 0xf9, 0xcb, 0x6d, 0xb5, 0xf0, 0xd6, 0xee,
-	0xed, 0x18, 0xe4, 0x29, 0xd4, 0xd0, 0x03, 0xa0, 0x91, 0x06, 0xd2, 0xaa, 0x17, 0xa7, 0xec, 0x3f,
-	0xf0, 0xd4, 0xaa, 0x11, 0x75, 0x94, 0xb7, 0x50, 0x8d, 0xf1, 0xc8, 0xa7, 0x50, 0xc2, 0xb0, 0x43,
-	0x0d, 0x71, 0x61, 0xb3, 0x22, 0x94, 0x14, 0x65, 0x3e, 0x1e, 0x8d, 0x9e, 0x47, 0x9d, 0x85, 0x44,
-	0x1c, 0x40, 0xff, 0xc0, 0xad, 0xde, 0x86, 0x9a, 0x4a, 0x3d, 0x3b, 0x70, 0xfb, 0x14, 0x1d, 0x2e,
-	0xcb, 0x78, 0x9c, 0x00, 0x17, 0xca, 0xaa, 0xac, 0xc9, 0xec, 0x7b, 0x4c, 0xc7, 0xb6, 0x2b, 0x93,
-	0x2e, 0xd1, 0x23, 0xf7, 0x21, 0x37, 0x74, 0x02, 0x71, 0xa8, 0x10, 0x36, 0xbd, 0xd8, 0x3f, 0x60,
-	0xf3, 0xa8, 0x8c, 0xc7, 0xdc, 0x85, 0x61, 0x7a, 0x47, 0x32, 0x16, 0xb3, 0xb6, 0xf2, 0x53, 0x28,
-	0x09, 0x99, 0x10, 0x99, 0x65, 0x22, 0x64, 0xc6, 0x56, 0xb3, 0x82, 0x71, 0x8f, 0xba, 0xb8, 0x5a,
-	0x4e, 0x15, 0x3d, 0xe5, 0x57, 0x00, 0x2f, 0xed, 0x5e, 0x87, 0xfa, 0xe8, 0x77, 0x7f, 0xcc, 0x50,
-	0x4f, 0x4f, 0xf3, 0xa8, 0x2f, 0x54, 0x32, 0x17, 0x73, 0xe0, 0x1d, 0xea, 0x33, 0x14, 0xc4, 0xfe,
-	0x92, 0x0f, 0x58, 0xec, 0xed, 0x49, 0x60, 0x3c, 0x1f, 0x93, 0xe2, 0x9e, 0x8f, 0x31, 0x95, 0xbf,
-	0xad, 0x41, 0x49, 0x50, 0xce, 0x0a, 0x0b, 0x8f, 0xa0, 0x21, 0x61, 0xbe, 0x76, 0x4c, 0x5d, 0x8f,
-	0x45, 0xda, 0x2c, 0xc6, 0xa5, 0x79, 0x49, 0x7f, 0xc3, 0xc9, 0xe4, 0x09, 0xd4, 0xed, 0xc0, 0x77,
-	0x02, 0x5f, 0x8b, 0xe1, 0x94, 0xe9, 0x20, 0x59, 0xe3, 0x42, 0xbc, 0x47, 0x9a, 0x50, 0x72, 0x29,
-	0x47, 0x23, 0x79, 0x9c, 0x56, 0x76, 0xd1, 0x41, 0xe8, 0xbe, 0xae, 0x09, 0x13, 0xa3, 0x86, 0xb0,
-	0xfd, 0x3a, 0xa3, 0xee, 0x4b, 0x22, 0x73, 0x10, 0x28, 0xe6, 0x1d, 0x99, 0x8e, 0x43, 0x0d, 0x0c,
-	0xf1, 0x39, 0x7c, 0x5e, 0x7a, 0x87, 0x93, 0x18, 0x32, 0x44, 0x11, 0xdf, 0xf6, 0xf5, 0x11, 0x22,
-	0xc3, 0x9c, 0x5a, 0x61, 0x94, 0x2e, 0x23, 0x30, 0xa8, 0x87, 0xec, 0x81, 0x6e, 0x8e, 0xa8, 0x81,
-	0xe0, 0x30, 0xa7, 0xe2, 0x88, 0xe7, 0x48, 0x09, 0x77, 0xe2, 0xd2, 0x3e, 0x03, 0x51, 0xd4, 0x40,
-	0xa4, 0x28, 0x76, 0xa2, 0x4a, 0x62, 0x14, 0xcc, 0xe0, 0xec, 0x60, 0xf6, 0x40, 0x86, 0xc8, 0x2a,
-	0x86, 0xc8, 0x46, 0xfc, 0x36, 0xe3, 0x01, 0x72, 0x05, 0x8a, 0x2e, 0xd5, 0x3d, 0xdb, 0x12, 0x99,
-	0xa4, 0xe8, 0x31, 0x13, 0xe9, 0xbb, 0x54, 0x67, 0x26, 0x52, 0x3f, 0xdb, 0x44, 0x84, 0x68, 0xdc,
-	0xb0, 0xe6, 0xce, 0x6f, 0x58, 0x4f, 0xa1, 0x3c, 0x30, 0x2d, 0xd3, 0x3b, 0xa4, 0x46, 0x73, 0xfe,
-	0xcc, 0x61, 0xa1, 0x2c, 0xf9, 0x04, 0x4a, 0x06, 0xf5, 0x75, 0x73, 0xe4, 0x35, 0x1b, 0x38, 0xec,
-	0xfa, 0xc4, 0x6b, 0x5c, 0xdd, 0xe6, 0x6c, 0x55, 0xca, 0xb5, 0xfe, 0xa2, 0x04, 0x25, 0x41, 0x24,
-	0x6b, 0x50, 0xf1, 0x65, 0x31, 0x61, 0xd2, 0x71, 0x87, 0x55, 0x06, 0x35, 0x92, 0x21, 0x9b, 0xd0,
-	0x70, 0x22, 0x34, 0xa5, 0x21, 0x28, 0xce, 0x26, 0x17, 0x9e, 0x40, 0x5b, 0xea, 0xbc, 0x33, 0x01,
-	0xbf, 0x1e, 0x40, 0x91, 0x62, 0x6a, 0x1c, 0x3d, 0x5e, 0x3e, 0x92, 0x27, 0xcc, 0xaa, 0xe0, 0xc6,
-	0xd3, 0xa8, 0xfc, 0xec, 0x34, 0x8a, 0x41, 0x26, 0x8f, 0xa5, 0x5e, 0xc2, 0x43, 0x87, 0x90, 0x09,
-	0xf3, 0x31, 0x95, 0xf3, 0xc8, 0x67, 0x50, 0x17, 0x6e, 0x58, 0xb8, 0xce, 0x22, 0xda, 0x6f, 0xf8,
-	0x86, 0xe2, 0x3e, 0x5b, 0xad, 0xbd, 0x8b, 0x7b, 0xf0, 0x0d, 0x58, 0x70, 0x85, 0x43, 0xd3, 0x5c,
-	0xfa, 0x9b, 0x80, 0x7a, 0xbe, 0x87, 0x8f, 0x3c, 0x36, 0x3c, 0xee, 0xf1, 0xd4, 0x86, 0x14, 0x57,
-	0x85, 0x34, 0xf9, 0x1c, 0xe6, 0xc3, 0x29, 0x46, 0xe6, 0xd8, 0xf4, 0x3d, 0xb4, 0x82, 0xd3, 0x26,
-	0x98, 0x93, 0xc2, 0xbb, 0x28, 0x4b, 0x76, 0xe1, 0xba, 0x67, 0x1a, 0xb4, 0xaf, 0xbb, 0xda, 0xe4,
-	0x34, 0x95, 0x19, 0xd3, 0x2c, 0x8b, 0x41, 0x6a, 0x72, 0xb6, 0x0f, 0xa0, 0x60, 0x32, 0x9f, 0x2d,
-	0xcc, 0x68, 0x12, 0xd0, 0x9b, 0x12, 0x9d, 0x7b, 0xfa, 0xc8, 0x97, 0xa5, 0x17, 0xd6, 0x26, 0xcf,
-	0xd0, 0x4c, 0x59, 0xf4, 0xa1, 0x3e, 0xbf, 0xfd, 0x5a, 0x72, 0x75, 0x1e, 0x63, 0xa8, 0x8f, 0xab,
-	0xf3, 0x48, 0x25, 0x7a, 0x88, 0xa3, 0x70, 0x2c, 0x0b, 0xdd, 0xec, 0xb2, 0xea, 0x67, 0xe3, 0x28,
-	0x26, 0xdf, 0xe5, 0xe2, 0x0c, 0x09, 0x31, 0xff, 0x2c, 0x47, 0xcf, 0x9d, 0x89, 0x84, 0xde, 0xda,
-	0x3d, 0x39, 0x96, 0xfb, 0x1f, 0xb6, 0xb6, 0x6b, 0x52, 0x0f, 0x4d, 0x8c, 0xfb, 0x9f, 0x60, 0xdc,
-	0x65, 0x14, 0xf2, 0x25, 0xcc, 0x7b, 0xfd, 0x43, 0x6a, 0x04, 0x23, 0xd3, 0x1a, 0xf2, 0x93, 0x71,
-	0x83, 0x5a, 0x09, 0xdf, 0x52, 0xc8, 0xe6, 0x17, 0xe4, 0x25, 0xfa, 0x2c, 0xf7, 0x75, 0x6c, 0x83,
-	0x8f, 0x5c, 0xe0, 0xb9, 0xaf, 0x63, 0x1b, 0xc8, 0xba, 0x09, 0x15, 0xc6, 0x72, 0x74, 0xbf, 0x7f,
-	0xd8, 0x24, 0x3c, 0x5f, 0x77, 0x6c, 0x63, 0x9f, 0xf5, 0x95, 0x17, 0x50, 0xe4, 0x0f, 0x2f, 0x35,
-	0x1b, 0x7a, 0x94, 0x84, 0xf9, 0x8b, 0xd3, 0x6f, 0x55, 0xba, 0x31, 0xe5, 0x0e, 0x94, 0x65, 0xd9,
-	0x28, 0x6d, 0x2a, 0xe5, 0x9f, 0xe7, 0xa1, 0x26, 0x05, 0x30, 0x2a, 0x5d, 0xac, 0xfe, 0xd4, 0x84,
-	0x52, 0x32, 0x36, 0xc9, 0x2e, 0x59, 0x83, 0x2a, 0x3b, 0xf5, 0xec, 0x88, 0x04, 0x4c, 0x24, 0x8a,
-	0x47, 0x9e, 0x6f, 0x63, 0x24, 0xe1, 0x99, 0x9a, 0xec, 0x92, 0x9f, 0xc8, 0xe3, 0x16, 0xf0, 0xb8,
-	0xcb, 0x93, 0xfb, 0x39, 0xc5, 0x6f, 0x17, 0x13, 0x7e, 0xfb, 0x29, 0xcc, 0x8d, 0x74, 0xcf, 0xd7,
-	0x30, 0x98, 0xe3, 0x6c, 0xe5, 0x53, 0x02, 0x40, 0x8d, 0xc9, 0xc9, 0x1e, 0xb9, 0x07, 0xd5, 0x98,
-	0xab, 0x42, 0xb3, 0xca, 0xab, 0x71, 0x12, 0xf9, 0xa9, 0xc0, 0x16, 0x80, 0xf3, 0xdd, 0x9f, 0xdc,
-	0x1d, 0xfa, 0x5b, 0xd9, 0xe9, 0x9e, 0x38, 0x54, 0xc0, 0x8f, 0xdb, 0x00, 0x7a, 0xe0, 0x1f, 0x6a,
-	0xbe, 0x7d, 0x44, 0x2d, 0x61, 0x4e, 0x15, 0x46, 0xe9, 0x32, 0x02, 0x79, 0x1a, 0xf9, 0x70, 0x6e,
-	0x4c, 0xb7, 0x52, 0x27, 0x9e, 0x72, 0xe4, 0xbf, 0x83, 0x2b, 0x38, 0xf2, 0xb5, 0xb0, 0x82, 0x99,
-	0x4d, 0xba, 0x00, 0xac, 0x62, 0x4e, 0x17, 0x34, 0x53, 0x3d, 0x7f, 0xee, 0xd2, 0x9e, 0x3f, 0x3f,
-	0xd3, 0xf3, 0x7f, 0x06, 0x20, 0xc2, 0xa9, 0xa6, 0x4b, 0x9f, 0x3e, 0x2b, 0x1e, 0x56, 0x84, 0xf4,
-	0x86, 0xcf, 0xa0, 0x8a, 0x4b, 0x59, 0x2a, 0xa7, 0x51, 0xd7, 0xb5, 0x5d, 0xf1, 0x34, 0xaa, 0x9c,
-	0xd6, 0x66, 0x24, 0xf2, 0x13, 0x58, 0xe0, 0xce, 0xdd, 0x93, 0xbe, 0x9c, 0x1a, 0x02, 0xb1, 0x34,
-	0x04, 0x43, 0x95, 0xf4, 0xb8, 0xb0, 0x7e, 0xac, 0x9b, 0x23, 0xbd, 0x37, 0xa2, 0x02, 0xbe, 0x48,
-	0xe1, 0x0d, 0x49, 0x27, 0x1f, 0x84, 0xe8, 0x4c, 0x94, 0xe0, 0x2a, 0xb8, 0xba, 0x40, 0x63, 0x9b,
-	0xbc, 0x10, 0x97, 0x1a, 0x4b, 0xe0, 0xaa, 0xb1, 0xa4, 0xfa, 0xc3, 0xc4, 0x92, 0xda, 0x15, 0x62,
-	0x49, 0x7d, 0x46, 0x2c, 0xb9, 0x07, 0x55, 0x83, 0x7a, 0x7d, 0xd7, 0x74, 0x98, 0x6b, 0x46, 0xdf,
-	0x5d, 0x51, 0xe3, 0xa4, 0x30, 0xda, 0x34, 0x62, 0xd1, 0x26, 0xb2, 0xf0, 0x85, 0x84, 0x85, 0xc7,
-	0x90, 0xc1, 0xe2, 0x79, 0x91, 0xc1, 0xd2, 0x0c, 0x64, 0x30, 0x1d, 0xd5, 0x96, 0x2f, 0x1f, 0xd5,
-	0x56, 0xae, 0x14, 0xd5, 0xae, 0x5f, 0x21, 0xaa, 0x35, 0xcf, 0x13, 0xd5, 0x6e, 0x5c, 0x3a, 0xaa,
-	0xb5, 0x66, 0x44, 0xb5, 0x9b, 0xc9, 0xa8, 0x46, 0x96, 0xa1, 0xe8, 0x3d, 0xd1, 0xd8, 0x81, 0x6e,
-	0xf1, 0x5f, 0x73, 0xbc, 0x27, 0xaf, 0x03, 0x9f, 0x85, 0x9c, 0xb1, 0xf8, 0xf9, 0xa0, 0x79, 0x3b,
-	0x19, 0x72, 0xe4, 0xcf, 0x0a, 0x6a, 0x28, 0xc1, 0x72, 0x02, 0x97, 0xca, 0x22, 0x01, 0x6e, 0xe1,
-	0x0e, 0x2e, 0x53, 0x0f, 0xa9, 0xb8, 0x91, 0x1f, 0xc3, 0x7c, 0x60, 0xf5, 0x47, 0xba, 0x39, 0xa6,
-	0x86, 0xe6, 0xeb, 0xde, 0x91, 0xd7, 0xbc, 0x8b, 0x9a, 0x98, 0x0b, 0xc9, 0x5d, 0x46, 0x65, 0x3b,
-	0x16, 0x00, 0xd0, 0xed, 0x37, 0xef, 0xf1, 0x1d, 0x73, 0x82, 0xda, 0x67, 0x2f, 0x54, 0x0f, 0x7c,
-	0xdb, 0xeb, 0xeb, 0xec, 0xf0, 0xcd, 0xfb, 0xb8, 0xed, 0x38, 0x49, 0xf9, 0x36, 0x8a, 0x9f, 0x58,
-	0x69, 0xbf, 0x01, 0xcb, 0xfb, 0x3b, 0xfb, 0xed, 0xdd, 0x9d, 0xbd, 0xae, 0xd6, 0xfd, 0x66, 0xbf,
-	0xad, 0x1d, 0xec, 0xbd, 0xda, 0x7b, 0xfd, 0xf5, 0x5e, 0xe3, 0x1a, 0xb9, 0x09, 0xd7, 0x05, 0xab,
-	0xcd, 0x59, 0x5d, 0x75, 0x63, 0xaf, 0xf3, 0xfc, 0xb5, 0xfa, 0x55, 0x23, 0x43, 0xae, 0xc3, 0x62,
-	0x92, 0xd9, 0xd9, 0x7f, 0x7d, 0xd0, 0x6d, 0x64, 0x63, 0x13, 0x4a, 0x46, 0x5b, 0x7d, 0xb3, 0xb3,
-	0xd5, 0x6e, 0xe4, 0x5e, 0xe6, 0xcb, 0xa5, 0x46, 0x59, 0x79, 0x09, 0xf5, 0x78, 0x48, 0x60, 0x8e,
-	0xb2, 0x1e, 0x66, 0x8e, 0xa6, 0x35, 0xb0, 0xc5, 0x6f, 0x3d, 0x4b, 0x69, 0x01, 0x44, 0xad, 0x39,
-	0xb1, 0x9e, 0x72, 0x0f, 0x8a, 0x3c, 0xad, 0x15, 0x55, 0xc9, 0xcc, 0x54, 0x55, 0x72, 0x0c, 0x4b,
-	0x3b, 0x16, 0x53, 0xbb, 0x2f, 0xf2, 0x5f, 0xee, 0x7e, 0xce, 0x9f, 0x27, 0x13, 0xc8, 0xbf, 0xd3,
-	0x45, 0x21, 0xb7, 0xac, 0x62, 0x9b, 0xc5, 0x7e, 0x19, 0xec, 0x72, 0x3c, 0xf6, 0x8b, 0xae, 0xf2,
-	0x11, 0x2c, 0xec, 0x9a, 0xde, 0xc4, 0x5a, 0x31, 0xf1, 0x4c, 0x52, 0xfc, 0xd7, 0xb0, 0x10, 0xed,
-	0x4e, 0x8a, 0x9f, 0x91, 0x68, 0x5f, 0x6c, 0x43, 0xff, 0x92, 0x81, 0x39, 0xb1, 0x23, 0x39, 0xff,
-	0xc5, 0x20, 0xd3, 0x27, 0x50, 0x43, 0xef, 0xa7, 0x85, 0x05, 0xed, 0x5c, 0x0a, 0x32, 0xaa, 0xa2,
-	0x4c, 0x04, 0x8d, 0x0e, 0x4d, 0xcf, 0xb7, 0xdd, 0x13, 0x51, 0xaa, 0x93, 0xdd, 0xf8, 0x3e, 0x0b,
-	0x89, 0x7d, 0x92, 0x16, 0x94, 0xdf, 0xfe, 0xe6, 0xb9, 0x39, 0xf2, 0xa9, 0x0c, 0x77, 0x61, 0x5f,
-	0xf9, 0x63, 0x58, 0xec, 0x04, 0x3d, 0xe6, 0x65, 0x7b, 0xf4, 0xd2, 0xe7, 0x88, 0x2d, 0x9d, 0x4d,
-	0xaa, 0xe8, 0x13, 0x68, 0x6c, 0xd3, 0x11, 0xf5, 0xe9, 0xb9, 0xef, 0x40, 0x79, 0x01, 0x73, 0x1d,
-	0xdf, 0x76, 0xce, 0x7f, 0x69, 0x51, 0x10, 0xc8, 0xc5, 0x83, 0x80, 0xf2, 0xbb, 0x2c, 0x2c, 0x1f,
-	0x38, 0x86, 0x8e, 0x8b, 0x73, 0x3c, 0x77, 0xbe, 0x09, 0x1f, 0x24, 0x31, 0xf5, 0x39, 0xea, 0x02,
-	0x89, 0x85, 0xe3, 0xe5, 0x94, 0xc2, 0x59, 0xe5, 0x94, 0xe2, 0x79, 0xca, 0x29, 0xa5, 0xe9, 0x72,
-	0xca, 0x0f, 0x55, 0x2f, 0x49, 0x96, 0x65, 0x60, 0xb2, 0x2c, 0x13, 0x96, 0x53, 0xaa, 0x67, 0x96,
-	0x53, 0x94, 0x7f, 0xcd, 0xc2, 0xdc, 0x0b, 0xea, 0xef, 0xda, 0x43, 0xef, 0x72, 0xcf, 0x48, 0x5c,
-	0x4b, 0xf6, 0x94, 0x6b, 0x91, 0x5a, 0x19, 0xe0, 0xcb, 0xf5, 0xc4, 0x97, 0x10, 0xa8, 0x06, 0xfe,
-	0x98, 0xbd, 0xe8, 0x97, 0x91, 0xfc, 0x8c, 0x5f, 0x46, 0x56, 0xa0, 0x38, 0xd6, 0x3d, 0x66, 0x0c,
-	0xdc, 0x4e, 0x44, 0x8f, 0xd1, 0x07, 0xf6, 0x68, 0x64, 0xbf, 0xc3, 0x4b, 0x29, 0xab, 0xa2, 0x87,
-	0x05, 0x43, 0xdd, 0x94, 0x35, 0x2b, 0x6c, 0x93, 0x87, 0xd0, 0x08, 0x3c, 0xaa, 0x8d, 0xec, 0x23,
-	0x53, 0xeb, 0xe9, 0xfd, 0x23, 0x6a, 0xf1, 0x3b, 0x28, 0xab, 0x73, 0x81, 0x47, 0x77, 0xed, 0x23,
-	0x73, 0x93, 0x53, 0xc9, 0x1a, 0x14, 0x3c, 0xd3, 0xea, 0x53, 0x91, 0x85, 0xcf, 0x08, 0xdc, 0x5c,
-	0x4e, 0xf9, 0xa7, 0x2c, 0xc0, 0xae, 0x3d, 0xfc, 0x8a, 0x7a, 0x9e, 0x3e, 0x44, 0xc8, 0x18, 0x7a,
-	0xf0, 0x58, 0xca, 0x16, 0xfa, 0xea, 0x3d, 0x96, 0x05, 0x9e, 0x5d, 0x15, 0x4e, 0x94, 0x98, 0x73,
-	0x33, 0x4b, 0xcc, 0x0f, 0xa0, 0xcc, 0x41, 0x83, 0xc9, 0xd3, 0xaf, 0xca, 0x66, 0xf5, 0xfd, 0xf7,
-	0x77, 0x4b, 0xfc, 0xf7, 0xa7, 0x6d, 0xb5, 0x84, 0xcc, 0x1d, 0xe3, 0x54, 0x3d, 0xca, 0x1a, 0x70,
-	0x71, 0x66, 0x0d, 0x38, 0xfc, 0x70, 0x83, 0xff, 0x48, 0xcc, 0x3f, 0xdc, 0x78, 0x0c, 0xd9, 0xb0,
-	0xec, 0x31, 0x0b, 0xcf, 0x67, 0x7d, 0x8f, 0x59, 0xd9, 0x98, 0xeb, 0x48, 0xa0, 0x68, 0xd9, 0x55,
-	0xbe, 0x86, 0x45, 0x95, 0x1b, 0x1c, 0xbf, 0xf7, 0xf3, 0x59, 0xfd, 0xe4, 0xf3, 0xca, 0x4e, 0x3d,
-	0x2f, 0xe5, 0x19, 0x2c, 0x8a, 0x90, 0x92, 0x98, 0xf8, 0x3c, 0xbf, 0xc7, 0x29, 0x6f, 0xa0, 0xc1,
-	0x62, 0xc5, 0x45, 0x76, 0x14, 0x02, 0xe7, 0xec, 0xe9, 0xc0, 0x59, 0x31, 0xa0, 0x16, 0x07, 0x9f,
-	0xb1, 0x52, 0x76, 0x26, 0x5e, 0xca, 0x66, 0x86, 0xee, 0x99, 0xdf, 0x52, 0xf1, 0x43, 0x05, 0x2f,
-	0x73, 0x57, 0x18, 0x85, 0xff, 0x92, 0x71, 0x1b, 0xc0, 0xa1, 0xae, 0xc6, 0x1f, 0x01, 0x3e, 0x90,
-	0x9c, 0x5a, 0x71, 0xa8, 0xcb, 0xdf, 0x87, 0xf2, 0xdb, 0x0c, 0xcc, 0x25, 0x91, 0x20, 0xf9, 0x0a,
-	0xea, 0x96, 0x6d, 0x50, 0xcd, 0xa3, 0x23, 0xda, 0xf7, 0x6d, 0x57, 0x40, 0x8b, 0x87, 0xe9, 0xc0,
-	0x71, 0x75, 0xcf, 0x36, 0x68, 0x47, 0x88, 0xf2, 0x4f, 0x42, 0x6a, 0x56, 0x8c, 0x44, 0x56, 0x61,
-	0xd1, 0x71, 0x4d, 0xdb, 0x35, 0xfd, 0x13, 0xad, 0x3f, 0xd2, 0x3d, 0x8f, 0xbf, 0x76, 0x5e, 0xfd,
-	0x5f, 0x90, 0xac, 0x2d, 0xc6, 0x61, 0x4f, 0xbe, 0xf5, 0x25, 0x2c, 0x4c, 0x4d, 0x79, 0xa1, 0xcf,
-	0x41, 0xfe, 0xaf, 0x02, 0xcb, 0x5b, 0x98, 0x16, 0x86, 0xae, 0xe8, 0x52, 0x5e, 0xeb, 0xc2, 0x89,
-	0x72, 0x22, 0x15, 0xcf, 0x5d, 0xb2, 0xa6, 0x9a, 0xbf, 0x74, 0x66, 0x5d, 0x98, 0x99, 0x59, 0xaf,
-	0x40, 0x31, 0xc0, 0x98, 0x29, 0x9d, 0x20, 0xef, 0x4d, 0x67, 0xae, 0xa5, 0x94, 0xcc, 0x35, 0x02,
-	0xf5, 0xe5, 0x38, 0xa8, 0x4f, 0x4d, 0x68, 0x2b, 0x57, 0x4d, 0x68, 0xe1, 0x87, 0x49, 0x68, 0xab,
-	0x57, 0x48, 0x68, 0x6b, 0xe7, 0x4f, 0x68, 0xeb, 0xd3, 0x09, 0xed, 0x2d, 0xfc, 0x4a, 0x87, 0x07,
-	0x52, 0x2c, 0x38, 0x96, 0xd5, 0x88, 0x10, 0x4f, 0x61, 0x17, 0xce, 0x9b, 0xc2, 0x92, 0x0b, 0xa5,
-	0xb0, 0x8b, 0x97, 0x4f, 0x61, 0x97, 0xae, 0x94, 0xc2, 0x2e, 0x5f, 0x24, 0x85, 0x95, 0x69, 0xff,
-	0x4a, 0x2c, 0xed, 0x9f, 0x48, 0x6b, 0xaf, 0x9f, 0x27, 0xad, 0x6d, 0x5e, 0x3a, 0xad, 0xbd, 0x31,
-	0x23, 0xad, 0x6d, 0x4d, 0xa4, 0xb5, 0x13, 0xa5, 0xce, 0x9b, 0x67, 0x96, 0x3a, 0xe3, 0x09, 0xef,
-	0xad, 0x4b, 0x24, 0xbc, 0xb7, 0xd3, 0x12, 0xde, 0x89, 0x54, 0xf5, 0xce, 0x74, 0xaa, 0xfa, 0x6b,
-	0x58, 0x11, 0x91, 0xec, 0x6a, 0xce, 0xef, 0x74, 0xe4, 0xff, 0x5d, 0x06, 0x16, 0x59, 0xc0, 0xbb,
-	0xf2, 0xfc, 0x32, 0xdd, 0xc9, 0x9e, 0x9a, 0xee, 0xe4, 0x4e, 0x4f, 0x77, 0xf2, 0x13, 0xe9, 0xce,
-	0x9f, 0x67, 0x60, 0x99, 0x27, 0x24, 0x57, 0xdb, 0x57, 0x03, 0x72, 0xfa, 0x68, 0x24, 0xce, 0xcc,
-	0x9a, 0x2c, 0xd0, 0x0c, 0x6c, 0xb7, 0x4f, 0xc5, 0x6e, 0x78, 0x87, 0x3d, 0x96, 0x23, 0x4a, 0x1d,
-	0x0d, 0x3f, 0x24, 0xe3, 0xb5, 0xec, 0x32, 0x23, 0xa8, 0xd4, 0xb1, 0x95, 0x6d, 0x58, 0xea, 0x30,
-	0x94, 0x72, 0xa5, 0xad, 0x28, 0x5b, 0xb0, 0xc8, 0xf2, 0xa5, 0xab, 0x4d, 0xf2, 0x57, 0x19, 0x20,
-	0x6a, 0x60, 0x5d, 0x4d, 0x29, 0xab, 0x00, 0x8e, 0x6b, 0x1f, 0x53, 0x4b, 0x67, 0x78, 0x37, 0x3d,
-	0x99, 0x8d, 0x49, 0xc4, 0x50, 0x6b, 0x2e, 0x1d, 0xb5, 0x2a, 0x5f, 0xc0, 0x9c, 0x1a, 0x58, 0x5b,
-	0xae, 0x6d, 0x5d, 0xee, 0x58, 0x8f, 0x60, 0x91, 0x87, 0x78, 0xfe, 0x91, 0xb2, 0x9c, 0x84, 0x40,
-	0x1e, 0x3f, 0xfc, 0xcd, 0xf0, 0x4f, 0xb4, 0x58, 0x5b, 0xf9, 0x1c, 0x16, 0xf9, 0xc3, 0x48, 0x8a,
-	0x3e, 0x80, 0x22, 0xff, 0xf0, 0x79, 0xb2, 0x94, 0x21, 0xc4, 0x04, 0x57, 0xf9, 0x22, 0xac, 0x85,
-	0x5c, 0x6e, 0xfc, 0x2d, 0x28, 0x72, 0x4a, 0xea, 0x4f, 0x33, 0xdf, 0x65, 0x00, 0x38, 0x1b, 0x7f,
-	0x98, 0x39, 0xe7, 0xa4, 0xe1, 0xa7, 0x0e, 0xd9, 0xd8, 0xa7, 0x0e, 0x3b, 0x40, 0xb0, 0x18, 0x6e,
-	0xda, 0x96, 0x16, 0x7e, 0x4e, 0x2f, 0x60, 0xc8, 0x2c, 0xc8, 0xbd, 0x20, 0x47, 0x85, 0x24, 0x65,
-	0x53, 0x7e, 0x38, 0xcf, 0x6b, 0x4d, 0x4f, 0xa0, 0xca, 0xd7, 0x8d, 0x57, 0x9a, 0x48, 0x72, 0x6b,
-	0x58, 0x67, 0x02, 0x2f, 0x6c, 0x2b, 0xcb, 0xb0, 0xb8, 0xd1, 0xf7, 0xcd, 0x63, 0xdd, 0xa7, 0x1b,
-	0x81, 0x7f, 0x28, 0xd4, 0xa6, 0xac, 0xc0, 0x52, 0x92, 0xec, 0x39, 0xb6, 0xe5, 0xd1, 0xc7, 0x7f,
-	0x9f, 0xc1, 0xaf, 0x03, 0xf9, 0xef, 0x31, 0xcb, 0xb0, 0xf0, 0xf2, 0xf5, 0xa6, 0xd6, 0xe9, 0x6e,
-	0x74, 0xe3, 0xb5, 0xb5, 0x79, 0xa8, 0x32, 0xf2, 0x96, 0xda, 0xde, 0xe8, 0xb6, 0xb7, 0x1b, 0x19,
-	0xd2, 0x80, 0x9a, 0x90, 0x53, 0xbb, 0x3b, 0x7b, 0x2f, 0x1a, 0x59, 0x29, 0xa2, 0x1e, 0xec, 0xed,
-	0x31, 0x42, 0x4e, 0x12, 0x9e, 0x6f, 0xec, 0xec, 0x1e, 0xa8, 0xed, 0x46, 0x5e, 0x12, 0x3a, 0x07,
-	0x5b, 0x5b, 0xed, 0x4e, 0xa7, 0x51, 0x20, 0x73, 0x00, 0x8c, 0xf0, 0x6a, 0x67, 0x77, 0xb7, 0xbd,
-	0xdd, 0x28, 0x92, 0x05, 0xa8, 0xb3, 0x7e, 0xfb, 0x85, 0xda, 0xee, 0x74, 0xd8, 0x24, 0x25, 0x49,
-	0x7a, 0xbe, 0xb3, 0xb7, 0xd3, 0xf9, 0x25, 0x23, 0x95, 0x1f, 0xff, 0x11, 0x40, 0xf4, 0xc1, 0x1d,
-	0xa9, 0x42, 0x29, 0xda, 0x26, 0x40, 0x91, 0x2d, 0x87, 0x3b, 0xac, 0x42, 0x49, 0xae, 0x94, 0xc5,
-	0xce, 0xab, 0x9d, 0xfd, 0xfd, 0xf6, 0x76, 0x23, 0x47, 0x6a, 0x50, 0x0e, 0xf7, 0x9d, 0x27, 0x75,
-	0xa8, 0xa8, 0xed, 0xad, 0xd7, 0x6f, 0xda, 0x6a, 0x7b, 0xbb, 0x51, 0x78, 0xfc, 0x0d, 0x54, 0x63,
-	0xbf, 0xf3, 0x91, 0x26, 0x2c, 0x7d, 0xfd, 0x5a, 0x7d, 0xd5, 0x56, 0xd3, 0x54, 0xb2, 0xff, 0x7a,
-	0x3b, 0x3c, 0x6f, 0x46, 0x12, 0xa2, 0x45, 0xe7, 0x00, 0x18, 0x41, 0xec, 0x28, 0xf7, 0xf8, 0xdf,
-	0x33, 0x51, 0x29, 0x91, 0xcf, 0xde, 0x82, 0x95, 0xb0, 0xf8, 0x38, 0x39, 0xff, 0x32, 0x2c, 0xc4,
-	0x79, 0x7c, 0xbb, 0x19, 0xb2, 0x04, 0x8d, 0x90, 0x2c, 0xd7, 0xce, 0x26, 0xca, 0x9b, 0x6a, 0x3b,
-	0x14, 0xcf, 0x25, 0xc4, 0xa3, 0x9b, 0x58, 0x84, 0xf9, 0x90, 0xba, 0xbf, 0x71, 0xd0, 0x61, 0x27,
-	0x4f, 0x88, 0x76, 0xba, 0x1b, 0x7b, 0xdb, 0x9b, 0xdf, 0x34, 0x8a, 0x89, 0x6d, 0x6c, 0xa9, 0x1b,
-	0xfc, 0x12, 0x4a, 0xeb, 0xff, 0x3b, 0x07, 0xb9, 0x8d, 0xfd, 0x1d, 0xf2, 0x0c, 0x20, 0xaa, 0x08,
-	0x92, 0x1b, 0x11, 0x6c, 0x9b, 0xa8, 0x12, 0xb6, 0x26, 0xbf, 0xd8, 0x51, 0xae, 0x91, 0x4d, 0xa8,
-	0x27, 0x6a, 0x9d, 0xe4, 0xd6, 0xf4, 0xf0, 0xa8, 0x2c, 0x99, 0x32, 0xc3, 0xc7, 0x19, 0xf2, 0x14,
-	0x4a, 0xa2, 0x5c, 0x48, 0x42, 0x1c, 0x92, 0xac, 0x1f, 0xa6, 0x8f, 0xfb, 0x12, 0x20, 0x2a, 0x7c,
-	0x46, 0xfb, 0x9e, 0x2a, 0x86, 0xb6, 0x48, 0xb2, 0xce, 0x1a, 0x4e, 0xf0, 0x0b, 0xa8, 0xc5, 0x8b,
-	0x7c, 0xe4, 0x66, 0x68, 0x94, 0xd3, 0xa5, 0xbf, 0xd3, 0xb6, 0x50, 0x09, 0xeb, 0x78, 0xa4, 0x19,
-	0x42, 0xc6, 0x89, 0xd2, 0x5e, 0x6b, 0x65, 0xca, 0x81, 0xb4, 0xc7, 0x8e, 0x7f, 0xa2, 0x5c, 0x23,
-	0x7f, 0x00, 0x25, 0x51, 0xd5, 0x8b, 0xce, 0x9e, 0x2c, 0xf3, 0xcd, 0x18, 0xfc, 0x0b, 0xa8, 0xc5,
-	0xf3, 0xee, 0x68, 0xff, 0x29, 0xd9, 0x78, 0x6b, 0x21, 0x01, 0x68, 0xc5, 0xf5, 0xfd, 0x1c, 0x2a,
-	0x61, 0xf6, 0x1d, 0xed, 0x7f, 0x32, 0x21, 0x4f, 0x1d, 0xfb, 0x71, 0x86, 0xb4, 0xf1, 0x73, 0xb5,
-	0xb0, 0xa0, 0x10, 0xad, 0x9f, 0x52, 0x66, 0x98, 0x71, 0x8c, 0x1d, 0x98, 0x4b, 0x26, 0x9c, 0xe4,
-	0x76, 0xf4, 0x11, 0x74, 0x4a, 0x22, 0x3a, 0x73, 0xaa, 0xf9, 0x09, 0xfc, 0x46, 0xee, 0x4c, 0x28,
-	0x65, 0x72, 0xb2, 0xd4, 0x9a, 0xbf, 0x72, 0x8d, 0x1d, 0x2e, 0x8e, 0xd3, 0xa2, 0xc3, 0xa5, 0xa0,
-	0xb7, 0xd3, 0x26, 0xf9, 0x38, 0xc3, 0x0e, 0x97, 0x04, 0x56, 0xd1, 0xe1, 0x52, 0x01, 0xd7, 0x8c,
-	0xc3, 0xbd, 0x80, 0x7a, 0x02, 0x17, 0x45, 0xb6, 0x96, 0x06, 0x97, 0x66, 0x4c, 0xd4, 0x86, 0x5a,
-	0x1c, 0x1a, 0xc5, 0xde, 0xfd, 0x34, 0x60, 0x9a, 0x31, 0xcd, 0x16, 0x54, 0x63, 0xd8, 0x88, 0x84,
-	0xff, 0x66, 0x35, 0x0d, 0x98, 0x66, 0x1b, 0x80, 0x80, 0x32, 0x91, 0x01, 0x24, 0xb1, 0xcd, 0xec,
-	0x83, 0xc4, 0x71, 0x4c, 0x74, 0x90, 0x14, 0x74, 0x33, 0x7b, 0x9a, 0x38, 0xc6, 0x89, 0xa6, 0x49,
-	0x41, 0x3e, 0x33, 0x8f, 0x82, 0xfe, 0x48, 0x4c, 0x72, 0x8a, 0x5c, 0x6b, 0x71, 0x3a, 0xf2, 0x7b,
-	0xa8, 0xcc, 0x7a, 0x02, 0x28, 0x4d, 0x39, 0xd2, 0xe4, 0x2e, 0x52, 0xf0, 0x83, 0x72, 0x8d, 0x7c,
-	0x2e, 0xdd, 0xd1, 0xc6, 0x68, 0x74, 0xea, 0x06, 0x4e, 0x3f, 0xc0, 0x67, 0x50, 0x12, 0x85, 0xea,
-	0xe8, 0x2e, 0x92, 0x95, 0xeb, 0x68, 0xdd, 0xa8, 0x14, 0x8b, 0xcf, 0xfc, 0x15, 0xd4, 0xe2, 0xc0,
-	0x24, 0x52, 0x61, 0x0a, 0x8a, 0x69, 0xdd, 0x4a, 0x67, 0x72, 0x2c, 0xc3, 0x1d, 0x42, 0xf2, 0x07,
-	0x8a, 0xc8, 0x66, 0x52, 0x7f, 0xb8, 0x98, 0x71, 0xa4, 0x5f, 0xe2, 0x1b, 0xdd, 0xb5, 0x75, 0xa3,
-	0xcb, 0x60, 0x67, 0x4b, 0xc2, 0xee, 0x18, 0x51, 0x4e, 0x72, 0x33, 0x95, 0x17, 0x6e, 0xea, 0x15,
-	0x66, 0x02, 0x92, 0xb1, 0x4d, 0x07, 0x7a, 0x30, 0x3a, 0xfd, 0x96, 0x67, 0x4f, 0xb6, 0xf9, 0xfb,
-	0xff, 0xf6, 0xfe, 0x4e, 0xe6, 0xb7, 0xef, 0xef, 0x64, 0xfe, 0xfb, 0xfd, 0x9d, 0xcc, 0xaf, 0x1e,
-	0x0d, 0x4d, 0xff, 0x30, 0xe8, 0xad, 0xf6, 0xed, 0xf1, 0x9a, 0xa3, 0xf7, 0x0f, 0x4f, 0x0c, 0xea,
-	0xc6, 0x5b, 0xc7, 0xeb, 0x6b, 0x9e, 0xdb, 0x5f, 0x73, 0x1c, 0xaf, 0x57, 0xc4, 0x75, 0x9e, 0xfc,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xb3, 0x11, 0xc3, 0x37, 0x3a, 0x00, 0x00,
+	// 6166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcb, 0x73, 0x1b, 0x49,
+	0x72, 0xf7, 0x00, 0x20, 0xf1, 0x48, 0x00, 0x24, 0x58, 0x24, 0x25, 0x88, 0x7a, 0x51, 0xad, 0x19,
+	0x8d, 0x1e, 0x33, 0xd4, 0x8c, 0x34, 0xa3, 0x9d, 0x99, 0x6f, 0x77, 0x76, 0xf9, 0xd4, 0x50, 0xa2,
+	0x28, 0x6e, 0x83, 0x9a, 0x89, 0xfd, 0xbe, 0xf8, 0xa2, 0xb7, 0x81, 0x2e, 0x90, 0x2d, 0x02, 0xdd,
+	0x3d, 0x5d, 0xdd, 0x94, 0x38, 0x3e, 0xec, 0xe3, 0xb6, 0x76, 0x84, 0x0f, 0x5e, 0x1f, 0x7c, 0x72,
+	0xf8, 0xea, 0x83, 0x23, 0xec, 0x8b, 0xed, 0xf0, 0xc5, 0xe1, 0x9b, 0xed, 0xd3, 0xfe, 0x05, 0x13,
+	0xb6, 0xae, 0x0e, 0xff, 0x03, 0x3e, 0x39, 0x2a, 0xab, 0xaa, 0x1f, 0x40, 0x03, 0x84, 0xc8, 0x8d,
+	0x3d, 0xb1, 0x2b, 0x2b, 0xbb, 0x2a, 0x3b, 0xab, 0x2a, 0x1f, 0xbf, 0x2c, 0x10, 0xea, 0x9e, 0xc7,
+	0xee, 0x7b, 0x1e, 0x5b, 0xf1, 0x7c, 0x37, 0x70, 0x49, 0xd1, 0xf3, 0x98, 0x71, 0xfc, 0x60, 0xe9,
+	0xf2, 0x81, 0xeb, 0x1e, 0xf4, 0xe8, 0x7d, 0xa4, 0xb6, 0xc3, 0xee, 0x7d, 0xda, 0xf7, 0x82, 0x13,
+	0xc1, 0xb4, 0x74, 0x7d, 0xb0, 0x33, 0xb0, 0xfb, 0x94, 0x05, 0x66, 0xdf, 0x93, 0x0c, 0xd7, 0x06,
+	0x19, 0xac, 0xd0, 0x37, 0x03, 0xdb, 0x75, 0x64, 0xff, 0xc2, 0x81, 0x7b, 0xe0, 0xe2, 0xe3, 0x7d,
+	0xfe, 0x24, 0xa9, 0x75, 0xaf, 0xcb, 0xee, 0x7b, 0x5d, 0x29, 0x8a, 0x76, 0x04, 0xd5, 0x16, 0xed,
+	0xf8, 0x34, 0x78, 0xe6, 0x86, 0x4e, 0x40, 0x08, 0x4c, 0x39, 0x66, 0x9f, 0x36, 0x73, 0xcb, 0xb9,
+	0xdb, 0x15, 0x1d, 0x9f, 0x49, 0x03, 0x0a, 0x47, 0xf4, 0xa4, 0x99, 0x47, 0x12, 0x7f, 0x24, 0x57,
+	0x01, 0xfa, 0x9c, 0xdd, 0xf0, 0xcc, 0xe0, 0xb0, 0x59, 0xc0, 0x8e, 0x0a, 0x52, 0xf6, 0xcc, 0xe0,
+	0x90, 0x5c, 0x84, 0x12, 0x75, 0x8e, 0x8d, 0x63, 0xd3, 0x6f, 0x4e, 0x61, 0x5f, 0x91, 0x3a, 0xc7,
+	0x5f, 0x9b, 0xbe, 0xe6, 0xc0, 0xcc, 0xba, 0xeb, 0x74, 0xed, 0x83, 0x67, 0xa6, 0xf7, 0x87, 0x98,
+	0xef, 0x1f, 0xa7, 0xa1, 0xb2, 0xef, 0x9b, 0x0e, 0xeb, 0xba, 0x7e, 0x9f, 0x2c, 0xc0, 0xb4, 0xdd,
+	0x37, 0x0f, 0xd4, 0x64, 0xa2, 0xc1, 0x67, 0xeb, 0xf4, 0xad, 0x66, 0x7e, 0xb9, 0xc0, 0x67, 0xeb,
+	0xf4, 0x2d, 0x1c, 0xce, 0xf7, 0x0d, 0x4e, 0x2d, 0x20, 0xb5, 0x48, 0x7d, 0x7f, 0xbd, 0x6f, 0x91,
+	0x0f, 0xa0, 0x40, 0x9d, 0xe3, 0xe6, 0xd4, 0x72, 0xe1, 0x76, 0xf5, 0xc1, 0xd2, 0x8a, 0x58, 0xc4,
+	0x95, 0x68, 0x82, 0x95, 0x4d, 0xe7, 0x78, 0xd3, 0x09, 0xfc, 0x13, 0x9d, 0xb3, 0x91, 0x0f, 0xa1,
+	0xc4, 0x50, 0xb3, 0xac, 0x39, 0x8d, 0x6f, 0xcc, 0xab, 0x37, 0x12, 0x0a, 0xd7, 0x15, 0x0f, 0xf9,
+	0x00, 0x08, 0x0a, 0x64, 0x78, 0x61, 0xaf, 0x67, 0xa8, 0x37, 0x8b, 0x28, 0x40, 0x03, 0x7b, 0xf6,
+	0xc2, 0x5e, 0xaf, 0x25, 0xb9, 0x17, 0x60, 0x9a, 0x05, 0x96, 0xed, 0x34, 0x4b, 0xc8, 0x20, 0x1a,
+	0xe4, 0x32, 0x54, 0xb8, 0xe4, 0xa2, 0xa7, 0x8c, 0x3d, 0x65, 0xea, 0xfb, 0x2d, 0xec, 0xfc, 0x00,
+	0x88, 0xd9, 0xe9, 0x50, 0x2f, 0x30, 0x7c, 0x1a, 0x84, 0xbe, 0x63, 0x74, 0x5c, 0x8b, 0x36, 0x2b,
+	0xcb, 0x85, 0xdb, 0x05, 0xbd, 0x21, 0x7a, 0x74, 0xec, 0x58, 0x77, 0x2d, 0xca, 0x27, 0xb0, 0x68,
+	0x3b, 0x3c, 0x68, 0xc2, 0x72, 0xee, 0x76, 0x59, 0x17, 0x0d, 0xbe, 0x5c, 0x21, 0xa3, 0x7e, 0xb3,
+	0x2a, 0x96, 0x8b, 0x3f, 0x93, 0xeb, 0x50, 0x7d, 0xe5, 0xfa, 0x47, 0xb6, 0x73, 0x60, 0x58, 0xb6,
+	0xdf, 0xac, 0x61, 0x17, 0x48, 0xd2, 0x86, 0xed, 0x93, 0x6b, 0x00, 0x96, 0xdb, 0x39, 0xa2, 0x7e,
+	0xd7, 0xee, 0xd1, 0x66, 0x5d, 0xf4, 0xc7, 0x14, 0x72, 0x1b, 0x1a, 0x28, 0xb1, 0xd1, 0xf5, 0xdd,
+	0xbe, 0x61, 0x3b, 0x5e, 0x18, 0x34, 0x67, 0x90, 0x6b, 0x06, 0xe9, 0x5b, 0xbe, 0xdb, 0xdf, 0xe6,
+	0x54, 0xf2, 0x03, 0xa8, 0x76, 0x70, 0xff, 0x18, 0x7d, 0xd3, 0x63, 0xcd, 0x59, 0x54, 0xeb, 0x05,
+	0xa5, 0xd6, 0xf4, 0xd6, 0xd2, 0xa1, 0xa3, 0xda, 0x8c, 0xdc, 0x84, 0xba, 0xe7, 0xd3, 0x6e, 0xcf,
+	0x3e, 0x38, 0x0c, 0x70, 0x61, 0x1b, 0xa8, 0x9c, 0x5a, 0x44, 0xe4, 0xcb, 0xfb, 0x3e, 0xcc, 0xc6,
+	0x4c, 0x42, 0x87, 0x73, 0xc8, 0x36, 0x13, 0x91, 0x85, 0x26, 0xef, 0xc2, 0x1c, 0xeb, 0xf8, 0xb6,
+	0x17, 0x24, 0x25, 0x26, 0x28, 0xf1, 0xac, 0xe8, 0x88, 0x44, 0x5e, 0x7a, 0x04, 0x65, 0xb5, 0x2d,
+	0xd4, 0xc6, 0xce, 0xc5, 0x1b, 0x7b, 0x01, 0xa6, 0x8f, 0xcd, 0x5e, 0x48, 0xe5, 0x66, 0x17, 0x8d,
+	0x2f, 0xf2, 0x9f, 0xe5, 0xb4, 0x3b, 0x30, 0xbd, 0xbf, 0xf5, 0xc4, 0x6d, 0x93, 0x65, 0x28, 0x06,
+	0x5d, 0xe3, 0xa5, 0xdb, 0x16, 0xef, 0xad, 0x55, 0xde, 0x7c, 0x7f, 0x5d, 0x74, 0xe9, 0xd3, 0x41,
+	0xf7, 0x89, 0xdb, 0xd6, 0x1e, 0x43, 0x71, 0xf3, 0xc0, 0xa7, 0x8c, 0xf1, 0x09, 0x5e, 0xe8, 0x3b,
+	0x6a, 0x82, 0x17, 0xfa, 0x0e, 0xb9, 0x07, 0x45, 0xb1, 0x95, 0x70, 0x86, 0x11, 0x7b, 0x50, 0xb2,
+	0x68, 0x3f, 0x85, 0x02, 0x9f, 0xf1, 0x03, 0x28, 0x7b, 0xb6, 0x47, 0x7b, 0xb6, 0x23, 0x8e, 0x4a,
+	0xf5, 0x41, 0x43, 0xbd, 0xb5, 0x27, 0xe9, 0x7a, 0xc4, 0x41, 0x2e, 0x40, 0xde, 0xb6, 0x84, 0xfc,
+	0x6b, 0xc5, 0x37, 0xdf, 0x5f, 0xcf, 0x6f, 0x6f, 0xe8, 0x79, 0xdb, 0xfa, 0x62, 0xea, 0x2f, 0xfe,
+	0xea, 0xfa, 0x3b, 0xda, 0x2f, 0xf3, 0x50, 0x7e, 0x46, 0x03, 0xd3, 0x32, 0x03, 0x93, 0xac, 0x43,
+	0xd5, 0x74, 0x1c, 0x37, 0x40, 0x23, 0xc5, 0x9a, 0x39, 0x5c, 0xbe, 0x1b, 0x6a, 0x6c, 0xc5, 0xb6,
+	0xb2, 0x1a, 0xf3, 0x88, 0xe3, 0x94, 0x7c, 0x8b, 0x7c, 0x02, 0xc5, 0x9e, 0xd9, 0xa6, 0x3d, 0x86,
+	0x47, 0xb6, 0xfa, 0xe0, 0xca, 0xd0, 0xfb, 0x3b, 0xd8, 0x2d, 0x5e, 0x95, 0xbc, 0x4b, 0x5f, 0x42,
+	0x63, 0x70, 0xd8, 0xb7, 0x59, 0x8e, 0xa5, 0xcf, 0xa1, 0x9a, 0x18, 0xf6, 0xad, 0x56, 0xf2, 0x17,
+	0x50, 0x6a, 0x51, 0xff, 0xd8, 0xee, 0x50, 0xbe, 0x0d, 0x6d, 0x27, 0xa0, 0xbe, 0x63, 0xf6, 0x0c,
+	0xcf, 0xf5, 0x03, 0x1c, 0x60, 0x5a, 0xaf, 0x29, 0xe2, 0x9e, 0xeb, 0x07, 0x9c, 0x89, 0xbe, 0x4e,
+	0x32, 0xe5, 0x05, 0x93, 0x22, 0x22, 0x13, 0xd7, 0xba, 0x27, 0x2c, 0xa1, 0xd4, 0xfa, 0x9e, 0x9e,
+	0xb7, 0x3d, 0x7e, 0x40, 0x83, 0x13, 0x8f, 0x4a, 0x3b, 0x88, 0xcf, 0xda, 0x37, 0x30, 0xdd, 0xf2,
+	0xdc, 0x30, 0x20, 0x77, 0xb8, 0x45, 0x42, 0x49, 0xe4, 0xba, 0xce, 0xc6, 0xbb, 0x01, 0xc9, 0xba,
+	0xea, 0xe7, 0x42, 0x74, 0xdc, 0x7e, 0xdf, 0x0e, 0x8c, 0xbe, 0xe9, 0x1f, 0x51, 0x5f, 0x7e, 0x56,
+	0x4d, 0x10, 0x9f, 0x21, 0x4d, 0xfb, 0x4d, 0x01, 0xca, 0x7b, 0x5b, 0x2d, 0x71, 0x36, 0xb3, 0x2c,
+	0x39, 0x81, 0x29, 0x9f, 0x7a, 0xae, 0x7c, 0x19, 0x9f, 0xb9, 0x8d, 0xe2, 0x7f, 0x0d, 0x14, 0x53,
+	0x18, 0x83, 0x32, 0x27, 0xec, 0x9f, 0x78, 0x7c, 0x33, 0x15, 0xdb, 0xbe, 0xe9, 0x74, 0x94, 0x91,
+	0x97, 0x2d, 0x4e, 0x17, 0x33, 0x2b, 0x03, 0x2f, 0x5a, 0x7c, 0x82, 0x83, 0x9e, 0xdb, 0x6e, 0x4e,
+	0x8b, 0x09, 0xf8, 0x33, 0x37, 0xdf, 0x2f, 0x5d, 0xdb, 0x31, 0x5c, 0xa7, 0x59, 0x14, 0xcc, 0xbc,
+	0xf9, 0xdc, 0xe1, 0x5e, 0xc4, 0x0d, 0x03, 0xea, 0x1b, 0xbc, 0xdd, 0x2c, 0xa1, 0x5d, 0xab, 0x20,
+	0xe5, 0x89, 0x6b, 0x3b, 0xe4, 0x12, 0x94, 0x0f, 0x7c, 0x37, 0xf4, 0x8c, 0xf6, 0x49, 0xb3, 0x8c,
+	0x2f, 0x96, 0xb0, 0xbd, 0x76, 0xc2, 0xa7, 0xe9, 0x99, 0xdf, 0x9d, 0x34, 0x2b, 0xf8, 0x0e, 0x3e,
+	0x73, 0xb3, 0x87, 0xde, 0xda, 0xe0, 0x36, 0x8c, 0x49, 0x33, 0x09, 0x48, 0xda, 0xe2, 0x14, 0x32,
+	0x03, 0x79, 0xf6, 0x10, 0x2d, 0x65, 0x59, 0xcf, 0xb3, 0x87, 0x5c, 0xfb, 0x81, 0x6f, 0x1f, 0x1c,
+	0x50, 0x61, 0x23, 0x51, 0xfb, 0x5d, 0xe9, 0x41, 0x90, 0xac, 0xab, 0x7e, 0xbe, 0x99, 0xf8, 0xa7,
+	0xb0, 0xe6, 0x8c, 0xb0, 0xee, 0xd8, 0xe0, 0x9a, 0xeb, 0x51, 0xb3, 0xcb, 0xad, 0x2c, 0xb7, 0x7d,
+	0x7c, 0xdc, 0x32, 0x27, 0x6c, 0xd8, 0x3e, 0xd3, 0xfe, 0x29, 0x0f, 0x95, 0x75, 0xdf, 0x75, 0xde,
+	0x6e, 0x31, 0x62, 0xbd, 0x16, 0x06, 0xf5, 0xca, 0x3c, 0xda, 0x51, 0xdb, 0x88, 0x3f, 0x93, 0x2b,
+	0x50, 0x71, 0x8f, 0xa9, 0xff, 0xca, 0xb7, 0x03, 0x8a, 0x0a, 0xe7, 0xda, 0x53, 0x04, 0xf2, 0x11,
+	0x77, 0x48, 0xa6, 0x1f, 0xa0, 0xce, 0xb9, 0x77, 0x14, 0xc1, 0xc9, 0x8a, 0x0a, 0x4e, 0x56, 0xf6,
+	0x55, 0xf4, 0xa2, 0x0b, 0x46, 0x3e, 0x37, 0xf7, 0x9a, 0x66, 0x80, 0x4b, 0x51, 0xd1, 0x65, 0x8b,
+	0xcf, 0xfd, 0x92, 0xb9, 0x0e, 0xae, 0x41, 0x59, 0xc7, 0x67, 0xbe, 0x74, 0x47, 0x94, 0x7a, 0x46,
+	0x60, 0x77, 0x8e, 0x18, 0x2e, 0xc3, 0x94, 0x5e, 0xe1, 0x94, 0x7d, 0x4e, 0x20, 0x5f, 0x42, 0x1d,
+	0xbb, 0x55, 0x00, 0x84, 0xab, 0x51, 0x7d, 0x70, 0x69, 0x48, 0x88, 0x0d, 0xc9, 0xa0, 0xd7, 0x38,
+	0xbf, 0x6a, 0x69, 0xff, 0x93, 0x83, 0x69, 0xa1, 0x38, 0x0d, 0x0a, 0x5e, 0x97, 0x0d, 0x99, 0x3d,
+	0xb9, 0xc9, 0x75, 0xde, 0x49, 0x6e, 0xc0, 0x14, 0xee, 0x20, 0x61, 0x7f, 0xea, 0x8a, 0x49, 0x70,
+	0x60, 0x17, 0xb9, 0x09, 0xd3, 0xb8, 0x77, 0x30, 0x80, 0x18, 0xe2, 0x11, 0x7d, 0x9c, 0xa9, 0xe3,
+	0xbb, 0x8c, 0xc9, 0x80, 0x62, 0x90, 0x09, 0xfb, 0x38, 0x53, 0xe8, 0xf0, 0x4f, 0x9a, 0xce, 0x64,
+	0xc2, 0x3e, 0xf2, 0x1e, 0x4c, 0x75, 0x7c, 0xb9, 0xdf, 0xab, 0x0f, 0xe6, 0x22, 0x87, 0xa8, 0xf6,
+	0x83, 0x8e, 0xdd, 0xc2, 0xa7, 0x5b, 0xa1, 0x27, 0xf7, 0xbe, 0x68, 0x68, 0x0e, 0x94, 0x9f, 0xb8,
+	0xed, 0xd1, 0xfb, 0xe6, 0x56, 0xb4, 0x47, 0x84, 0x0b, 0x99, 0x51, 0xdb, 0x76, 0x1d, 0xa9, 0x43,
+	0x67, 0xb1, 0x90, 0x38, 0x8b, 0xea, 0xe0, 0x4c, 0xc5, 0x07, 0x47, 0x3b, 0x82, 0xd9, 0x3d, 0xd3,
+	0x37, 0x7b, 0x3d, 0xda, 0xb3, 0x59, 0xbf, 0xc5, 0xb7, 0xd6, 0x12, 0x94, 0x3b, 0xae, 0xc3, 0x02,
+	0xd3, 0x11, 0x26, 0x71, 0x4a, 0x8f, 0xda, 0xdc, 0xd9, 0x5a, 0x66, 0x10, 0xf6, 0x99, 0xe1, 0x51,
+	0xdf, 0xe0, 0x61, 0x85, 0xb4, 0x46, 0x05, 0x7d, 0x56, 0x74, 0xec, 0x51, 0xff, 0x1b, 0x24, 0x73,
+	0xb3, 0xdc, 0x37, 0x5f, 0xa3, 0x04, 0x53, 0x3a, 0x7f, 0xd4, 0x1e, 0x42, 0x05, 0xbf, 0x8c, 0x1f,
+	0x49, 0x2e, 0x0d, 0x06, 0x90, 0xf2, 0xeb, 0xf8, 0x33, 0xa7, 0x1d, 0x9a, 0xec, 0x10, 0x47, 0xac,
+	0xe9, 0xf8, 0xac, 0x7d, 0x09, 0xd3, 0x1b, 0x7c, 0x64, 0x72, 0x15, 0x0a, 0xca, 0xf1, 0x56, 0x1f,
+	0x54, 0x95, 0x5a, 0xb9, 0xeb, 0xe5, 0xf4, 0x51, 0xae, 0x4f, 0xfb, 0x75, 0x1e, 0x2a, 0x38, 0xc0,
+	0xb6, 0xd3, 0x75, 0xf9, 0x0a, 0xa2, 0x9c, 0x72, 0x98, 0x68, 0x05, 0x91, 0x43, 0x17, 0x7d, 0xe4,
+	0x36, 0x1e, 0x9f, 0x40, 0xb8, 0x8f, 0x99, 0x07, 0x24, 0xc5, 0xd4, 0xe2, 0x3d, 0xba, 0x60, 0x20,
+	0x77, 0x05, 0x27, 0xc3, 0xaf, 0xac, 0x3e, 0x58, 0x88, 0xf6, 0xa8, 0xef, 0x76, 0x28, 0x63, 0x9c,
+	0x97, 0x09, 0x5e, 0x46, 0xee, 0x40, 0x85, 0xaf, 0x95, 0x18, 0x79, 0x0a, 0xf9, 0x6b, 0x6a, 0xf5,
+	0xb8, 0x46, 0xf4, 0xb2, 0xd7, 0xc5, 0x37, 0x28, 0x79, 0x17, 0xa6, 0xb8, 0xf3, 0x94, 0xdb, 0xac,
+	0x91, 0xe4, 0xe2, 0x5f, 0xa1, 0x63, 0x2f, 0x1f, 0x50, 0xac, 0x80, 0x61, 0x5b, 0xc2, 0xba, 0xae,
+	0xd5, 0xde, 0x7c, 0x7f, 0xbd, 0x2c, 0xf4, 0xbf, 0xbd, 0xa1, 0x97, 0x45, 0xf7, 0xb6, 0xa5, 0xfd,
+	0x32, 0x07, 0xf5, 0x2d, 0xd3, 0xee, 0x85, 0x3e, 0xd5, 0x29, 0xf7, 0x63, 0xa7, 0x6b, 0xb3, 0xe8,
+	0x53, 0x93, 0x9f, 0x7c, 0x61, 0xa1, 0x64, 0x8b, 0x7c, 0x06, 0xf5, 0xae, 0x69, 0xf7, 0xa8, 0x65,
+	0x88, 0xe5, 0x96, 0x67, 0x2a, 0x8a, 0x64, 0xb6, 0xb0, 0x53, 0x68, 0xb3, 0xd6, 0x8d, 0x1b, 0x4c,
+	0xfb, 0xcb, 0x1c, 0x54, 0x13, 0xbd, 0x93, 0xad, 0xc4, 0x28, 0x31, 0x94, 0x82, 0x0a, 0x63, 0x15,
+	0xc4, 0x37, 0xbc, 0x7b, 0x20, 0x8e, 0x74, 0x45, 0xc7, 0x67, 0xd2, 0x84, 0x92, 0x4f, 0x03, 0xdf,
+	0xa6, 0x0c, 0xcd, 0x66, 0x41, 0x57, 0x4d, 0xed, 0x6f, 0x73, 0x50, 0x59, 0x3d, 0x38, 0xf0, 0xe9,
+	0x01, 0x5f, 0x82, 0x05, 0x98, 0xee, 0xf0, 0x78, 0x0c, 0xc5, 0x2b, 0xe8, 0xa2, 0xc1, 0x47, 0xec,
+	0x53, 0x53, 0x48, 0x93, 0xd3, 0xf1, 0x99, 0xcb, 0xc8, 0x02, 0xcb, 0xa2, 0xc7, 0xb8, 0x09, 0x72,
+	0xba, 0x6c, 0x91, 0x3b, 0xd0, 0xe8, 0xda, 0xdd, 0xe0, 0x90, 0x1f, 0x95, 0x0e, 0x75, 0x02, 0x1e,
+	0x6f, 0x4f, 0x21, 0xc7, 0x2c, 0xd2, 0xf7, 0x22, 0x32, 0x79, 0x04, 0x17, 0x1d, 0xdb, 0xa1, 0xe8,
+	0xbf, 0x06, 0xde, 0x98, 0xc6, 0x37, 0x16, 0x45, 0xf7, 0x56, 0xfa, 0x3d, 0xed, 0xcf, 0xf2, 0x50,
+	0x4b, 0x6e, 0x35, 0x6e, 0x7b, 0x2d, 0xf7, 0x95, 0xd3, 0x73, 0x4d, 0xcb, 0xe0, 0x19, 0xaa, 0x54,
+	0xee, 0x38, 0xdb, 0xab, 0xf8, 0xb9, 0x4b, 0x20, 0x3f, 0x84, 0x9a, 0x27, 0xc6, 0x13, 0xaf, 0xe7,
+	0x4f, 0x7b, 0xbd, 0x2a, 0xd9, 0xf1, 0xed, 0x2f, 0xa0, 0x1a, 0x7a, 0xf1, 0xdc, 0x85, 0xd3, 0x5e,
+	0x06, 0xc1, 0x8d, 0xef, 0xbe, 0x07, 0x33, 0x91, 0xe4, 0xed, 0x93, 0x80, 0x32, 0xd4, 0x55, 0x41,
+	0x8f, 0xbe, 0x67, 0x8d, 0x13, 0xc9, 0x0d, 0xa8, 0xc9, 0x29, 0x04, 0x93, 0x58, 0x43, 0x39, 0x2d,
+	0xb2, 0x68, 0x7f, 0x9d, 0x87, 0xc5, 0x68, 0x1d, 0x53, 0xda, 0x79, 0x94, 0xad, 0x9d, 0xc8, 0x44,
+	0x47, 0x6f, 0x0d, 0x68, 0xe5, 0x93, 0x4c, 0xad, 0x64, 0xbc, 0x96, 0xd2, 0xc6, 0x83, 0x2c, 0x6d,
+	0x64, 0xbc, 0x94, 0xd4, 0xc2, 0x67, 0x99, 0x5a, 0xc8, 0x7c, 0x6d, 0x40, 0x31, 0x9f, 0x64, 0x28,
+	0x26, 0x5b, 0xc6, 0xa4, 0xae, 0xbe, 0xcf, 0x41, 0x4d, 0x98, 0x0b, 0xae, 0xa1, 0x90, 0xa5, 0x6d,
+	0x4a, 0x6e, 0x9c, 0x4d, 0xe1, 0xb9, 0xd0, 0x4b, 0xb7, 0x6d, 0x44, 0x46, 0x17, 0x73, 0x21, 0xee,
+	0xbc, 0x36, 0xf4, 0xe9, 0x97, 0x6e, 0x7b, 0xdb, 0x22, 0x8f, 0xa0, 0x86, 0xc7, 0x18, 0x6d, 0x5e,
+	0xa8, 0x8c, 0xe4, 0xfc, 0x90, 0x39, 0x0d, 0x99, 0x5e, 0xb5, 0xe2, 0x06, 0x0f, 0x30, 0xbe, 0x0d,
+	0x69, 0x48, 0x0d, 0x66, 0x7f, 0x47, 0xe5, 0x3e, 0xa8, 0x20, 0xa5, 0x65, 0x7f, 0x47, 0x07, 0x9c,
+	0x10, 0xa3, 0x1d, 0xd7, 0xb1, 0xe4, 0x39, 0x89, 0x9d, 0x50, 0x0b, 0xc9, 0xda, 0x4b, 0xa8, 0x26,
+	0xa6, 0x21, 0x9f, 0x40, 0x09, 0xe3, 0x1d, 0x6a, 0xc9, 0xb5, 0x1f, 0x17, 0x1a, 0x29, 0x56, 0xee,
+	0xd1, 0xd1, 0xda, 0x88, 0x18, 0x63, 0x2e, 0xe5, 0xf5, 0xd1, 0x72, 0x63, 0xb7, 0xe6, 0x42, 0x4d,
+	0xa7, 0xcc, 0x0d, 0xfd, 0x0e, 0x45, 0x47, 0xda, 0x80, 0x42, 0xc7, 0x0b, 0x71, 0xa2, 0xbc, 0xce,
+	0x1f, 0xb9, 0xa9, 0xe8, 0xd3, 0xbe, 0xeb, 0x2b, 0x3c, 0x45, 0xb6, 0xc8, 0x0d, 0x28, 0x1c, 0x78,
+	0xa1, 0xd4, 0x4f, 0x94, 0x07, 0x3c, 0xde, 0x7b, 0xc1, 0xc7, 0xd1, 0x79, 0x1f, 0xb7, 0x3c, 0x96,
+	0xcd, 0x8e, 0x54, 0x10, 0xc8, 0x9f, 0xb5, 0x4f, 0xa1, 0x24, 0x79, 0xa2, 0x54, 0x23, 0x17, 0xa7,
+	0x1a, 0x7c, 0x36, 0x27, 0xec, 0xb7, 0x23, 0x0f, 0x2d, 0x5b, 0xda, 0x0b, 0x20, 0xa8, 0x93, 0x67,
+	0x38, 0x79, 0xab, 0x63, 0xf6, 0x6c, 0x07, 0xd1, 0x84, 0xb6, 0xc9, 0xa2, 0x11, 0xf8, 0x33, 0x8f,
+	0xc2, 0xb9, 0x8a, 0xf9, 0x8e, 0x92, 0x26, 0xaf, 0xe4, 0x51, 0x9f, 0x6f, 0x9d, 0xa4, 0x77, 0xaf,
+	0x08, 0xef, 0xfe, 0x0a, 0x2a, 0x5f, 0x51, 0xd3, 0x0f, 0xda, 0xd4, 0x0c, 0xc8, 0xa7, 0x50, 0xc6,
+	0x3c, 0xea, 0xd8, 0xec, 0x9d, 0x6e, 0x83, 0x22, 0x56, 0xf2, 0x10, 0x4a, 0xfc, 0xb0, 0xb8, 0x61,
+	0x70, 0xba, 0xe9, 0x51, 0x9c, 0xda, 0xdf, 0xe5, 0xa0, 0xb6, 0xee, 0x9b, 0xec, 0x70, 0xcd, 0xec,
+	0x1c, 0xb9, 0xdd, 0x2e, 0x1f, 0xc5, 0x76, 0xec, 0xc0, 0x9e, 0x64, 0x6e, 0xc5, 0x49, 0xee, 0x89,
+	0x0f, 0x3a, 0x75, 0x5a, 0xce, 0x45, 0xae, 0x01, 0xf4, 0xc3, 0x5e, 0x60, 0x7b, 0x3d, 0x9b, 0xfa,
+	0xd2, 0xee, 0x27, 0x28, 0x3c, 0x1f, 0xe9, 0x9b, 0xaf, 0x0d, 0xe5, 0x69, 0xc4, 0x16, 0x86, 0xbe,
+	0xf9, 0x5a, 0x97, 0xce, 0xe6, 0xe7, 0x30, 0xff, 0xcc, 0xe4, 0x9f, 0xed, 0x98, 0x4e, 0x87, 0xb6,
+	0x3a, 0x87, 0xd4, 0x0a, 0x45, 0x50, 0x84, 0xb1, 0xa3, 0x5c, 0x04, 0x0c, 0x14, 0x3f, 0x85, 0x72,
+	0x14, 0x4a, 0x9f, 0x2a, 0x5d, 0xc4, 0xaa, 0x31, 0x98, 0x4b, 0xcc, 0xf0, 0x8d, 0xed, 0x58, 0xee,
+	0xab, 0x38, 0x31, 0xc8, 0x4d, 0x9a, 0x18, 0x20, 0xcc, 0x66, 0xc9, 0x89, 0xc7, 0xf1, 0x73, 0x36,
+	0xed, 0xd7, 0x39, 0x80, 0x27, 0x6e, 0xbb, 0x45, 0x03, 0x8c, 0xb6, 0xde, 0xe7, 0xd9, 0x5f, 0xdb,
+	0x60, 0x54, 0x4d, 0x38, 0x93, 0x08, 0x34, 0x5a, 0x34, 0xe0, 0xd9, 0x20, 0xff, 0x4b, 0x6e, 0xf2,
+	0x28, 0xbe, 0xad, 0x50, 0x84, 0xd9, 0x04, 0x97, 0x70, 0xe7, 0xbc, 0x93, 0xdc, 0x52, 0x61, 0x59,
+	0x01, 0xc3, 0xb2, 0x46, 0x72, 0xac, 0x44, 0x50, 0xa6, 0xfd, 0x7b, 0x1d, 0x4a, 0xf2, 0xcd, 0xd3,
+	0xc2, 0x9c, 0x3b, 0xd0, 0x50, 0xd8, 0x89, 0x71, 0x4c, 0x7d, 0xa6, 0x74, 0x3c, 0xa5, 0xcf, 0x2a,
+	0xfa, 0xd7, 0x82, 0x4c, 0x1e, 0x42, 0xdd, 0x0d, 0x03, 0x2f, 0x0c, 0x8c, 0x44, 0x92, 0x36, 0x1c,
+	0x80, 0xd7, 0x04, 0x93, 0x68, 0x89, 0x68, 0x43, 0x68, 0x7c, 0x0a, 0x87, 0x55, 0x4d, 0xf4, 0x77,
+	0x66, 0x60, 0x1a, 0xd2, 0x63, 0x50, 0x4b, 0xba, 0xb2, 0x3a, 0xa7, 0xee, 0x29, 0x22, 0xf7, 0x77,
+	0xc8, 0xc6, 0x8e, 0x6c, 0xcf, 0xa3, 0x22, 0xcc, 0x2b, 0xa0, 0xb5, 0x34, 0x5b, 0x82, 0xc4, 0xad,
+	0x25, 0xb2, 0x04, 0x6e, 0x60, 0xf6, 0x30, 0x9b, 0x28, 0xe8, 0x15, 0x4e, 0xd9, 0xe7, 0x04, 0xbe,
+	0x15, 0xb1, 0x5b, 0x04, 0x63, 0x98, 0xc8, 0x15, 0x74, 0x7c, 0x43, 0x44, 0x63, 0x91, 0x24, 0x3e,
+	0xed, 0xf0, 0x0c, 0x92, 0x5a, 0x98, 0xd2, 0x49, 0x49, 0x74, 0x45, 0x8c, 0x43, 0x5d, 0x38, 0x3d,
+	0xd4, 0x8d, 0x56, 0xaa, 0x3a, 0x76, 0xa5, 0x12, 0xe1, 0x5d, 0x2d, 0x15, 0xde, 0x7d, 0x02, 0xa5,
+	0x8e, 0x4f, 0x4d, 0x6e, 0xa6, 0xeb, 0xa7, 0x9b, 0x69, 0xc9, 0x9a, 0x34, 0xee, 0x33, 0x93, 0x1b,
+	0xf7, 0x47, 0x50, 0xee, 0xda, 0x8e, 0xcd, 0x0e, 0xa9, 0x85, 0x79, 0xfc, 0xf8, 0xd7, 0x22, 0x5e,
+	0xf2, 0x31, 0x94, 0x2c, 0x1a, 0x98, 0x76, 0x8f, 0x35, 0x1b, 0xf8, 0xda, 0xc5, 0x81, 0x5d, 0xbb,
+	0xb2, 0x21, 0xba, 0x75, 0xc5, 0xc7, 0x93, 0x76, 0x9f, 0xca, 0x05, 0x6f, 0xce, 0x89, 0xa4, 0x3d,
+	0x22, 0x44, 0x4b, 0xed, 0x51, 0xc7, 0xb2, 0x9d, 0x03, 0xc4, 0x30, 0xe5, 0x52, 0xef, 0x09, 0xd2,
+	0x70, 0xf4, 0x3d, 0x3f, 0x61, 0xf4, 0xbd, 0xf4, 0xf7, 0x25, 0x28, 0x49, 0x79, 0xc8, 0x7d, 0xa8,
+	0x04, 0x0a, 0x26, 0x1f, 0x0c, 0x81, 0x22, 0xfc, 0x5c, 0x8f, 0x79, 0xc8, 0x1a, 0x34, 0xbc, 0x38,
+	0x49, 0x34, 0x10, 0x8c, 0xc8, 0xa7, 0xbf, 0x79, 0x20, 0x89, 0xd4, 0x67, 0xbd, 0x81, 0xac, 0xf2,
+	0x16, 0x14, 0x29, 0xe2, 0xa2, 0xf1, 0xb9, 0x11, 0x6f, 0x0a, 0xb4, 0x54, 0x97, 0xbd, 0x49, 0x58,
+	0x6c, 0xea, 0x54, 0x58, 0x6c, 0x9a, 0x79, 0xdc, 0x55, 0x4c, 0xa7, 0x33, 0x08, 0xc4, 0xd7, 0x74,
+	0xd1, 0x47, 0x3e, 0x87, 0xba, 0x0c, 0x68, 0x64, 0x10, 0x52, 0x44, 0x95, 0x45, 0xdb, 0x37, 0x19,
+	0xfd, 0xe8, 0xb5, 0x57, 0xc9, 0x58, 0x68, 0x15, 0xe6, 0x7c, 0xe9, 0xcf, 0x0d, 0x9f, 0x7e, 0x1b,
+	0x52, 0x16, 0x30, 0x3c, 0x5f, 0x89, 0xd7, 0x93, 0x0e, 0x5f, 0x6f, 0x28, 0x76, 0x5d, 0x72, 0x93,
+	0x1f, 0xc1, 0x6c, 0x34, 0x44, 0xcf, 0xee, 0xdb, 0x01, 0xc3, 0x03, 0x38, 0x6a, 0x80, 0x19, 0xc5,
+	0xbc, 0x83, 0xbc, 0x64, 0x07, 0x2e, 0x32, 0xdb, 0xa2, 0x1d, 0xd3, 0x37, 0x06, 0x87, 0xa9, 0x8c,
+	0x19, 0x66, 0x51, 0xbe, 0xa4, 0xa7, 0x47, 0xbb, 0x09, 0xd3, 0x02, 0x1d, 0x87, 0xb4, 0xbe, 0x24,
+	0x7a, 0x61, 0x2b, 0xd0, 0x81, 0x99, 0xbd, 0x40, 0x15, 0x15, 0xf8, 0x33, 0xf9, 0x02, 0x2d, 0x04,
+	0x8f, 0xe3, 0x68, 0x20, 0x56, 0xbf, 0x96, 0x9e, 0x5d, 0x84, 0x58, 0x34, 0xc0, 0xd9, 0x45, 0xcc,
+	0x27, 0x5b, 0x98, 0x91, 0xe0, 0xbb, 0xca, 0xaf, 0xd7, 0x4f, 0xcf, 0x48, 0x38, 0xff, 0xbe, 0x60,
+	0xe7, 0x39, 0x05, 0x77, 0x21, 0xea, 0xed, 0x99, 0x53, 0x73, 0x8a, 0x97, 0x6e, 0x5b, 0xbd, 0x2b,
+	0x4c, 0x1f, 0x9f, 0x1b, 0xbd, 0xf0, 0x6c, 0x64, 0xfa, 0xc2, 0xfe, 0x3e, 0xa7, 0x90, 0x1f, 0xc3,
+	0x2c, 0x13, 0xae, 0xd7, 0x76, 0x0e, 0xc4, 0x97, 0x89, 0xb3, 0x1c, 0x95, 0x31, 0x5a, 0x51, 0xb7,
+	0x58, 0x20, 0x96, 0x6a, 0x63, 0x80, 0xe4, 0x5a, 0xe2, 0xcd, 0x39, 0x01, 0x53, 0x7a, 0xae, 0x85,
+	0x5d, 0x97, 0xa1, 0xc2, 0xbb, 0x3c, 0x33, 0xe8, 0x1c, 0xca, 0x7a, 0x04, 0xe7, 0xdd, 0xe3, 0x6d,
+	0x72, 0x1b, 0x1a, 0x42, 0x32, 0xc4, 0x2b, 0x69, 0xc0, 0xa3, 0xe8, 0x79, 0x51, 0x65, 0x41, 0xfa,
+	0x96, 0x20, 0x6f, 0x5b, 0xda, 0x63, 0x28, 0x4a, 0x3c, 0x25, 0x0b, 0x0e, 0xba, 0x93, 0x46, 0x2a,
+	0xe6, 0x87, 0x77, 0x75, 0xe4, 0x15, 0xaf, 0x41, 0x59, 0x15, 0x0c, 0xb2, 0x86, 0xd2, 0x7e, 0x73,
+	0x01, 0x6a, 0x8a, 0x01, 0x5d, 0xe7, 0xdb, 0x55, 0x1e, 0x9a, 0x50, 0x4a, 0x3b, 0x50, 0xd5, 0x24,
+	0xf7, 0xa1, 0xca, 0xf5, 0x33, 0xde, 0x6d, 0x02, 0x67, 0x89, 0x9d, 0x26, 0x0b, 0x5c, 0x74, 0x77,
+	0x02, 0xaa, 0x52, 0x4d, 0x72, 0x4f, 0x7d, 0xee, 0x34, 0x7e, 0xee, 0xe2, 0xa0, 0x3c, 0x23, 0x9c,
+	0x4b, 0x31, 0xe5, 0x5c, 0x1e, 0xc1, 0x4c, 0xcf, 0x64, 0x81, 0x81, 0x91, 0x09, 0x8e, 0x56, 0x1e,
+	0xe1, 0xa5, 0x6a, 0x9c, 0x4f, 0xb5, 0xc8, 0x32, 0x54, 0x13, 0x46, 0x4d, 0xe2, 0x9e, 0x49, 0x12,
+	0xf9, 0x54, 0x06, 0xe1, 0x80, 0xe3, 0xdd, 0x18, 0x94, 0x0e, 0x9d, 0x82, 0x6a, 0xec, 0x9f, 0x78,
+	0x54, 0xc6, 0xe9, 0x57, 0x01, 0xcc, 0x30, 0x38, 0x34, 0x02, 0xf7, 0x88, 0x3a, 0xf2, 0xe0, 0x55,
+	0x38, 0x65, 0x9f, 0x13, 0xc8, 0xa3, 0xd8, 0xd1, 0x88, 0x63, 0x77, 0x25, 0x73, 0xe0, 0x21, 0x6f,
+	0xf3, 0x10, 0xaa, 0x3e, 0xed, 0x50, 0x07, 0xbf, 0x94, 0x35, 0xeb, 0x68, 0xf7, 0x48, 0xf2, 0x23,
+	0xc3, 0x7e, 0xdf, 0xf4, 0x4f, 0x74, 0x10, 0x6c, 0x4f, 0xdc, 0x36, 0x5b, 0xfa, 0xd5, 0xdc, 0x39,
+	0xfc, 0xc4, 0xfd, 0xa8, 0x3a, 0x96, 0x4f, 0x5b, 0x18, 0xac, 0x90, 0x0d, 0x17, 0xcb, 0x32, 0x1d,
+	0x4b, 0xe1, 0xcc, 0x8e, 0x65, 0x6a, 0xac, 0x63, 0xf9, 0x1c, 0x40, 0x06, 0x0a, 0x86, 0xa9, 0x5c,
+	0xc6, 0x38, 0x4f, 0x5f, 0x91, 0xdc, 0xab, 0x01, 0xf7, 0xcc, 0x52, 0x93, 0xd4, 0xf7, 0x5d, 0x5f,
+	0xee, 0x27, 0xa9, 0xdd, 0x4d, 0x4e, 0x22, 0xf7, 0x60, 0x4e, 0xf8, 0x0e, 0xa6, 0x5c, 0x05, 0xb5,
+	0x64, 0x2c, 0xd6, 0x90, 0x1d, 0xba, 0xa2, 0x27, 0x99, 0xcd, 0x63, 0xd3, 0xee, 0x99, 0xed, 0x1e,
+	0x95, 0x81, 0x99, 0x62, 0x5e, 0x55, 0x74, 0x72, 0x33, 0x8a, 0x3b, 0x65, 0x31, 0xa6, 0x22, 0x8a,
+	0x3f, 0x82, 0xb8, 0x26, 0x4a, 0x32, 0x99, 0xae, 0x0a, 0xce, 0xeb, 0xaa, 0xaa, 0xbf, 0x1f, 0x57,
+	0x55, 0x3b, 0x87, 0xab, 0xaa, 0x8f, 0x71, 0x55, 0xcb, 0x50, 0xb5, 0xa8, 0x28, 0xf1, 0x72, 0xb3,
+	0x23, 0xaa, 0xd4, 0x49, 0x52, 0xe4, 0xcc, 0x1a, 0x09, 0x67, 0x16, 0x9b, 0x85, 0xb9, 0x94, 0x59,
+	0x48, 0x04, 0x1e, 0xf3, 0x93, 0x06, 0x1e, 0x0b, 0x63, 0x02, 0x8f, 0x61, 0xa7, 0xb9, 0x78, 0x76,
+	0xa7, 0x79, 0xe1, 0x5c, 0x4e, 0xf3, 0xe2, 0x39, 0x9c, 0x66, 0x73, 0x12, 0xa7, 0x79, 0xe9, 0xcc,
+	0x4e, 0x73, 0x69, 0x8c, 0xd3, 0xbc, 0x3c, 0xe0, 0x34, 0x17, 0xa1, 0xc8, 0x1e, 0x1a, 0xfc, 0x83,
+	0xae, 0x88, 0x92, 0x09, 0x7b, 0xf8, 0x3c, 0xe4, 0x19, 0x6a, 0xb9, 0x2f, 0xab, 0xcd, 0xcd, 0xab,
+	0x69, 0x3f, 0xa5, 0xaa, 0xd0, 0x7a, 0xc4, 0xc1, 0xb3, 0x9d, 0x28, 0xe4, 0x16, 0x22, 0x5c, 0xc3,
+	0x69, 0xea, 0x11, 0x15, 0x05, 0x79, 0x1f, 0x66, 0x43, 0xa7, 0xd3, 0x33, 0xed, 0x3e, 0xb5, 0x8c,
+	0xc0, 0x64, 0x47, 0xac, 0x79, 0x1d, 0x35, 0x31, 0x13, 0x91, 0xf7, 0x39, 0x95, 0x4b, 0x2c, 0xe3,
+	0x4b, 0xbf, 0xd3, 0x5c, 0x16, 0x12, 0x0b, 0x82, 0xde, 0xe1, 0x3b, 0xd4, 0x0c, 0x03, 0x97, 0x09,
+	0x88, 0xa5, 0x79, 0x03, 0xc5, 0x4e, 0x92, 0xf8, 0xe9, 0xc6, 0xc2, 0x8f, 0x61, 0x1e, 0x98, 0xb6,
+	0xc3, 0x82, 0xa6, 0x26, 0x4e, 0x37, 0x12, 0x57, 0x05, 0x8d, 0xcb, 0xdc, 0x15, 0xe0, 0xbd, 0xe1,
+	0x23, 0x7a, 0xdf, 0xbc, 0x89, 0x23, 0xd5, 0xbb, 0x29, 0x48, 0xff, 0x32, 0x54, 0x1c, 0xd7, 0xa2,
+	0x86, 0xe7, 0xba, 0xbd, 0xe6, 0xbb, 0x42, 0x14, 0x4e, 0xd8, 0x73, 0xdd, 0x9e, 0xf0, 0x5e, 0x8c,
+	0x05, 0x87, 0xbe, 0x1b, 0x1e, 0x1c, 0x36, 0xdf, 0x13, 0xa2, 0x24, 0x48, 0xf2, 0xc6, 0xc5, 0xb1,
+	0xed, 0x86, 0xcc, 0x10, 0xc6, 0xa5, 0x79, 0x4b, 0x84, 0x24, 0x8a, 0xfc, 0x1c, 0xa9, 0x64, 0x19,
+	0x6a, 0xec, 0xd0, 0xf4, 0x2d, 0xa3, 0x7d, 0x62, 0x1c, 0xd1, 0x93, 0xe6, 0xfb, 0xa2, 0xda, 0x8a,
+	0xb4, 0xb5, 0x93, 0xa7, 0xf4, 0x84, 0xec, 0xc0, 0x82, 0xd8, 0x43, 0x02, 0xdf, 0x32, 0x94, 0x02,
+	0x6e, 0x4b, 0xab, 0x9b, 0x3c, 0x01, 0x29, 0x14, 0x4a, 0x27, 0xd6, 0x30, 0x32, 0x75, 0x07, 0x1a,
+	0xdf, 0x86, 0xa6, 0x6f, 0x3a, 0x01, 0x4f, 0xd3, 0xcd, 0x6e, 0x40, 0xfd, 0xe6, 0x1d, 0x51, 0x73,
+	0x8a, 0xe9, 0xab, 0x9c, 0xcc, 0x5d, 0xd6, 0xa1, 0xc2, 0xa0, 0x9a, 0x77, 0xd3, 0x2e, 0x2b, 0x02,
+	0xa7, 0xf4, 0x98, 0x87, 0xdc, 0x85, 0x39, 0x7e, 0x52, 0x0e, 0x6d, 0x16, 0x70, 0x41, 0xd1, 0x62,
+	0x35, 0xef, 0x89, 0xc1, 0x5f, 0xba, 0xed, 0xaf, 0x04, 0x1d, 0xad, 0x12, 0x4f, 0x25, 0x3a, 0xbe,
+	0xc9, 0x0e, 0x8d, 0xb6, 0xc0, 0x99, 0x9a, 0x1f, 0xa4, 0x0f, 0x74, 0x12, 0x83, 0xd2, 0x6b, 0x9d,
+	0x24, 0x22, 0x75, 0x0f, 0x48, 0xdf, 0x7c, 0x6d, 0xd8, 0x8e, 0x21, 0x6f, 0xb4, 0xa0, 0x4b, 0xfe,
+	0x50, 0xcc, 0xd3, 0x37, 0x5f, 0x6f, 0x3b, 0x5b, 0x48, 0xe7, 0x3e, 0x98, 0xbc, 0x0b, 0x33, 0xbc,
+	0x3b, 0xe6, 0x6e, 0xae, 0x20, 0x63, 0x8d, 0x53, 0x15, 0x27, 0xb9, 0x08, 0x25, 0xc7, 0x35, 0xf8,
+	0xbe, 0x6e, 0xde, 0xc7, 0x05, 0x28, 0x3a, 0x2e, 0xdf, 0xef, 0x64, 0x17, 0x16, 0xfa, 0x31, 0xf0,
+	0x63, 0xc8, 0xc3, 0x47, 0x9b, 0x1f, 0xa1, 0xb4, 0x97, 0xa3, 0xb3, 0x31, 0x0c, 0x3f, 0xe9, 0xf3,
+	0xfd, 0x0c, 0x4c, 0xea, 0x2b, 0x2e, 0x7b, 0x3c, 0xde, 0x2b, 0x44, 0x92, 0x9a, 0x1f, 0x4b, 0x9b,
+	0x32, 0x3c, 0x9a, 0x80, 0x9a, 0xf4, 0xb9, 0xfe, 0x10, 0xfa, 0x94, 0x3a, 0x7b, 0x58, 0x9e, 0x7c,
+	0x30, 0x70, 0xf6, 0x1e, 0xf7, 0xdc, 0xb6, 0xf6, 0x5d, 0x1c, 0x88, 0xe2, 0x3d, 0x84, 0x4b, 0xb0,
+	0xb8, 0xb7, 0xbd, 0xb7, 0xb9, 0xb3, 0xbd, 0xbb, 0x6f, 0xec, 0xff, 0x6c, 0x6f, 0xd3, 0x78, 0xb1,
+	0xfb, 0x74, 0xf7, 0xf9, 0x37, 0xbb, 0x8d, 0x77, 0xc8, 0x65, 0xb8, 0x28, 0xbb, 0x36, 0x45, 0xd7,
+	0xbe, 0xbe, 0xba, 0xdb, 0xda, 0x7a, 0xae, 0x3f, 0x6b, 0xe4, 0xc8, 0x45, 0x98, 0x4f, 0x77, 0xb6,
+	0xf6, 0x9e, 0xbf, 0xd8, 0x6f, 0xe4, 0x13, 0x03, 0xaa, 0x8e, 0x4d, 0xfd, 0xeb, 0xed, 0xf5, 0xcd,
+	0x46, 0xe1, 0xc9, 0x54, 0xb9, 0xd4, 0x28, 0x6b, 0x7f, 0x22, 0x61, 0x2c, 0x11, 0x20, 0x9d, 0x06,
+	0x22, 0xdd, 0x4a, 0x07, 0xe1, 0x23, 0xd1, 0x8e, 0x24, 0xd2, 0x50, 0x98, 0x1c, 0x69, 0xd0, 0x9e,
+	0x40, 0x3d, 0x19, 0xe9, 0xf1, 0x50, 0xa6, 0x1e, 0xa1, 0x56, 0xb6, 0xd3, 0x75, 0xe5, 0xe5, 0x9d,
+	0x85, 0xac, 0xb8, 0x50, 0xaf, 0x79, 0x89, 0x96, 0xb6, 0x0c, 0x45, 0x01, 0xbd, 0xc9, 0x7a, 0x69,
+	0x6e, 0xa8, 0x5e, 0xda, 0x87, 0x85, 0x6d, 0x87, 0x1b, 0xc6, 0x40, 0x62, 0x74, 0x22, 0x40, 0x98,
+	0x1c, 0xcb, 0x23, 0x30, 0xf5, 0xca, 0x94, 0x05, 0xea, 0xb2, 0x8e, 0xcf, 0x3c, 0xa4, 0x57, 0x31,
+	0x6c, 0x41, 0x84, 0xf4, 0xb2, 0xa9, 0x7d, 0x08, 0x73, 0x3b, 0x36, 0x1b, 0x98, 0x2b, 0xc1, 0x9e,
+	0x4b, 0xb3, 0xff, 0x1c, 0xe6, 0x62, 0xe9, 0x14, 0xfb, 0x29, 0xeb, 0xf3, 0x76, 0x02, 0xfd, 0x4b,
+	0x0e, 0x66, 0xa4, 0x44, 0x6a, 0xfc, 0xb7, 0xcb, 0x84, 0x3e, 0x86, 0x1a, 0xc6, 0x27, 0x46, 0x54,
+	0xa8, 0x2f, 0x64, 0x24, 0x3c, 0x55, 0xe4, 0x89, 0x33, 0x1e, 0x69, 0x81, 0x24, 0x54, 0xac, 0x9a,
+	0x49, 0x39, 0xa7, 0x53, 0x72, 0x92, 0x25, 0x28, 0xbf, 0xfc, 0x76, 0xcb, 0xee, 0x71, 0x6b, 0x28,
+	0x02, 0xd2, 0xa8, 0xad, 0xfd, 0x02, 0xe6, 0x5b, 0x61, 0x9b, 0xc7, 0x41, 0x6d, 0x7a, 0xe6, 0xef,
+	0x48, 0x4c, 0x9d, 0x4f, 0x4f, 0xbd, 0x0c, 0x55, 0x0c, 0xfa, 0x6d, 0x71, 0x75, 0x4c, 0x28, 0x30,
+	0x49, 0xd2, 0x3e, 0x86, 0xc6, 0x06, 0xed, 0xd1, 0x80, 0x4e, 0xbc, 0x4a, 0xda, 0x63, 0x98, 0x69,
+	0x05, 0xae, 0x37, 0xf9, 0xb2, 0xc6, 0x81, 0x5c, 0x21, 0x19, 0xc8, 0x69, 0xff, 0x9d, 0x87, 0xc5,
+	0x17, 0x9e, 0x65, 0xe2, 0xe4, 0xe2, 0x00, 0x4e, 0x36, 0xe0, 0xa4, 0xe7, 0x78, 0xc4, 0xc4, 0x49,
+	0xb0, 0x77, 0xfa, 0x34, 0xb0, 0xb7, 0x38, 0x09, 0xd8, 0x5b, 0x1a, 0x06, 0x7b, 0x7f, 0x5f, 0x68,
+	0x6e, 0x1a, 0x34, 0x86, 0x41, 0xd0, 0x38, 0x02, 0x7b, 0xab, 0xa7, 0x82, 0xbd, 0xda, 0x7f, 0xe6,
+	0x61, 0xe6, 0x31, 0x0d, 0x76, 0xdc, 0x03, 0x76, 0xb6, 0x8d, 0x26, 0x97, 0x25, 0x3f, 0x62, 0x59,
+	0x94, 0x56, 0xba, 0xb8, 0xb7, 0x99, 0xbc, 0x06, 0x8c, 0x6a, 0x10, 0xdb, 0x9d, 0xc5, 0x77, 0x09,
+	0xa6, 0xc6, 0xdf, 0x25, 0xe8, 0x9b, 0x8c, 0x1f, 0x17, 0x71, 0x92, 0x64, 0x4b, 0x5c, 0x7d, 0xea,
+	0xf5, 0xdc, 0x57, 0xb8, 0x28, 0x65, 0x5d, 0xb6, 0xb0, 0xa4, 0x66, 0xda, 0x0a, 0x51, 0xc7, 0x67,
+	0x72, 0x1b, 0x1a, 0x21, 0xa3, 0x46, 0xcf, 0x3d, 0xb2, 0x31, 0x0a, 0xa0, 0x8e, 0x25, 0xaf, 0x46,
+	0xcd, 0x84, 0x8c, 0xee, 0xb8, 0x47, 0xf6, 0x9a, 0xa0, 0x92, 0xfb, 0x30, 0xcd, 0x6c, 0xa7, 0x43,
+	0x25, 0x50, 0x37, 0x26, 0xf8, 0x16, 0x7c, 0x3c, 0x7a, 0x0b, 0x19, 0xf5, 0x0d, 0xd7, 0xe9, 0x9d,
+	0xc8, 0x0b, 0x6c, 0x65, 0x4e, 0x78, 0xee, 0xf4, 0x4e, 0xb4, 0x7f, 0xce, 0x03, 0xec, 0xb8, 0x07,
+	0xcf, 0x28, 0x63, 0xe6, 0x01, 0xe6, 0x84, 0x91, 0x03, 0x48, 0x00, 0x39, 0x91, 0xa9, 0xdf, 0x35,
+	0xfb, 0x74, 0x82, 0xfa, 0x6c, 0xaa, 0xd8, 0x5b, 0x18, 0x5b, 0xec, 0xbd, 0x05, 0x65, 0x11, 0xd1,
+	0xd9, 0x02, 0x94, 0xa9, 0xac, 0x55, 0xdf, 0x7c, 0x7f, 0xbd, 0x24, 0x2e, 0xd6, 0x6c, 0xe8, 0x25,
+	0xec, 0xdc, 0xb6, 0x46, 0x2a, 0x59, 0x95, 0x50, 0x8b, 0x63, 0x4b, 0xa8, 0xd1, 0x95, 0x66, 0x71,
+	0x27, 0x4a, 0x5c, 0x69, 0xbe, 0x0b, 0xf9, 0x08, 0x36, 0x1d, 0xe7, 0x30, 0xf3, 0x01, 0xde, 0xee,
+	0xe8, 0x0b, 0x1d, 0xc9, 0x34, 0x59, 0x35, 0xb5, 0x6f, 0x60, 0x5e, 0x17, 0xa7, 0x51, 0x6c, 0x8a,
+	0xc9, 0x4c, 0xc2, 0xe0, 0xde, 0xcb, 0x0f, 0xed, 0x3d, 0xed, 0x0b, 0x98, 0x97, 0x1e, 0x29, 0x35,
+	0xf0, 0x24, 0xd7, 0x5b, 0xb4, 0x5f, 0xe6, 0xa0, 0xc1, 0x7d, 0xcd, 0xdb, 0x88, 0x14, 0xa5, 0xc6,
+	0xf9, 0x31, 0xa9, 0x71, 0x16, 0xbe, 0x58, 0xc8, 0xc4, 0x17, 0x6d, 0x58, 0x78, 0x4c, 0x85, 0x00,
+	0xeb, 0x78, 0xff, 0xf8, 0x4c, 0x47, 0x78, 0x12, 0xa1, 0xb4, 0x0f, 0x61, 0x71, 0x60, 0x2a, 0xe6,
+	0xb9, 0x0e, 0x1b, 0x71, 0xd7, 0x46, 0xd3, 0x60, 0x59, 0x2a, 0x76, 0xd3, 0x09, 0xa8, 0xef, 0xf9,
+	0x36, 0xa3, 0x5b, 0xd4, 0x0c, 0x42, 0x9f, 0x2a, 0x43, 0xa3, 0xfd, 0x1c, 0x6e, 0x8c, 0xe1, 0x91,
+	0xc3, 0x5f, 0x03, 0xa0, 0x51, 0xaf, 0x0c, 0x28, 0x12, 0x14, 0xbc, 0xca, 0xc9, 0x0f, 0x34, 0xde,
+	0x15, 0xca, 0xcb, 0xab, 0x9c, 0xee, 0x91, 0xcd, 0x2d, 0x9a, 0x76, 0x15, 0x2e, 0xcb, 0x19, 0xd6,
+	0x7b, 0x21, 0xdf, 0xca, 0x02, 0xa1, 0x50, 0x02, 0xfc, 0x7f, 0xa8, 0xa7, 0xe8, 0xfc, 0x68, 0xf2,
+	0x48, 0x5f, 0x69, 0x86, 0xc9, 0x6f, 0xaa, 0xf5, 0xcd, 0xd7, 0x4a, 0x6f, 0x8c, 0xa7, 0x5a, 0xc8,
+	0x94, 0x80, 0x13, 0x45, 0x89, 0x7e, 0x86, 0xb3, 0xc5, 0x54, 0xcd, 0x82, 0x5a, 0x12, 0x26, 0x48,
+	0x94, 0xf4, 0x73, 0xc9, 0x92, 0x3e, 0x37, 0xe7, 0xcc, 0xfe, 0x8e, 0xca, 0xbb, 0x1f, 0x62, 0xac,
+	0x0a, 0xa7, 0x88, 0xcb, 0x21, 0x57, 0x01, 0x12, 0xf7, 0xf5, 0x0a, 0xa2, 0xdb, 0x53, 0x37, 0xf5,
+	0xb4, 0xdf, 0xe5, 0x60, 0x26, 0x9d, 0xb3, 0x93, 0x67, 0x50, 0xc7, 0x5c, 0x92, 0xd1, 0x1e, 0xed,
+	0x04, 0xae, 0x2f, 0x43, 0xcc, 0xdb, 0xd9, 0x29, 0xfe, 0xca, 0xae, 0x6b, 0xd1, 0x96, 0x64, 0x15,
+	0x77, 0xbd, 0x6b, 0x4e, 0x82, 0x44, 0x56, 0x60, 0xde, 0xf3, 0x6d, 0xd7, 0xb7, 0x83, 0x13, 0xa3,
+	0xd3, 0x33, 0x19, 0x13, 0x66, 0x4b, 0xdc, 0x82, 0x98, 0x53, 0x5d, 0xeb, 0xbc, 0x87, 0xdb, 0xae,
+	0xa5, 0x1f, 0xc3, 0xdc, 0xd0, 0x90, 0x6f, 0x75, 0xcf, 0xfb, 0x1f, 0x66, 0x61, 0x71, 0x1d, 0x01,
+	0xbc, 0x68, 0xb7, 0x9e, 0x69, 0x63, 0xbf, 0x35, 0xa4, 0x99, 0x02, 0x4d, 0x0b, 0x67, 0x2c, 0xae,
+	0x4d, 0x9d, 0x19, 0x03, 0x9d, 0x1e, 0x8b, 0x81, 0x5e, 0x80, 0x62, 0x88, 0x91, 0x91, 0x72, 0x75,
+	0xa2, 0x35, 0x8c, 0x31, 0x96, 0x32, 0x30, 0xc6, 0x18, 0x7e, 0x29, 0x27, 0xe1, 0x97, 0x4c, 0xe8,
+	0xb1, 0x72, 0x5e, 0xe8, 0x11, 0x7e, 0x3f, 0xd0, 0x63, 0xf5, 0x1c, 0xd0, 0x63, 0x6d, 0x72, 0xe8,
+	0xb1, 0x3e, 0x0c, 0x3d, 0xa6, 0x6a, 0xbd, 0xb3, 0x83, 0xb5, 0xde, 0x04, 0xd8, 0x38, 0x37, 0x29,
+	0xd8, 0x48, 0xde, 0x0a, 0x6c, 0x9c, 0x3f, 0x3b, 0xd8, 0xb8, 0x70, 0x2e, 0xb0, 0x71, 0xf1, 0x6d,
+	0xc0, 0x46, 0x05, 0xd0, 0x5e, 0x48, 0x00, 0xb4, 0x03, 0x00, 0xe4, 0xc5, 0x49, 0x00, 0xc8, 0xe6,
+	0x99, 0x01, 0xc8, 0x4b, 0x63, 0x00, 0xc8, 0xa5, 0x01, 0x00, 0x72, 0xa0, 0x92, 0x75, 0xf9, 0xd4,
+	0x4a, 0x56, 0x12, 0x9a, 0xbc, 0x72, 0x06, 0x68, 0xf2, 0x6a, 0x16, 0x34, 0x39, 0x00, 0x2a, 0x5e,
+	0x9b, 0x00, 0x54, 0xbc, 0x3e, 0x11, 0xa8, 0xb8, 0x7c, 0x2a, 0xa8, 0x78, 0x63, 0x3c, 0xa8, 0xa8,
+	0x4d, 0x04, 0x2a, 0xde, 0x9c, 0x08, 0x54, 0x7c, 0x77, 0x62, 0x50, 0xf1, 0xbd, 0x33, 0x81, 0x8a,
+	0x17, 0xa1, 0x64, 0xf9, 0x27, 0x86, 0x1f, 0x3a, 0x88, 0x72, 0x96, 0xf5, 0xa2, 0xe5, 0x9f, 0xe8,
+	0xa1, 0x93, 0x89, 0x36, 0xbe, 0x3f, 0x01, 0xda, 0x78, 0xfb, 0xac, 0x68, 0xe3, 0x9d, 0x09, 0xd1,
+	0xc6, 0xbb, 0xe7, 0x44, 0x1b, 0xef, 0x65, 0xa3, 0x8d, 0x09, 0x1c, 0xf1, 0x83, 0x89, 0x70, 0xc4,
+	0x0f, 0xcf, 0x88, 0x23, 0x0e, 0xa3, 0x7f, 0x2b, 0x59, 0xe8, 0xdf, 0xaf, 0x72, 0x70, 0x41, 0x46,
+	0x5c, 0xe7, 0x73, 0xdd, 0xa3, 0xf1, 0x8b, 0xeb, 0xe9, 0xca, 0xa8, 0x88, 0x87, 0x12, 0x55, 0x50,
+	0xed, 0xb7, 0x39, 0x98, 0xe7, 0x71, 0xf9, 0xb9, 0x05, 0x50, 0xa8, 0x4e, 0x7e, 0x24, 0xaa, 0x53,
+	0x18, 0x8d, 0xea, 0x4c, 0x0d, 0xa0, 0x3a, 0x7f, 0x9c, 0x83, 0x45, 0x81, 0xaa, 0x9c, 0x4f, 0xae,
+	0x06, 0x14, 0xcc, 0x5e, 0x4f, 0x2a, 0x85, 0x3f, 0xf2, 0x38, 0xaa, 0xeb, 0xfa, 0x1d, 0x2a, 0xa5,
+	0x11, 0x0d, 0x7e, 0xf4, 0xf1, 0x87, 0x3c, 0xf8, 0x43, 0x25, 0x51, 0x89, 0x2f, 0x73, 0x02, 0xb7,
+	0x0c, 0xda, 0x1f, 0xc1, 0x85, 0xb4, 0x2c, 0x51, 0xf2, 0xbf, 0x02, 0x95, 0x64, 0xf4, 0x5b, 0xc8,
+	0x94, 0x26, 0x66, 0x89, 0x27, 0xcf, 0x8f, 0x9c, 0xbc, 0x30, 0x30, 0x79, 0x00, 0x0b, 0x2d, 0x9e,
+	0xca, 0x9d, 0x4f, 0x0f, 0x29, 0x41, 0xf3, 0xa7, 0x0a, 0xaa, 0x31, 0x98, 0x6f, 0x05, 0xae, 0xf7,
+	0x87, 0x9d, 0xf4, 0xcf, 0x73, 0x40, 0xf4, 0xd0, 0x39, 0xef, 0xa4, 0xe0, 0xf9, 0xee, 0xb1, 0x38,
+	0x90, 0x23, 0x00, 0xc9, 0x04, 0x47, 0x02, 0x3a, 0x28, 0x64, 0x43, 0x07, 0xda, 0x97, 0x30, 0xa3,
+	0x87, 0xce, 0xba, 0xef, 0x3a, 0x67, 0x92, 0x48, 0xfb, 0xd3, 0x1c, 0x34, 0x75, 0x75, 0xee, 0xcf,
+	0xf7, 0x71, 0xc3, 0x6e, 0x33, 0x9f, 0xe5, 0x36, 0x65, 0x5a, 0x5d, 0x18, 0x01, 0x3f, 0x7a, 0x5c,
+	0x9e, 0x1e, 0x35, 0x19, 0xfd, 0x69, 0x64, 0xe5, 0xcf, 0x26, 0x4f, 0x12, 0x2a, 0xc9, 0x8f, 0x86,
+	0x4a, 0xb4, 0x67, 0x70, 0x55, 0xda, 0x39, 0x91, 0x86, 0xc5, 0x1e, 0xe3, 0x4c, 0x1a, 0x3d, 0x86,
+	0xd9, 0x81, 0x71, 0xde, 0xe6, 0x32, 0xff, 0x67, 0x50, 0x89, 0xfe, 0xa3, 0xc1, 0x04, 0x97, 0x7d,
+	0x63, 0x66, 0xed, 0x29, 0x34, 0x06, 0xe6, 0x65, 0xe4, 0x07, 0x00, 0x91, 0xd3, 0x53, 0x36, 0xe0,
+	0x62, 0xfa, 0x6e, 0x52, 0xfc, 0xb5, 0x09, 0x56, 0xed, 0x0e, 0xcc, 0x8b, 0xac, 0x4d, 0xfc, 0x22,
+	0x5a, 0x69, 0x82, 0xc0, 0x14, 0xfe, 0x5c, 0x3d, 0x27, 0x7e, 0x17, 0xc6, 0x9f, 0xb5, 0x1f, 0xc1,
+	0xbc, 0x30, 0x40, 0x69, 0xd6, 0x5b, 0xd1, 0x6f, 0xac, 0x07, 0xaa, 0x14, 0x92, 0x4d, 0xfd, 0xbc,
+	0xfa, 0xcb, 0xa8, 0xcc, 0x71, 0xb6, 0xf7, 0xaf, 0x40, 0x51, 0x50, 0x32, 0x2f, 0x53, 0xfd, 0x36,
+	0x07, 0x20, 0xba, 0xf1, 0x2a, 0xd5, 0x84, 0x83, 0x46, 0xb7, 0xf8, 0xf3, 0x89, 0x5b, 0xfc, 0xdb,
+	0x40, 0xf0, 0x26, 0x8a, 0xed, 0x3a, 0x46, 0xbc, 0x44, 0xa7, 0xd7, 0x8f, 0xe6, 0xd4, 0x5b, 0x11,
+	0x49, 0x5b, 0x53, 0xff, 0x5e, 0x42, 0x94, 0x91, 0x1e, 0x42, 0x55, 0xcc, 0x9b, 0x2c, 0x22, 0x91,
+	0xb4, 0x68, 0x58, 0x42, 0x02, 0x16, 0x3d, 0x6b, 0xaf, 0x60, 0x46, 0x6d, 0xbe, 0xb5, 0xd0, 0xb1,
+	0x7a, 0x94, 0x7c, 0x2c, 0x7f, 0x9e, 0x2a, 0x3e, 0xed, 0x6a, 0x1c, 0x9f, 0x64, 0x64, 0xdf, 0xf2,
+	0xd7, 0xab, 0xa3, 0x2f, 0x8b, 0x35, 0xe3, 0xff, 0xd3, 0x20, 0x70, 0x5e, 0xd5, 0xd4, 0x16, 0x61,
+	0x7e, 0xb5, 0x13, 0xd8, 0xc7, 0x66, 0x40, 0x57, 0xc3, 0xe0, 0x50, 0x01, 0x30, 0x17, 0x60, 0x21,
+	0x4d, 0x16, 0xa0, 0xcf, 0xdd, 0xbf, 0xc9, 0xe1, 0x2f, 0x29, 0xc5, 0xd5, 0xad, 0x45, 0x98, 0x7b,
+	0xf2, 0x7c, 0xcd, 0x68, 0xed, 0xaf, 0xee, 0x27, 0xab, 0x87, 0xb3, 0x50, 0xe5, 0xe4, 0x75, 0x7d,
+	0x73, 0x75, 0x7f, 0x73, 0xa3, 0x91, 0x23, 0x0d, 0xa8, 0x49, 0x3e, 0x7d, 0x7f, 0x7b, 0xf7, 0x71,
+	0x23, 0xaf, 0x58, 0xf4, 0x17, 0xbb, 0xbb, 0x9c, 0x50, 0x50, 0x84, 0xad, 0xd5, 0xed, 0x9d, 0x17,
+	0xfa, 0x66, 0x63, 0x4a, 0x11, 0x5a, 0x2f, 0xd6, 0xd7, 0x37, 0x5b, 0xad, 0xc6, 0x34, 0x99, 0x01,
+	0xe0, 0x84, 0xa7, 0xdb, 0x3b, 0x3b, 0x9b, 0x1b, 0x8d, 0x22, 0x99, 0x83, 0x3a, 0x6f, 0x6f, 0x3e,
+	0xd6, 0x37, 0x5b, 0x2d, 0x3e, 0x48, 0x49, 0x91, 0xb6, 0xb6, 0x77, 0xb7, 0x5b, 0x5f, 0x71, 0x52,
+	0xf9, 0x6e, 0x1f, 0x20, 0xfe, 0x79, 0x21, 0xa9, 0x42, 0x29, 0x16, 0x13, 0xa0, 0xc8, 0xa7, 0x43,
+	0x09, 0xab, 0x50, 0x52, 0x33, 0xe5, 0xb1, 0xf1, 0x74, 0x7b, 0x6f, 0x6f, 0x73, 0xa3, 0x51, 0x20,
+	0x35, 0x28, 0x47, 0x72, 0x4f, 0x91, 0x3a, 0x54, 0xf4, 0xcd, 0xf5, 0xe7, 0x5f, 0x6f, 0xea, 0x9b,
+	0x1b, 0x8d, 0x69, 0x2e, 0xe4, 0x4f, 0x5f, 0xac, 0xea, 0xab, 0xbb, 0xfb, 0xdb, 0xbb, 0x5c, 0xa8,
+	0xbb, 0x3f, 0x83, 0x6a, 0xe2, 0x8e, 0x20, 0x69, 0xc2, 0xc2, 0x37, 0xcf, 0xf5, 0xa7, 0x9b, 0x7a,
+	0x96, 0x8e, 0xf6, 0x9e, 0x6f, 0x44, 0x0a, 0xc8, 0x29, 0x42, 0x2c, 0xc5, 0x0c, 0x00, 0x27, 0x48,
+	0x11, 0x0b, 0x77, 0xff, 0x2d, 0x17, 0xd7, 0x2b, 0xc5, 0xe8, 0x4b, 0x70, 0x21, 0xaa, 0xb7, 0x0e,
+	0x8e, 0xbf, 0x08, 0x73, 0xc9, 0x3e, 0x21, 0x7f, 0x8e, 0x2c, 0x40, 0x23, 0x22, 0xab, 0xb9, 0xf3,
+	0xa9, 0x8a, 0xae, 0xbe, 0x19, 0xb1, 0x17, 0x52, 0xec, 0xf1, 0xd2, 0xcc, 0xc3, 0x6c, 0x44, 0xdd,
+	0x5b, 0x7d, 0xd1, 0x42, 0x55, 0x24, 0x59, 0x5b, 0xfb, 0xab, 0xbb, 0x1b, 0x6b, 0x3f, 0x6b, 0x14,
+	0x53, 0x62, 0xac, 0xeb, 0xab, 0x62, 0x55, 0x4a, 0x0f, 0xfe, 0x6b, 0x01, 0x0a, 0xab, 0x7b, 0xdb,
+	0xe4, 0x0b, 0x80, 0xb8, 0xec, 0x48, 0x2e, 0xc5, 0x98, 0xc0, 0x40, 0x29, 0x72, 0x69, 0xf0, 0xa7,
+	0x0b, 0xda, 0x3b, 0x64, 0x0d, 0xea, 0xa9, 0x82, 0x2a, 0xb9, 0x32, 0xfc, 0x7a, 0x5c, 0xfb, 0xcc,
+	0x18, 0xe1, 0xa3, 0x1c, 0x79, 0x9c, 0x2c, 0x7b, 0xaa, 0x5f, 0x57, 0x8c, 0x1f, 0x87, 0xa4, 0xcb,
+	0xb3, 0x52, 0x98, 0x47, 0x50, 0x92, 0xc5, 0x4d, 0x12, 0x65, 0xcb, 0xe9, 0x6a, 0x67, 0xb6, 0x00,
+	0x3f, 0x06, 0x88, 0xcb, 0xb4, 0xb1, 0x02, 0x86, 0x4a, 0xb7, 0xd9, 0xd3, 0x7e, 0x94, 0x23, 0x3f,
+	0x81, 0x5a, 0xb2, 0x24, 0x49, 0xa2, 0xfc, 0x21, 0xa3, 0x50, 0x39, 0x4a, 0x84, 0x4a, 0x54, 0x53,
+	0x24, 0xcd, 0x28, 0xdd, 0x1b, 0x28, 0x33, 0x2e, 0x5d, 0x18, 0xb2, 0x89, 0x9b, 0x7d, 0x2f, 0x38,
+	0xd1, 0xde, 0x21, 0xff, 0x07, 0x4a, 0xb2, 0xc2, 0x18, 0x7f, 0x7b, 0xba, 0xe4, 0x38, 0xe6, 0xe5,
+	0x9f, 0x40, 0x2d, 0x09, 0xf3, 0xc7, 0xf2, 0x67, 0x80, 0xff, 0x4b, 0x73, 0xa9, 0x64, 0x54, 0xaa,
+	0xfe, 0x87, 0x50, 0x89, 0xb0, 0xfe, 0x58, 0xfe, 0x41, 0xf8, 0x3f, 0xf3, 0xdd, 0x8f, 0x72, 0x64,
+	0x13, 0x7f, 0x5c, 0x16, 0xd5, 0x2f, 0xe2, 0xf9, 0x33, 0xaa, 0x1a, 0x63, 0x3e, 0x63, 0x17, 0xea,
+	0x29, 0x0c, 0x3e, 0xde, 0x44, 0x59, 0x55, 0x80, 0xa5, 0xab, 0x23, 0x7a, 0x85, 0x91, 0xd5, 0xde,
+	0x21, 0xdb, 0x30, 0x93, 0x36, 0xf4, 0x64, 0xbc, 0x03, 0x18, 0x23, 0xda, 0x33, 0x58, 0x48, 0xbf,
+	0xb2, 0x21, 0x12, 0xf2, 0x53, 0x06, 0xcc, 0xbc, 0xf5, 0x80, 0x92, 0xcd, 0x0e, 0xa4, 0x91, 0xe4,
+	0xda, 0xc0, 0x9a, 0x4d, 0x3a, 0xd4, 0x26, 0xd4, 0x92, 0xd9, 0x60, 0xac, 0xfb, 0x8c, 0x1c, 0x71,
+	0xd4, 0x20, 0x1f, 0xe5, 0xb8, 0xae, 0xd2, 0x29, 0x53, 0xfc, 0x69, 0x99, 0x69, 0xdd, 0x18, 0x5d,
+	0x3d, 0x85, 0xd9, 0x81, 0xec, 0x2b, 0xfe, 0xb8, 0xec, 0xb4, 0x6c, 0xcc, 0x60, 0x8f, 0xa1, 0x9e,
+	0xca, 0xa6, 0xe2, 0x3d, 0x91, 0x95, 0x64, 0x8d, 0x19, 0x68, 0x13, 0x6a, 0xc9, 0x04, 0x29, 0x71,
+	0xc6, 0x87, 0xd3, 0xa6, 0x31, 0xc3, 0xac, 0x43, 0x35, 0x91, 0xf1, 0x90, 0x08, 0xd9, 0x19, 0x4e,
+	0x83, 0xc6, 0x1f, 0x76, 0x99, 0xa0, 0xc4, 0x87, 0x3d, 0x9d, 0xb1, 0x8c, 0x79, 0x79, 0x03, 0xe6,
+	0x86, 0x92, 0x13, 0xb2, 0x1c, 0x9f, 0xb8, 0xec, 0xbc, 0x65, 0x29, 0x99, 0x55, 0x68, 0xef, 0x90,
+	0xe7, 0x7c, 0x94, 0x81, 0x94, 0x22, 0x39, 0x4a, 0x76, 0xb6, 0x31, 0x46, 0xac, 0xff, 0x17, 0x21,
+	0x23, 0x83, 0x91, 0xfe, 0x7b, 0x03, 0x3b, 0x3b, 0x3b, 0xa3, 0x58, 0x6a, 0x8e, 0x88, 0xc1, 0x99,
+	0x58, 0xbc, 0x64, 0xe8, 0x1d, 0x2f, 0x5e, 0x46, 0x40, 0x3e, 0x7e, 0x0f, 0x24, 0xc3, 0xf2, 0x78,
+	0x98, 0x8c, 0x60, 0x7d, 0xec, 0xf2, 0xa1, 0xbf, 0x91, 0x83, 0x8c, 0xe0, 0x5b, 0x9a, 0x1f, 0x0e,
+	0x56, 0x19, 0x6e, 0xa0, 0x7a, 0x2a, 0xb6, 0x1f, 0xf2, 0x94, 0x69, 0x29, 0x32, 0x42, 0x5e, 0xed,
+	0x1d, 0xf2, 0x23, 0xe5, 0x6e, 0x56, 0x7b, 0xbd, 0x91, 0x02, 0x8c, 0xfe, 0x80, 0xcf, 0xa1, 0x24,
+	0x2f, 0x45, 0xc4, 0xfb, 0x2f, 0x7d, 0x4b, 0x22, 0x9e, 0x37, 0xae, 0xec, 0xa3, 0x9d, 0xf0, 0xe1,
+	0xd2, 0xc8, 0xa2, 0x26, 0xb9, 0x3d, 0xf0, 0x29, 0x23, 0x6b, 0xa3, 0x4b, 0x77, 0x26, 0xe0, 0x8c,
+	0xec, 0xf8, 0x7e, 0x94, 0x0e, 0x0d, 0x94, 0x33, 0x07, 0x06, 0xc9, 0x2a, 0x82, 0x2e, 0x45, 0xbf,
+	0xc3, 0x48, 0xf5, 0xa2, 0x99, 0xaa, 0x25, 0x83, 0xf3, 0x78, 0x33, 0x64, 0x44, 0xf2, 0x4b, 0x57,
+	0xb2, 0x3b, 0x93, 0xae, 0x26, 0x7d, 0xad, 0x27, 0x36, 0x9f, 0x99, 0xd7, 0x7d, 0xc6, 0x2c, 0xce,
+	0x57, 0x68, 0x61, 0x76, 0x5c, 0xd3, 0xda, 0xe7, 0x39, 0xdf, 0x92, 0x82, 0x42, 0x12, 0x44, 0x35,
+	0xc8, 0xe5, 0xcc, 0xbe, 0x48, 0xa8, 0xa7, 0x88, 0xce, 0xa8, 0x8e, 0x0d, 0xda, 0x35, 0xc3, 0xde,
+	0xe8, 0xfd, 0x3a, 0x7e, 0xb0, 0xb5, 0x1f, 0xfc, 0xeb, 0x9b, 0x6b, 0xb9, 0xdf, 0xbd, 0xb9, 0x96,
+	0xfb, 0x8f, 0x37, 0xd7, 0x72, 0xff, 0xf7, 0xce, 0x81, 0x1d, 0x1c, 0x86, 0xed, 0x95, 0x8e, 0xdb,
+	0xbf, 0xef, 0x99, 0x9d, 0xc3, 0x13, 0x8b, 0xfa, 0xc9, 0xa7, 0xe3, 0x07, 0xf7, 0x99, 0xdf, 0xb9,
+	0xef, 0x79, 0xac, 0x5d, 0xc4, 0x79, 0x1e, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xbc,
+	0xb3, 0xa0, 0xda, 0x50, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5471,6 +7572,9 @@ const _ = grpc.SupportPackageIsVersion4
 type APIClient interface {
 	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
 	InspectJobSet(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (API_InspectJobSetClient, error)
+	// InspectJobSetInfo returns the jobs in a job set along with their aggregate
+	// state.
+	InspectJobSetInfo(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (*JobSetInfo, error)
 	// ListJob returns information about current and past Pachyderm jobs.
 	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error)
 	ListJobSet(ctx context.Context, in *ListJobSetRequest, opts ...grpc.CallOption) (API_ListJobSetClient, error)
@@ -5481,14 +7585,30 @@ type APIClient interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetDatumCount returns the number of datums an input would produce against
+	// the current heads of its branches
+	GetDatumCount(ctx context.Context, in *GetDatumCountRequest, opts ...grpc.CallOption) (*GetDatumCountResponse, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CreatePipelineDryRun validates a pipeline as CreatePipeline would and
+	// returns the PipelineInfo that would be created, without changing any
+	// cluster state.
+	CreatePipelineDryRun(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DeletePipelines deletes a set of pipelines, downstream pipelines first.
+	DeletePipelines(ctx context.Context, in *DeletePipelinesRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ReprocessPipeline starts a new job that processes the datums in the
+	// pipeline's current inputs under its existing spec.
+	ReprocessPipeline(ctx context.Context, in *ReprocessPipelineRequest, opts ...grpc.CallOption) (*Job, error)
+	// ReleaseQuarantine causes a quarantined datum to be processed again by the
+	// pipeline's next job.
+	ReleaseQuarantine(ctx context.Context, in *ReleaseQuarantineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectWorkerHeartbeat(ctx context.Context, in *InspectWorkerHeartbeatRequest, opts ...grpc.CallOption) (*WorkerHeartbeats, error)
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error)
@@ -5496,6 +7616,12 @@ type APIClient interface {
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
+	// InspectEnterpriseFeatures returns which enterprise features of PPS are
+	// enabled.
+	InspectEnterpriseFeatures(ctx context.Context, in *InspectEnterpriseFeaturesRequest, opts ...grpc.CallOption) (*InspectEnterpriseFeaturesResponse, error)
+	// InspectClusterLimits returns the limits CreatePipeline enforces on this
+	// cluster.
+	InspectClusterLimits(ctx context.Context, in *InspectClusterLimitsRequest, opts ...grpc.CallOption) (*ClusterLimits, error)
 	// An internal call that causes PPS to put itself into an auth-enabled state
 	// (all pipeline have tokens, correct permissions, etcd)
 	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
@@ -5556,6 +7682,15 @@ func (x *aPIInspectJobSetClient) Recv() (*JobInfo, error) {
 	return m, nil
 }
 
+func (c *aPIClient) InspectJobSetInfo(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (*JobSetInfo, error) {
+	out := new(JobSetInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectJobSetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps_v2.API/ListJob", opts...)
 	if err != nil {
@@ -5720,9 +7855,27 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
-func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
+func (c *aPIClient) GetDatumCount(ctx context.Context, in *GetDatumCountRequest, opts ...grpc.CallOption) (*GetDatumCountResponse, error) {
+	out := new(GetDatumCountResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/GetDatumCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreatePipelineDryRun(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
+	out := new(PipelineInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipelineDryRun", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5779,6 +7932,15 @@ func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineReques
 	return out, nil
 }
 
+func (c *aPIClient) DeletePipelines(ctx context.Context, in *DeletePipelinesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeletePipelines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/StartPipeline", in, out, opts...)
@@ -5815,6 +7977,33 @@ func (c *aPIClient) RunCron(ctx context.Context, in *RunCronRequest, opts ...grp
 	return out, nil
 }
 
+func (c *aPIClient) ReprocessPipeline(ctx context.Context, in *ReprocessPipelineRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ReprocessPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ReleaseQuarantine(ctx context.Context, in *ReleaseQuarantineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ReleaseQuarantine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectWorkerHeartbeat(ctx context.Context, in *InspectWorkerHeartbeatRequest, opts ...grpc.CallOption) (*WorkerHeartbeats, error) {
+	out := new(WorkerHeartbeats)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectWorkerHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/CreateSecret", in, out, opts...)
@@ -5892,6 +8081,24 @@ func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
 	return m, nil
 }
 
+func (c *aPIClient) InspectEnterpriseFeatures(ctx context.Context, in *InspectEnterpriseFeaturesRequest, opts ...grpc.CallOption) (*InspectEnterpriseFeaturesResponse, error) {
+	out := new(InspectEnterpriseFeaturesResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectEnterpriseFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectClusterLimits(ctx context.Context, in *InspectClusterLimitsRequest, opts ...grpc.CallOption) (*ClusterLimits, error) {
+	out := new(ClusterLimits)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectClusterLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
 	out := new(ActivateAuthResponse)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/ActivateAuth", in, out, opts...)
@@ -5932,6 +8139,9 @@ func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opt
 type APIServer interface {
 	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
 	InspectJobSet(*InspectJobSetRequest, API_InspectJobSetServer) error
+	// InspectJobSetInfo returns the jobs in a job set along with their aggregate
+	// state.
+	InspectJobSetInfo(context.Context, *InspectJobSetRequest) (*JobSetInfo, error)
 	// ListJob returns information about current and past Pachyderm jobs.
 	ListJob(*ListJobRequest, API_ListJobServer) error
 	ListJobSet(*ListJobSetRequest, API_ListJobSetServer) error
@@ -5942,14 +8152,30 @@ type APIServer interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(*ListDatumRequest, API_ListDatumServer) error
 	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
+	// GetDatumCount returns the number of datums an input would produce against
+	// the current heads of its branches
+	GetDatumCount(context.Context, *GetDatumCountRequest) (*GetDatumCountResponse, error)
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
+	// CreatePipelineDryRun validates a pipeline as CreatePipeline would and
+	// returns the PipelineInfo that would be created, without changing any
+	// cluster state.
+	CreatePipelineDryRun(context.Context, *CreatePipelineRequest) (*PipelineInfo, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(*ListPipelineRequest, API_ListPipelineServer) error
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
+	// DeletePipelines deletes a set of pipelines, downstream pipelines first.
+	DeletePipelines(context.Context, *DeletePipelinesRequest) (*types.Empty, error)
 	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
 	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
 	RunPipeline(context.Context, *RunPipelineRequest) (*types.Empty, error)
 	RunCron(context.Context, *RunCronRequest) (*types.Empty, error)
+	// ReprocessPipeline starts a new job that processes the datums in the
+	// pipeline's current inputs under its existing spec.
+	ReprocessPipeline(context.Context, *ReprocessPipelineRequest) (*Job, error)
+	// ReleaseQuarantine causes a quarantined datum to be processed again by the
+	// pipeline's next job.
+	ReleaseQuarantine(context.Context, *ReleaseQuarantineRequest) (*types.Empty, error)
+	InspectWorkerHeartbeat(context.Context, *InspectWorkerHeartbeatRequest) (*WorkerHeartbeats, error)
 	CreateSecret(context.Context, *CreateSecretRequest) (*types.Empty, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*types.Empty, error)
 	ListSecret(context.Context, *types.Empty) (*SecretInfos, error)
@@ -5957,6 +8183,12 @@ type APIServer interface {
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
+	// InspectEnterpriseFeatures returns which enterprise features of PPS are
+	// enabled.
+	InspectEnterpriseFeatures(context.Context, *InspectEnterpriseFeaturesRequest) (*InspectEnterpriseFeaturesResponse, error)
+	// InspectClusterLimits returns the limits CreatePipeline enforces on this
+	// cluster.
+	InspectClusterLimits(context.Context, *InspectClusterLimitsRequest) (*ClusterLimits, error)
 	// An internal call that causes PPS to put itself into an auth-enabled state
 	// (all pipeline have tokens, correct permissions, etcd)
 	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
@@ -5978,6 +8210,9 @@ func (*UnimplementedAPIServer) InspectJob(ctx context.Context, req *InspectJobRe
 func (*UnimplementedAPIServer) InspectJobSet(req *InspectJobSetRequest, srv API_InspectJobSetServer) error {
 	return status.Errorf(codes.Unimplemented, "method InspectJobSet not implemented")
 }
+func (*UnimplementedAPIServer) InspectJobSetInfo(ctx context.Context, req *InspectJobSetRequest) (*JobSetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectJobSetInfo not implemented")
+}
 func (*UnimplementedAPIServer) ListJob(req *ListJobRequest, srv API_ListJobServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListJob not implemented")
 }
@@ -6002,9 +8237,15 @@ func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatu
 func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
 }
+func (*UnimplementedAPIServer) GetDatumCount(ctx context.Context, req *GetDatumCountRequest) (*GetDatumCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatumCount not implemented")
+}
 func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
 }
+func (*UnimplementedAPIServer) CreatePipelineDryRun(ctx context.Context, req *CreatePipelineRequest) (*PipelineInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePipelineDryRun not implemented")
+}
 func (*UnimplementedAPIServer) InspectPipeline(ctx context.Context, req *InspectPipelineRequest) (*PipelineInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectPipeline not implemented")
 }
@@ -6014,6 +8255,9 @@ func (*UnimplementedAPIServer) ListPipeline(req *ListPipelineRequest, srv API_Li
 func (*UnimplementedAPIServer) DeletePipeline(ctx context.Context, req *DeletePipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
 }
+func (*UnimplementedAPIServer) DeletePipelines(ctx context.Context, req *DeletePipelinesRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePipelines not implemented")
+}
 func (*UnimplementedAPIServer) StartPipeline(ctx context.Context, req *StartPipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartPipeline not implemented")
 }
@@ -6026,6 +8270,15 @@ func (*UnimplementedAPIServer) RunPipeline(ctx context.Context, req *RunPipeline
 func (*UnimplementedAPIServer) RunCron(ctx context.Context, req *RunCronRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCron not implemented")
 }
+func (*UnimplementedAPIServer) ReprocessPipeline(ctx context.Context, req *ReprocessPipelineRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessPipeline not implemented")
+}
+func (*UnimplementedAPIServer) ReleaseQuarantine(ctx context.Context, req *ReleaseQuarantineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQuarantine not implemented")
+}
+func (*UnimplementedAPIServer) InspectWorkerHeartbeat(ctx context.Context, req *InspectWorkerHeartbeatRequest) (*WorkerHeartbeats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectWorkerHeartbeat not implemented")
+}
 func (*UnimplementedAPIServer) CreateSecret(ctx context.Context, req *CreateSecretRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
 }
@@ -6044,6 +8297,12 @@ func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty)
 func (*UnimplementedAPIServer) GetLogs(req *GetLogsRequest, srv API_GetLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
+func (*UnimplementedAPIServer) InspectEnterpriseFeatures(ctx context.Context, req *InspectEnterpriseFeaturesRequest) (*InspectEnterpriseFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectEnterpriseFeatures not implemented")
+}
+func (*UnimplementedAPIServer) InspectClusterLimits(ctx context.Context, req *InspectClusterLimitsRequest) (*ClusterLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectClusterLimits not implemented")
+}
 func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
 }
@@ -6100,6 +8359,24 @@ func (x *aPIInspectJobSetServer) Send(m *JobInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_InspectJobSetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectJobSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectJobSetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectJobSetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectJobSetInfo(ctx, req.(*InspectJobSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ListJob_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListJobRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6256,6 +8533,24 @@ func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetDatumCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatumCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetDatumCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/GetDatumCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDatumCount(ctx, req.(*GetDatumCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePipelineRequest)
 	if err := dec(in); err != nil {
@@ -6274,6 +8569,24 @@ func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreatePipelineDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreatePipelineDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/CreatePipelineDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePipelineDryRun(ctx, req.(*CreatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InspectPipelineRequest)
 	if err := dec(in); err != nil {
@@ -6331,6 +8644,24 @@ func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_DeletePipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeletePipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeletePipelines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePipelines(ctx, req.(*DeletePipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartPipelineRequest)
 	if err := dec(in); err != nil {
@@ -6403,6 +8734,60 @@ func _API_RunCron_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ReprocessPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ReprocessPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ReprocessPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ReprocessPipeline(ctx, req.(*ReprocessPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ReleaseQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseQuarantineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ReleaseQuarantine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ReleaseQuarantine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ReleaseQuarantine(ctx, req.(*ReleaseQuarantineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectWorkerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectWorkerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectWorkerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectWorkerHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectWorkerHeartbeat(ctx, req.(*InspectWorkerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSecretRequest)
 	if err := dec(in); err != nil {
@@ -6514,6 +8899,42 @@ func (x *aPIGetLogsServer) Send(m *LogMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_InspectEnterpriseFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectEnterpriseFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectEnterpriseFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectEnterpriseFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectEnterpriseFeatures(ctx, req.(*InspectEnterpriseFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectClusterLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectClusterLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectClusterLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectClusterLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectClusterLimits(ctx, req.(*InspectClusterLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateAuthRequest)
 	if err := dec(in); err != nil {
@@ -6594,6 +9015,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InspectJob",
 			Handler:    _API_InspectJob_Handler,
 		},
+		{
+			MethodName: "InspectJobSetInfo",
+			Handler:    _API_InspectJobSetInfo_Handler,
+		},
 		{
 			MethodName: "DeleteJob",
 			Handler:    _API_DeleteJob_Handler,
@@ -6610,10 +9035,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartDatum",
 			Handler:    _API_RestartDatum_Handler,
 		},
+		{
+			MethodName: "GetDatumCount",
+			Handler:    _API_GetDatumCount_Handler,
+		},
 		{
 			MethodName: "CreatePipeline",
 			Handler:    _API_CreatePipeline_Handler,
 		},
+		{
+			MethodName: "CreatePipelineDryRun",
+			Handler:    _API_CreatePipelineDryRun_Handler,
+		},
 		{
 			MethodName: "InspectPipeline",
 			Handler:    _API_InspectPipeline_Handler,
@@ -6622,6 +9055,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePipeline",
 			Handler:    _API_DeletePipeline_Handler,
 		},
+		{
+			MethodName: "DeletePipelines",
+			Handler:    _API_DeletePipelines_Handler,
+		},
 		{
 			MethodName: "StartPipeline",
 			Handler:    _API_StartPipeline_Handler,
@@ -6638,6 +9075,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunCron",
 			Handler:    _API_RunCron_Handler,
 		},
+		{
+			MethodName: "ReprocessPipeline",
+			Handler:    _API_ReprocessPipeline_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantine",
+			Handler:    _API_ReleaseQuarantine_Handler,
+		},
+		{
+			MethodName: "InspectWorkerHeartbeat",
+			Handler:    _API_InspectWorkerHeartbeat_Handler,
+		},
 		{
 			MethodName: "CreateSecret",
 			Handler:    _API_CreateSecret_Handler,
@@ -6658,6 +9107,14 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
 		},
+		{
+			MethodName: "InspectEnterpriseFeatures",
+			Handler:    _API_InspectEnterpriseFeatures_Handler,
+		},
+		{
+			MethodName: "InspectClusterLimits",
+			Handler:    _API_InspectClusterLimits_Handler,
+		},
 		{
 			MethodName: "ActivateAuth",
 			Handler:    _API_ActivateAuth_Handler,
@@ -6770,7 +9227,7 @@ func (m *SecretMount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Transform) Marshal() (dAtA []byte, err error) {
+func (m *ConfigMapMount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6780,12 +9237,12 @@ func (m *Transform) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigMapMount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigMapMount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6794,48 +9251,155 @@ func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Dockerfile) > 0 {
-		i -= len(m.Dockerfile)
-		copy(dAtA[i:], m.Dockerfile)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
+	if len(m.EnvVar) > 0 {
+		i -= len(m.EnvVar)
+		copy(dAtA[i:], m.EnvVar)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.EnvVar)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x22
 	}
-	if len(m.WorkingDir) > 0 {
-		i -= len(m.WorkingDir)
-		copy(dAtA[i:], m.WorkingDir)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
+	if len(m.MountPath) > 0 {
+		i -= len(m.MountPath)
+		copy(dAtA[i:], m.MountPath)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x1a
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x12
 	}
-	if m.Debug {
-		i--
-		if m.Debug {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0xa
 	}
-	if len(m.AcceptReturnCode) > 0 {
-		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
-		var j1 int
-		for _, num1 := range m.AcceptReturnCode {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
+	return len(dAtA) - i, nil
+}
+
+func (m *Transform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ScriptFromInput) > 0 {
+		i -= len(m.ScriptFromInput)
+		copy(dAtA[i:], m.ScriptFromInput)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ScriptFromInput)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PreflightStdin) > 0 {
+		for iNdEx := len(m.PreflightStdin) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreflightStdin[iNdEx])
+			copy(dAtA[i:], m.PreflightStdin[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.PreflightStdin[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PreflightCmd) > 0 {
+		for iNdEx := len(m.PreflightCmd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreflightCmd[iNdEx])
+			copy(dAtA[i:], m.PreflightCmd[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.PreflightCmd[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ConfigMaps) > 0 {
+		for iNdEx := len(m.ConfigMaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigMaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.StdinFromInput) > 0 {
+		i -= len(m.StdinFromInput)
+		copy(dAtA[i:], m.StdinFromInput)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StdinFromInput)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Dockerfile) > 0 {
+		i -= len(m.Dockerfile)
+		copy(dAtA[i:], m.Dockerfile)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.WorkingDir) > 0 {
+		i -= len(m.WorkingDir)
+		copy(dAtA[i:], m.WorkingDir)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Debug {
+		i--
+		if m.Debug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AcceptReturnCode) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
+		var j1 int
+		for _, num1 := range m.AcceptReturnCode {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
 			j1++
 		}
 		i -= j1
@@ -6990,6 +9554,18 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.URL) > 0 {
 		i -= len(m.URL)
 		copy(dAtA[i:], m.URL)
@@ -7186,6 +9762,13 @@ func (m *Spout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CommitMarker) > 0 {
+		i -= len(m.CommitMarker)
+		copy(dAtA[i:], m.CommitMarker)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CommitMarker)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Service != nil {
 		{
 			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
@@ -7225,6 +9808,25 @@ func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LeafDirs {
+		i--
+		if m.LeafDirs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Globs) > 0 {
+		for iNdEx := len(m.Globs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Globs[iNdEx])
+			copy(dAtA[i:], m.Globs[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Globs[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.RepoType) > 0 {
 		i -= len(m.RepoType)
 		copy(dAtA[i:], m.RepoType)
@@ -7360,6 +9962,40 @@ func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.KeepDuration != nil {
+		{
+			size, err := m.KeepDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.KeepTicks != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.KeepTicks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Json {
+		i--
+		if m.Json {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Start != nil {
 		{
 			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
@@ -7437,6 +10073,16 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Dedup {
+		i--
+		if m.Dedup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Cron != nil {
 		{
 			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
@@ -7607,6 +10253,16 @@ func (m *ParallelismSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Max != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DatumsPerWorker != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumsPerWorker))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Constant != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
 		i--
@@ -7726,6 +10382,13 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.WorkerID) > 0 {
+		i -= len(m.WorkerID)
+		copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7784,7 +10447,7 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Aggregate) Marshal() (dAtA []byte, err error) {
+func (m *FailureReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7794,12 +10457,12 @@ func (m *Aggregate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
+func (m *FailureReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FailureReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7808,39 +10471,43 @@ func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NinetyFifthPercentile != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.FifthPercentile != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
-		i--
-		dAtA[i] = 0x21
-	}
-	if m.Stddev != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
-		i--
-		dAtA[i] = 0x19
+	if len(m.FailedDatums) > 0 {
+		for iNdEx := len(m.FailedDatums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDatums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Mean != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x11
+		dAtA[i] = 0x12
 	}
-	if m.Count != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
+func (m *FailedDatum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7850,12 +10517,12 @@ func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *FailedDatum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FailedDatum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7864,19 +10531,44 @@ func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UploadBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
+	if m.Retries != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Retries))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.DownloadBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Logs[iNdEx])
+			copy(dAtA[i:], m.Logs[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Logs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if m.UploadTime != nil {
+	if m.Datum != nil {
 		{
-			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7884,9 +10576,114 @@ func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.ProcessTime != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NinetyFifthPercentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.FifthPercentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Stddev != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Mean != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Count != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UploadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DownloadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UploadTime != nil {
+		{
+			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProcessTime != nil {
 		{
 			size, err := m.ProcessTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -8024,6 +10821,17 @@ func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DatumsPerSecond))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.QueueSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.QueueSize))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DatumStatus != nil {
 		{
 			size, err := m.DatumStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -8204,7 +11012,7 @@ func (m *GPUSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+func (m *DatumMemoryScaling) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8214,12 +11022,12 @@ func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumMemoryScaling) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DatumMemoryScaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8228,36 +11036,30 @@ func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Jobs) > 0 {
-		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.JobSet != nil {
-		{
-			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if m.PerByte != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PerByte))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Base)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+func (m *Heartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8267,12 +11069,12 @@ func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Heartbeat) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8281,9 +11083,9 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details != nil {
+	if m.Timeout != nil {
 		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8291,13 +11093,11 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x12
 	}
-	if m.Finished != nil {
+	if m.Interval != nil {
 		{
-			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8305,11 +11105,49 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0xa
 	}
-	if m.Started != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *CrashBackoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashBackoff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashBackoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Multiplier != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Multiplier))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Max != nil {
 		{
-			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8317,11 +11155,11 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x12
 	}
-	if m.Created != nil {
+	if m.Initial != nil {
 		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Initial.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8329,23 +11167,38 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xa
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x62
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x58
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Stats != nil {
+	if m.Duration != nil {
 		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8353,26 +11206,278 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
-	}
-	if m.DataRecovered != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
-		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x12
 	}
-	if m.DataFailed != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Cron)))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0xa
 	}
-	if m.DataTotal != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DataSkipped != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
-		i--
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.JobSet != nil {
+		{
+			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FailedDatums) > 0 {
+		for iNdEx := len(m.FailedDatums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDatums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.DataPending != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataPending))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Reprocess {
+		i--
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DataRecovered != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DataFailed != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DataTotal != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DataSkipped != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+		i--
 		dAtA[i] = 0x30
 	}
 	if m.DataProcessed != 0 {
@@ -8441,6 +11546,15 @@ func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DatumFilesetId) > 0 {
+		i -= len(m.DatumFilesetId)
+		copy(dAtA[i:], m.DatumFilesetId)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumFilesetId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.PodPatch) > 0 {
 		i -= len(m.PodPatch)
 		copy(dAtA[i:], m.PodPatch)
@@ -8743,6 +11857,20 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RecentJobs) > 0 {
+		for iNdEx := len(m.RecentJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.Details != nil {
 		{
 			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
@@ -8855,6 +11983,188 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ReprocessGlob) > 0 {
+		i -= len(m.ReprocessGlob)
+		copy(dAtA[i:], m.ReprocessGlob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessGlob)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MaintenanceWindow != nil {
+		{
+			size, err := m.MaintenanceWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaintenanceSchedule != nil {
+		{
+			size, err := m.MaintenanceSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.NoMeta {
+		i--
+		if m.NoMeta {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.JobsInFlight != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.JobsInFlight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxInFlightJobs != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxInFlightJobs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.CrashBackoff != nil {
+		{
+			size, err := m.CrashBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.JobHistoryLimit != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.JobHistoryLimit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Heartbeat != nil {
+		{
+			size, err := m.Heartbeat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.QuarantineAfter != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.QuarantineAfter))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.DatumMemoryScaling != nil {
+		{
+			size, err := m.DatumMemoryScaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.ShardByKey {
+		i--
+		if m.ShardByKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PreviousOutput) > 0 {
+		i -= len(m.PreviousOutput)
+		copy(dAtA[i:], m.PreviousOutput)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PreviousOutput)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Passthrough {
+		i--
+		if m.Passthrough {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.NodePool) > 0 {
+		i -= len(m.NodePool)
+		copy(dAtA[i:], m.NodePool)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.NodePool)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.FailureReport {
+		i--
+		if m.FailureReport {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.DedupAgainst) > 0 {
+		i -= len(m.DedupAgainst)
+		copy(dAtA[i:], m.DedupAgainst)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DedupAgainst)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -9187,6 +12497,62 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9516,6 +12882,16 @@ func (m *SubscribeJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Transitions {
+		i--
+		if m.Transitions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Details {
 		i--
 		if m.Details {
@@ -9743,6 +13119,16 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.UserOnly {
+		i--
+		if m.UserOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Since != nil {
 		{
 			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
@@ -10057,6 +13443,13 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DatumFilesetId) > 0 {
+		i -= len(m.DatumFilesetId)
+		copy(dAtA[i:], m.DatumFilesetId)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumFilesetId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Input != nil {
 		{
 			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
@@ -10084,7 +13477,7 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
+func (m *GetDatumCountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10094,12 +13487,12 @@ func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDatumCountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDatumCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10108,25 +13501,34 @@ func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PerWorker != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Number != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+func (m *GetDatumCountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10136,12 +13538,12 @@ func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDatumCountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDatumCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10150,20 +13552,232 @@ func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PriorityClassName) > 0 {
-		i -= len(m.PriorityClassName)
-		copy(dAtA[i:], m.PriorityClassName)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
+	if m.Count != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x8
 	}
-	if len(m.NodeSelector) > 0 {
-		for k := range m.NodeSelector {
-			v := m.NodeSelector[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectEnterpriseFeaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectEnterpriseFeaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectEnterpriseFeaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectEnterpriseFeaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectEnterpriseFeaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectEnterpriseFeaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LokiLogs {
+		i--
+		if m.LokiLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enterprise {
+		i--
+		if m.Enterprise {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectClusterLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectClusterLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectClusterLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxParallelism != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxParallelism))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPipelines != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxPipelines))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PerWorker != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PriorityClassName) > 0 {
+		i -= len(m.PriorityClassName)
+		copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
 			i -= len(k)
@@ -10203,30 +13817,51 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Autoscaling {
+	if len(m.ReprocessGlob) > 0 {
+		i -= len(m.ReprocessGlob)
+		copy(dAtA[i:], m.ReprocessGlob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessGlob)))
 		i--
-		if m.Autoscaling {
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.MaintenanceSchedule != nil {
+		{
+			size, err := m.MaintenanceSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.NoMeta {
+		i--
+		if m.NoMeta {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xf0
+		dAtA[i] = 0xe0
 	}
-	if len(m.ReprocessSpec) > 0 {
-		i -= len(m.ReprocessSpec)
-		copy(dAtA[i:], m.ReprocessSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+	if m.MaxInFlightJobs != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxInFlightJobs))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0xd8
 	}
-	if m.Metadata != nil {
+	if m.CrashBackoff != nil {
 		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CrashBackoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10234,13 +13869,20 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xd2
 	}
-	if m.SpecCommit != nil {
+	if m.JobHistoryLimit != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.JobHistoryLimit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Heartbeat != nil {
 		{
-			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Heartbeat.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10248,20 +13890,165 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xc2
 	}
-	if len(m.PodPatch) > 0 {
-		i -= len(m.PodPatch)
-		copy(dAtA[i:], m.PodPatch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+	if m.QuarantineAfter != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.QuarantineAfter))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xb8
 	}
-	if len(m.PodSpec) > 0 {
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DatumMemoryScaling != nil {
+		{
+			size, err := m.DatumMemoryScaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.ShardByKey {
+		i--
+		if m.ShardByKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PreviousOutput) > 0 {
+		i -= len(m.PreviousOutput)
+		copy(dAtA[i:], m.PreviousOutput)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PreviousOutput)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Passthrough {
+		i--
+		if m.Passthrough {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.NodePool) > 0 {
+		i -= len(m.NodePool)
+		copy(dAtA[i:], m.NodePool)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.NodePool)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FailureReport {
+		i--
+		if m.FailureReport {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.DedupAgainst) > 0 {
+		i -= len(m.DedupAgainst)
+		copy(dAtA[i:], m.DedupAgainst)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DedupAgainst)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Autoscaling {
+		i--
+		if m.Autoscaling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.ReprocessSpec) > 0 {
+		i -= len(m.ReprocessSpec)
+		copy(dAtA[i:], m.ReprocessSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.SpecCommit != nil {
+		{
+			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.PodPatch) > 0 {
+		i -= len(m.PodPatch)
+		copy(dAtA[i:], m.PodPatch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.PodSpec) > 0 {
 		i -= len(m.PodSpec)
 		copy(dAtA[i:], m.PodSpec)
 		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
@@ -10549,6 +14336,11 @@ func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RecentJobs != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.RecentJobs))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Details {
 		i--
 		if m.Details {
@@ -10704,6 +14496,67 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeletePipelinesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelinesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePipelinesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeepRepo {
+		i--
+		if m.KeepRepo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10728,6 +14581,20 @@ func (m *StartPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Pipeline != nil {
 		{
 			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
@@ -10767,6 +14634,20 @@ func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Pipeline != nil {
 		{
 			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
@@ -10881,7 +14762,7 @@ func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReprocessPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10891,12 +14772,12 @@ func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReprocessPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReprocessPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10905,17 +14786,41 @@ func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.File) > 0 {
-		i -= len(m.File)
-		copy(dAtA[i:], m.File)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.File)))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReprocessSpec) > 0 {
+		i -= len(m.ReprocessSpec)
+		copy(dAtA[i:], m.ReprocessSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseQuarantineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10925,12 +14830,12 @@ func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseQuarantineRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseQuarantineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10939,9 +14844,16 @@ func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Secret != nil {
+	if len(m.DatumID) > 0 {
+		i -= len(m.DatumID)
+		copy(dAtA[i:], m.DatumID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline != nil {
 		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10954,7 +14866,7 @@ func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectWorkerHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10964,12 +14876,12 @@ func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectWorkerHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectWorkerHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10978,9 +14890,208 @@ func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Secret != nil {
+	if m.Pipeline != nil {
 		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkerID) > 0 {
+		i -= len(m.WorkerID)
+		copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerHeartbeats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerHeartbeats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerHeartbeats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Heartbeats) > 0 {
+		for iNdEx := len(m.Heartbeats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heartbeats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11126,6 +15237,59 @@ func (m *SecretInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PipelineBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Secrets[iNdEx])
+			copy(dAtA[i:], m.Secrets[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Secrets[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11219,31 +15383,59 @@ func (m *SecretMount) Size() (n int) {
 	return n
 }
 
-func (m *Transform) Size() (n int) {
+func (m *ConfigMapMount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.ErrCmd) > 0 {
-		for _, s := range m.ErrCmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
+	l = len(m.EnvVar)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Transform) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
 			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
@@ -11295,6 +15487,32 @@ func (m *Transform) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.StdinFromInput)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.ConfigMaps) > 0 {
+		for _, e := range m.ConfigMaps {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.PreflightCmd) > 0 {
+		for _, s := range m.PreflightCmd {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.PreflightStdin) > 0 {
+		for _, s := range m.PreflightStdin {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.ScriptFromInput)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11327,6 +15545,10 @@ func (m *Egress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11417,6 +15639,10 @@ func (m *Spout) Size() (n int) {
 		l = m.Service.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.CommitMarker)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11477,6 +15703,15 @@ func (m *PFSInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Globs) > 0 {
+		for _, s := range m.Globs {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.LeafDirs {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11512,6 +15747,20 @@ func (m *CronInput) Size() (n int) {
 		l = m.Start.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Json {
+		n += 2
+	}
+	if m.KeepTicks != 0 {
+		n += 1 + sovPps(uint64(m.KeepTicks))
+	}
+	if m.KeepDuration != nil {
+		l = m.KeepDuration.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11556,6 +15805,9 @@ func (m *Input) Size() (n int) {
 		l = m.Cron.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Dedup {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11598,6 +15850,12 @@ func (m *ParallelismSpec) Size() (n int) {
 	if m.Constant != 0 {
 		n += 1 + sovPps(uint64(m.Constant))
 	}
+	if m.DatumsPerWorker != 0 {
+		n += 1 + sovPps(uint64(m.DatumsPerWorker))
+	}
+	if m.Max != 0 {
+		n += 1 + sovPps(uint64(m.Max))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11671,6 +15929,71 @@ func (m *DatumInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailureReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.FailedDatums) > 0 {
+		for _, e := range m.FailedDatums {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailedDatum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Retries != 0 {
+		n += 1 + sovPps(uint64(m.Retries))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11784,6 +16107,12 @@ func (m *WorkerStatus) Size() (n int) {
 		l = m.DatumStatus.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.QueueSize != 0 {
+		n += 1 + sovPps(uint64(m.QueueSize))
+	}
+	if m.DatumsPerSecond != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11858,6 +16187,115 @@ func (m *GPUSpec) Size() (n int) {
 	return n
 }
 
+func (m *DatumMemoryScaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PerByte != 0 {
+		n += 9
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Heartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CrashBackoff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Initial != nil {
+		l = m.Initial.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Multiplier != 0 {
+		n += 9
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovPps(uint64(m.MaxRetries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MaintenanceSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *JobSetInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11874,6 +16312,9 @@ func (m *JobSetInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11942,6 +16383,18 @@ func (m *JobInfo) Size() (n int) {
 		l = m.Details.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.Reprocess {
+		n += 3
+	}
+	if m.DataPending != 0 {
+		n += 2 + sovPps(uint64(m.DataPending))
+	}
+	if len(m.FailedDatums) > 0 {
+		for _, e := range m.FailedDatums {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12027,6 +16480,10 @@ func (m *JobInfo_Details) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	l = len(m.DatumFilesetId)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12112,6 +16569,12 @@ func (m *PipelineInfo) Size() (n int) {
 		l = m.Details.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.RecentJobs) > 0 {
+		for _, e := range m.RecentJobs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12242,23 +16705,106 @@ func (m *PipelineInfo_Details) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.DedupAgainst)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
 	}
-	return n
-}
-
-func (m *PipelineInfos) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FailureReport {
+		n += 3
 	}
-	var l int
-	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, e := range m.PipelineInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.NodePool)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Passthrough {
+		n += 3
+	}
+	l = len(m.PreviousOutput)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ShardByKey {
+		n += 3
+	}
+	if m.DatumMemoryScaling != nil {
+		l = m.DatumMemoryScaling.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.QuarantineAfter != 0 {
+		n += 2 + sovPps(uint64(m.QuarantineAfter))
+	}
+	if m.Heartbeat != nil {
+		l = m.Heartbeat.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobHistoryLimit != 0 {
+		n += 2 + sovPps(uint64(m.JobHistoryLimit))
+	}
+	if m.CrashBackoff != nil {
+		l = m.CrashBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MaxInFlightJobs != 0 {
+		n += 2 + sovPps(uint64(m.MaxInFlightJobs))
+	}
+	if m.JobsInFlight != 0 {
+		n += 2 + sovPps(uint64(m.JobsInFlight))
+	}
+	if m.NoMeta {
+		n += 3
+	}
+	if m.MaintenanceSchedule != nil {
+		l = m.MaintenanceSchedule.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MaintenanceWindow != nil {
+		l = m.MaintenanceWindow.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ReprocessGlob)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PipelineInfo) > 0 {
+		for _, e := range m.PipelineInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12386,6 +16932,9 @@ func (m *SubscribeJobRequest) Size() (n int) {
 	if m.Details {
 		n += 2
 	}
+	if m.Transitions {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12513,6 +17062,9 @@ func (m *GetLogsRequest) Size() (n int) {
 		l = m.Since.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.UserOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12619,6 +17171,105 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Input.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.DatumFilesetId)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDatumCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDatumCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectEnterpriseFeaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectEnterpriseFeaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enterprise {
+		n += 2
+	}
+	if m.LokiLogs {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectClusterLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPipelines != 0 {
+		n += 1 + sovPps(uint64(m.MaxPipelines))
+	}
+	if m.MaxParallelism != 0 {
+		n += 1 + sovPps(uint64(m.MaxParallelism))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12783,6 +17434,62 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	l = len(m.DedupAgainst)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.FailureReport {
+		n += 3
+	}
+	l = len(m.NodePool)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Passthrough {
+		n += 3
+	}
+	l = len(m.PreviousOutput)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ShardByKey {
+		n += 3
+	}
+	if m.DatumMemoryScaling != nil {
+		l = m.DatumMemoryScaling.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.QuarantineAfter != 0 {
+		n += 2 + sovPps(uint64(m.QuarantineAfter))
+	}
+	if m.Heartbeat != nil {
+		l = m.Heartbeat.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobHistoryLimit != 0 {
+		n += 2 + sovPps(uint64(m.JobHistoryLimit))
+	}
+	if m.CrashBackoff != nil {
+		l = m.CrashBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MaxInFlightJobs != 0 {
+		n += 2 + sovPps(uint64(m.MaxInFlightJobs))
+	}
+	if m.NoMeta {
+		n += 3
+	}
+	if m.MaintenanceSchedule != nil {
+		l = m.MaintenanceSchedule.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ReprocessGlob)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12802,6 +17509,9 @@ func (m *InspectPipelineRequest) Size() (n int) {
 	if m.Details {
 		n += 2
 	}
+	if m.RecentJobs != 0 {
+		n += 1 + sovPps(uint64(m.RecentJobs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12859,6 +17569,30 @@ func (m *DeletePipelineRequest) Size() (n int) {
 	return n
 }
 
+func (m *DeletePipelinesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.KeepRepo {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *StartPipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12869,6 +17603,12 @@ func (m *StartPipelineRequest) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12885,6 +17625,12 @@ func (m *StopPipelineRequest) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12933,30 +17679,42 @@ func (m *RunCronRequest) Size() (n int) {
 	return n
 }
 
-func (m *CreateSecretRequest) Size() (n int) {
+func (m *ReprocessPipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.File)
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.ReprocessSpec)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeleteSecretRequest) Size() (n int) {
+func (m *ReleaseQuarantineRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.DatumID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12965,14 +17723,14 @@ func (m *DeleteSecretRequest) Size() (n int) {
 	return n
 }
 
-func (m *InspectSecretRequest) Size() (n int) {
+func (m *InspectWorkerHeartbeatRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12981,32 +17739,118 @@ func (m *InspectSecretRequest) Size() (n int) {
 	return n
 }
 
-func (m *Secret) Size() (n int) {
+func (m *WorkerHeartbeat) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.WorkerID)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretInfo) Size() (n int) {
+func (m *WorkerHeartbeats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
+	if len(m.Heartbeats) > 0 {
+		for _, e := range m.Heartbeats {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
@@ -13039,6 +17883,31 @@ func (m *SecretInfos) Size() (n int) {
 	return n
 }
 
+func (m *PipelineBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if len(m.Secrets) > 0 {
+		for _, s := range m.Secrets {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ActivateAuthRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13134,7 +18003,4351 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMapMount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapMount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapMount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretMount{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
+					m.AcceptReturnCode = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dockerfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinFromInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdinFromInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMaps = append(m.ConfigMaps, &ConfigMapMount{})
+			if err := m.ConfigMaps[len(m.ConfigMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreflightCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreflightCmd = append(m.PreflightCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreflightStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreflightStdin = append(m.PreflightStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptFromInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptFromInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TFJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TFJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TFJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TFJob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Egress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secret == nil {
+				m.Secret = &SecretMount{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMarker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitMarker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PFSInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OuterJoin = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmptyFiles = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3 = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &pfs.Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Globs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Globs = append(m.Globs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafDirs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeafDirs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Json = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepTicks", wireType)
+			}
+			m.KeepTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepTicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeepDuration == nil {
+				m.KeepDuration = &types.Duration{}
+			}
+			if err := m.KeepDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
+			}
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dedup = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsPerWorker", wireType)
+			}
+			m.DatumsPerWorker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumsPerWorker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailureReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDatums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDatums = append(m.FailedDatums, &FailedDatum{})
+			if err := m.FailedDatums[len(m.FailedDatums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDatum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDatum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDatum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13144,29 +22357,33 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13176,29 +22393,33 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPath = string(dAtA[iNdEx:postIndex])
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var stringLen uint64
+			m.DownloadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13208,24 +22429,30 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DownloadBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.EnvVar = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13248,7 +22475,7 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transform) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13271,17 +22498,17 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13291,29 +22518,33 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13323,59 +22554,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13385,124 +22588,69 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Env[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13529,14 +22677,67 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &SecretMount{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13564,11 +22765,11 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13596,13 +22797,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13612,105 +22813,150 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			if m.DatumStatus == nil {
+				m.DatumStatus = &DatumStatus{}
+			}
+			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPps
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPps
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DatumsPerSecond = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
-					m.AcceptReturnCode = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13720,15 +22966,91 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13756,13 +23078,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13772,27 +23094,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
+			}
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13820,7 +23146,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dockerfile = string(dAtA[iNdEx:postIndex])
+			m.Disk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13844,7 +23170,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TFJob) Unmarshal(dAtA []byte) error {
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13867,15 +23193,15 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TFJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TFJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13903,8 +23229,27 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TFJob = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -13927,7 +23272,7 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *DatumMemoryScaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13946,19 +23291,62 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumMemoryScaling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumMemoryScaling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerByte", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PerByte = float64(math.Float64frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13986,7 +23374,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14010,7 +23398,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *Heartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14033,15 +23421,15 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: Heartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Heartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14068,18 +23456,18 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Interval == nil {
+				m.Interval = &types.Duration{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14089,23 +23477,27 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Timeout == nil {
+				m.Timeout = &types.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14129,7 +23521,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *CrashBackoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14152,15 +23544,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrashBackoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrashBackoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initial", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14187,231 +23579,79 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Initial == nil {
+				m.Initial = &types.Duration{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Initial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &types.Duration{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Multiplier = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14434,7 +23674,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14457,53 +23697,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
-			}
-			m.InternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
-			}
-			m.ExternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14531,13 +23733,13 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = string(dAtA[iNdEx:postIndex])
+			m.Cron = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14547,23 +23749,27 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Duration == nil {
+				m.Duration = &types.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14587,7 +23793,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Spout) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14610,15 +23816,15 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14645,10 +23851,46 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &types.Timestamp{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14674,7 +23916,7 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PFSInput) Unmarshal(dAtA []byte) error {
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14697,17 +23939,17 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14717,29 +23959,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
+			}
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14749,29 +23995,101 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Jobs = append(m.Jobs, &JobInfo{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14781,29 +24099,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			var stringLen uint64
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14813,29 +24135,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PipelineVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14845,29 +24154,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var stringLen uint64
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14877,29 +24190,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JoinOn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var v int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14909,17 +24209,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OuterJoin = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var stringLen uint64
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14929,29 +24228,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var v int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14961,17 +24247,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 10:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var v int
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14981,17 +24266,16 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EmptyFiles = bool(v != 0)
-		case 11:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var v int
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15001,15 +24285,14 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3 = bool(v != 0)
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15036,16 +24319,35 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &pfs.Trigger{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15073,64 +24375,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoType = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15140,29 +24391,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15172,29 +24427,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15204,29 +24463,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15236,27 +24499,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			if m.Details == nil {
+				m.Details = &JobInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15273,10 +24540,29 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
-		case 6:
+			m.Reprocess = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPending", wireType)
+			}
+			m.DataPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataPending |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDatums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15303,10 +24589,8 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &types.Timestamp{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedDatums = append(m.FailedDatums, &FailedDatum{})
+			if err := m.FailedDatums[len(m.FailedDatums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15332,7 +24616,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15355,15 +24639,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15390,16 +24674,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15426,14 +24710,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15460,14 +24746,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = append(m.Group, &Input{})
-			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15494,14 +24782,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15528,14 +24818,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15562,69 +24854,88 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if iNdEx >= l {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15634,27 +24945,31 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15681,16 +24996,16 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15718,13 +25033,13 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15734,68 +25049,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15805,67 +25085,33 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15875,29 +25121,52 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15907,82 +25176,65 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15992,31 +25244,27 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumFilesetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16044,7 +25292,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.DatumFilesetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16068,7 +25316,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16091,17 +25339,17 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16111,27 +25359,23 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -16147,117 +25391,11 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= WorkerState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16280,7 +25418,7 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16303,17 +25441,17 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16323,55 +25461,24 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16394,7 +25501,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16417,15 +25524,15 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16452,16 +25559,35 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16488,18 +25614,57 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stopped = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16509,33 +25674,48 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastJobState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastJobState |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			m.DownloadBytes = 0
+			m.Parallelism = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16545,16 +25725,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= int64(b&0x7F) << shift
+				m.Parallelism |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.UploadBytes = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16564,11 +25744,113 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadBytes |= int64(b&0x7F) << shift
+				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &PipelineInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentJobs = append(m.RecentJobs, &JobSummary{})
+			if err := m.RecentJobs[len(m.RecentJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16591,7 +25873,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16614,15 +25896,15 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16649,16 +25931,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16685,16 +25967,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16721,16 +26003,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16757,16 +26039,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16793,67 +26075,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16881,13 +26112,13 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			m.RecentError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
 			}
-			var stringLen uint64
+			m.WorkersRequested = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16897,29 +26128,35 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WorkersRequested |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.WorkersAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkersAvailable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16929,82 +26166,27 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumStatus == nil {
-				m.DatumStatus = &DatumStatus{}
-			}
-			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17031,16 +26213,16 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17067,78 +26249,18 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17148,27 +26270,31 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17195,16 +26321,16 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17232,62 +26358,43 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17315,13 +26422,13 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17331,65 +26438,103 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if iNdEx >= l {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17416,16 +26561,16 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17452,65 +26597,35 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jobs = append(m.Jobs, &JobInfo{})
-			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17537,18 +26652,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			m.PipelineVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17558,16 +26673,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17577,33 +26705,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
 			}
-			m.Restart = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17613,16 +26737,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			m.S3Out = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.DataProcessed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17632,16 +26757,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataSkipped = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17651,16 +26793,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
 			}
-			m.DataTotal = 0
+			m.UnclaimedTasks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17670,16 +26825,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				m.UnclaimedTasks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
 			}
-			m.DataFailed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17689,16 +26844,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerRc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
-			m.DataRecovered = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17708,16 +26876,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.Autoscaling = bool(v != 0)
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupAgainst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17727,33 +26896,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DedupAgainst = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReport", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17763,14 +26928,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			m.FailureReport = bool(v != 0)
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17798,13 +26964,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.NodePool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passthrough", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17814,33 +26980,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+			m.Passthrough = bool(v != 0)
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOutput", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17850,33 +27000,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PreviousOutput = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardByKey", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17886,31 +27032,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
-			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+			m.ShardByKey = bool(v != 0)
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumMemoryScaling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17937,69 +27067,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &JobInfo_Details{}
+			if m.DatumMemoryScaling == nil {
+				m.DatumMemoryScaling = &DatumMemoryScaling{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumMemoryScaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineAfter", wireType)
 			}
-			var msglen int
+			m.QuarantineAfter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18009,31 +27088,14 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.QuarantineAfter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18060,18 +27122,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Heartbeat == nil {
+				m.Heartbeat = &Heartbeat{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Heartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobHistoryLimit", wireType)
 			}
-			var msglen int
+			m.JobHistoryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18081,31 +27143,14 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JobHistoryLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18132,18 +27177,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.CrashBackoff == nil {
+				m.CrashBackoff = &CrashBackoff{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrashBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightJobs", wireType)
 			}
-			var msglen int
+			m.MaxInFlightJobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18153,33 +27198,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxInFlightJobs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsInFlight", wireType)
 			}
-			var msglen int
+			m.JobsInFlight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18189,29 +27217,34 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JobsInFlight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoMeta", wireType)
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
+			m.NoMeta = bool(v != 0)
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18238,16 +27271,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.MaintenanceSchedule == nil {
+				m.MaintenanceSchedule = &MaintenanceSchedule{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18274,18 +27307,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.MaintenanceWindow == nil {
+				m.MaintenanceWindow = &MaintenanceWindow{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessGlob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18295,31 +27328,78 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
-			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReprocessGlob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18346,18 +27426,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18367,27 +27447,14 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18414,16 +27481,67 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18450,18 +27568,67 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18471,50 +27638,78 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18541,18 +27736,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18562,29 +27757,17 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18594,24 +27777,12 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18634,7 +27805,7 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18657,49 +27828,17 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18709,11 +27848,12 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18736,7 +27876,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18759,17 +27899,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18779,24 +27919,68 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18819,7 +28003,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18842,10 +28026,10 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18885,27 +28069,8 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18932,18 +28097,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var v int
+			m.History = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18953,17 +28116,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.History |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18973,14 +28135,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Details = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19008,32 +28171,64 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastJobState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Parallelism = 0
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19043,16 +28238,33 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parallelism |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19062,16 +28274,17 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			m.Details = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19081,27 +28294,66 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			m.Transitions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19128,10 +28380,10 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &PipelineInfo_Details{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19157,7 +28409,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19180,15 +28432,15 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19212,19 +28464,102 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19251,18 +28586,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19272,33 +28607,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19308,33 +28626,29 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19344,33 +28658,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var stringLen uint64
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19380,29 +28677,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			m.WorkersRequested = 0
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19412,16 +28696,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersRequested |= int64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			m.WorkersAvailable = 0
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19431,16 +28715,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersAvailable |= int64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var stringLen uint64
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19450,29 +28734,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var msglen int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19482,31 +28753,14 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19533,52 +28787,67 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19605,18 +28874,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19626,27 +28895,31 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19674,13 +28947,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19690,29 +28963,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19722,33 +28999,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+			m.Master = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19758,33 +29019,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+			m.Follow = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
 			}
-			var msglen int
+			m.Tail = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19794,33 +29039,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Tail |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
-			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19830,31 +29058,15 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
+			m.UseLokiBackend = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19881,37 +29093,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.Since == nil {
+				m.Since = &types.Duration{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOnly", wireType)
 			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
-			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19921,31 +29114,66 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.UserOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 26:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19973,11 +29201,11 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20005,33 +29233,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.S3Out = bool(v != 0)
-		case 29:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20041,31 +29249,27 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20093,13 +29297,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 31:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			m.UnclaimedTasks = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20109,16 +29313,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnclaimedTasks |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+			m.Master = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20128,27 +29333,29 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerRc = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20165,61 +29372,10 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Autoscaling = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.User = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20246,65 +29402,16 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20332,7 +29439,7 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20356,7 +29463,7 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20379,15 +29486,15 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20414,38 +29521,18 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
-			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			m.Wait = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20455,12 +29542,24 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20483,7 +29582,7 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20506,17 +29605,17 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20526,12 +29625,28 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20554,7 +29669,7 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20577,10 +29692,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20620,10 +29735,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20633,17 +29748,33 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumFilesetId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20653,12 +29784,24 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumFilesetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20681,7 +29824,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDatumCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20704,10 +29847,10 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDatumCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDatumCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20748,7 +29891,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20775,55 +29918,69 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.History = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.History |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDatumCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.Details = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDatumCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDatumCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20833,24 +29990,62 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectEnterpriseFeaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectEnterpriseFeaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectEnterpriseFeaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20873,7 +30068,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectEnterpriseFeaturesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20896,17 +30091,17 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectEnterpriseFeaturesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectEnterpriseFeaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enterprise", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20916,31 +30111,15 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enterprise = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LokiLogs", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20957,7 +30136,7 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			m.LokiLogs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20980,7 +30159,7 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectClusterLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21003,48 +30182,12 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectClusterLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectClusterLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21067,7 +30210,7 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21090,17 +30233,17 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPipelines", wireType)
 			}
-			var msglen int
+			m.MaxPipelines = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21110,33 +30253,16 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPipelines |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParallelism", wireType)
 			}
-			var stringLen uint64
+			m.MaxParallelism = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21146,24 +30272,11 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxParallelism |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21186,7 +30299,7 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
+func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21209,17 +30322,17 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21229,33 +30342,16 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			m.State = 0
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21265,16 +30361,16 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
 			}
-			var stringLen uint64
+			m.PerWorker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21284,86 +30380,67 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PerWorker |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.DataFailed = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21373,54 +30450,124 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataRecovered = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 11:
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21430,27 +30577,23 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21474,7 +30617,7 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21497,10 +30640,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21541,7 +30684,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21568,18 +30711,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21589,27 +30732,31 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21636,18 +30783,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21657,15 +30804,31 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21682,12 +30845,12 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Follow = bool(v != 0)
+			m.Update = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			m.Tail = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21697,14 +30860,27 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tail |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21721,10 +30897,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.UseLokiBackend = bool(v != 0)
+			m.S3Out = bool(v != 0)
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21751,69 +30927,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &types.Duration{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21823,29 +30948,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21855,29 +30984,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21887,27 +31020,31 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21935,11 +31072,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21956,10 +31093,10 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		case 6:
+			m.Reprocess = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21986,16 +31123,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22005,15 +31144,31 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22040,18 +31195,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22061,78 +31216,31 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22159,16 +31267,16 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22196,62 +31304,30 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22278,69 +31354,18 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22350,33 +31375,29 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22386,84 +31407,29 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Number = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22473,16 +31439,33 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.SizeBytes = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22492,16 +31475,33 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.PerWorker = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22511,67 +31511,49 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerWorker |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Autoscaling = bool(v != 0)
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupAgainst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22581,122 +31563,47 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			m.DedupAgainst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReport", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			m.FailureReport = bool(v != 0)
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22724,64 +31631,33 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.NodePool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passthrough", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Passthrough = bool(v != 0)
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOutput", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22791,31 +31667,47 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			m.PreviousOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardByKey", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.ShardByKey = bool(v != 0)
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumMemoryScaling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22842,18 +31734,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			if m.DatumMemoryScaling == nil {
+				m.DatumMemoryScaling = &DatumMemoryScaling{}
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumMemoryScaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22863,31 +31755,34 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			m.DryRun = bool(v != 0)
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineAfter", wireType)
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QuarantineAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuarantineAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22914,16 +31809,35 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Heartbeat == nil {
+				m.Heartbeat = &Heartbeat{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Heartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobHistoryLimit", wireType)
+			}
+			m.JobHistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobHistoryLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22950,16 +31864,35 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.CrashBackoff == nil {
+				m.CrashBackoff = &CrashBackoff{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrashBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 43:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightJobs", wireType)
+			}
+			m.MaxInFlightJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlightJobs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoMeta", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22976,10 +31909,46 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
-		case 7:
+			m.NoMeta = bool(v != 0)
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaintenanceSchedule == nil {
+				m.MaintenanceSchedule = &MaintenanceSchedule{}
+			}
+			if err := m.MaintenanceSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessGlob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23004,34 +31973,65 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprocessGlob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.S3Out = bool(v != 0)
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23058,18 +32058,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23079,33 +32079,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
-			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+			m.Details = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentJobs", wireType)
 			}
-			var msglen int
+			m.RecentJobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23115,31 +32099,65 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecentJobs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23166,18 +32184,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			m.History = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23187,27 +32205,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.History |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23224,12 +32229,12 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Reprocess = bool(v != 0)
-		case 17:
+			m.Details = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23239,67 +32244,78 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 19:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23326,18 +32342,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23347,33 +32363,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23383,33 +32383,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepRepo", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23419,46 +32403,66 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			m.KeepRepo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePipelinesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 24:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePipelinesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePipelinesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23485,18 +32489,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
-			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pipelines = append(m.Pipelines, &Pipeline{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23506,29 +32508,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepRepo", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23538,27 +32528,66 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			m.KeepRepo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23585,16 +32614,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23621,65 +32650,11 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pipelines = append(m.Pipelines, &Pipeline{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Autoscaling = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23702,7 +32677,7 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23725,10 +32700,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23768,10 +32743,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23781,12 +32756,26 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, &Pipeline{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23809,7 +32798,7 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23832,10 +32821,10 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23875,10 +32864,10 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			m.History = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23888,34 +32877,29 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.History |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Details = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &pfs.Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23943,7 +32927,7 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23967,7 +32951,7 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23990,10 +32974,10 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunCronRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunCronRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24032,11 +33016,62 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReprocessPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReprocessPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReprocessPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24046,17 +33081,33 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24066,17 +33117,29 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeepRepo", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24086,12 +33149,28 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KeepRepo = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24114,7 +33193,7 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleaseQuarantineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24137,10 +33216,10 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseQuarantineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseQuarantineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24179,6 +33258,38 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24201,7 +33312,7 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectWorkerHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24224,10 +33335,10 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectWorkerHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectWorkerHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24288,7 +33399,7 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkerHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24311,17 +33422,17 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24331,31 +33442,27 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24382,42 +33489,12 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &pfs.Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24441,7 +33518,7 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkerHeartbeats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24464,15 +33541,15 @@ func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunCronRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerHeartbeats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunCronRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerHeartbeats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24499,10 +33576,8 @@ func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Heartbeats = append(m.Heartbeats, &WorkerHeartbeat{})
+			if err := m.Heartbeats[len(m.Heartbeats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25110,6 +34185,144 @@ func (m *SecretInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PipelineBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &CreatePipelineRequest{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ActivateAuthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
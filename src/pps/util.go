@@ -161,6 +161,23 @@ func PipelineStateFromName(name string) (PipelineState, error) {
 	return 0, fmt.Errorf(errInvalidPipelineStateName, name)
 }
 
+// JobSetState returns the aggregate state of the jobs in a job set: FAILURE if
+// any job failed or was killed, RUNNING if any job has not yet reached a
+// terminal state, and SUCCESS if every job succeeded. An empty job set is
+// considered successful.
+func JobSetState(jobInfos []*JobInfo) JobState {
+	state := JobState_JOB_SUCCESS
+	for _, ji := range jobInfos {
+		switch {
+		case ji.State == JobState_JOB_FAILURE || ji.State == JobState_JOB_KILLED:
+			return JobState_JOB_FAILURE
+		case !IsTerminal(ji.State):
+			state = JobState_JOB_RUNNING
+		}
+	}
+	return state
+}
+
 // IsTerminal returns 'true' if 'state' indicates that the job is done (i.e.
 // the state will not change later: SUCCESS, FAILURE, KILLED) and 'false'
 // otherwise.
@@ -174,3 +191,48 @@ func IsTerminal(state JobState) bool {
 		panic(fmt.Sprintf("unrecognized job state: %s", state))
 	}
 }
+
+// PipelineReqFromInfo converts a PipelineInfo, which must include its
+// details, into the CreatePipelineRequest that would recreate it.
+func PipelineReqFromInfo(pipelineInfo *PipelineInfo) *CreatePipelineRequest {
+	return &CreatePipelineRequest{
+		Pipeline:              pipelineInfo.Pipeline,
+		Transform:             pipelineInfo.Details.Transform,
+		ParallelismSpec:       pipelineInfo.Details.ParallelismSpec,
+		Egress:                pipelineInfo.Details.Egress,
+		OutputBranch:          pipelineInfo.Details.OutputBranch,
+		ResourceRequests:      pipelineInfo.Details.ResourceRequests,
+		ResourceLimits:        pipelineInfo.Details.ResourceLimits,
+		SidecarResourceLimits: pipelineInfo.Details.SidecarResourceLimits,
+		Input:                 pipelineInfo.Details.Input,
+		Description:           pipelineInfo.Details.Description,
+		Service:               pipelineInfo.Details.Service,
+		DatumSetSpec:          pipelineInfo.Details.DatumSetSpec,
+		DatumTimeout:          pipelineInfo.Details.DatumTimeout,
+		JobTimeout:            pipelineInfo.Details.JobTimeout,
+		Salt:                  pipelineInfo.Details.Salt,
+		PodSpec:               pipelineInfo.Details.PodSpec,
+		PodPatch:              pipelineInfo.Details.PodPatch,
+		Spout:                 pipelineInfo.Details.Spout,
+		SchedulingSpec:        pipelineInfo.Details.SchedulingSpec,
+		DatumTries:            pipelineInfo.Details.DatumTries,
+		S3Out:                 pipelineInfo.Details.S3Out,
+		Metadata:              pipelineInfo.Details.Metadata,
+		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
+		Autoscaling:           pipelineInfo.Details.Autoscaling,
+		DedupAgainst:          pipelineInfo.Details.DedupAgainst,
+		FailureReport:         pipelineInfo.Details.FailureReport,
+		NodePool:              pipelineInfo.Details.NodePool,
+		Passthrough:           pipelineInfo.Details.Passthrough,
+		PreviousOutput:        pipelineInfo.Details.PreviousOutput,
+		ShardByKey:            pipelineInfo.Details.ShardByKey,
+		DatumMemoryScaling:    pipelineInfo.Details.DatumMemoryScaling,
+		QuarantineAfter:       pipelineInfo.Details.QuarantineAfter,
+		Heartbeat:             pipelineInfo.Details.Heartbeat,
+		JobHistoryLimit:       pipelineInfo.Details.JobHistoryLimit,
+		CrashBackoff:          pipelineInfo.Details.CrashBackoff,
+		MaxInFlightJobs:       pipelineInfo.Details.MaxInFlightJobs,
+		NoMeta:                pipelineInfo.Details.NoMeta,
+		MaintenanceSchedule:   pipelineInfo.Details.MaintenanceSchedule,
+	}
+}
@@ -1,18 +1,96 @@
 package server
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/version"
 
+	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
+// featuresTTL is how long InspectCluster reuses the auth and enterprise state
+// it last looked up, as clients call it whenever they connect.
+const featuresTTL = 10 * time.Second
+
 type apiServer struct {
 	log.Logger
+	env         serviceenv.ServiceEnv
 	clusterInfo *admin.ClusterInfo
+
+	mu sync.Mutex
+	// features holds the auth and enterprise state, looked up at featuresTime
+	features     features
+	featuresTime time.Time
+}
+
+type features struct {
+	authActive, enterpriseActive bool
 }
 
+// InspectCluster implements the protobuf admin.InspectCluster RPC. Clients call
+// it whenever they connect, so the cluster's features are reported on a best
+// effort basis rather than failing the RPC.
 func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (*admin.ClusterInfo, error) {
-	return a.clusterInfo, nil
+	clusterInfo := proto.Clone(a.clusterInfo).(*admin.ClusterInfo)
+	f := a.getFeatures(ctx)
+	clusterInfo.AuthActive = f.authActive
+	clusterInfo.EnterpriseActive = f.enterpriseActive
+	clusterInfo.LokiLogging = ppsutil.LokiLogsEnabled(a.env, f.enterpriseActive)
+	return clusterInfo, nil
+}
+
+// getFeatures returns the cluster's auth and enterprise state, looking it up
+// if the cached state is older than featuresTTL. State that can't be looked up
+// is reported as inactive, and isn't cached.
+func (a *apiServer) getFeatures(ctx context.Context) features {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.featuresTime.IsZero() && time.Since(a.featuresTime) < featuresTTL {
+		return a.features
+	}
+	var f features
+	complete := true
+	if authServer := a.env.AuthServer(); authServer != nil {
+		// The caller not being logged in also shows that auth is active, but
+		// other errors (e.g. from the database) say nothing either way
+		_, err := authServer.WhoAmI(ctx, &auth.WhoAmIRequest{})
+		switch {
+		case err == nil, auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+			f.authActive = true
+		case auth.IsErrNotActivated(err):
+			// auth is inactive
+		default:
+			logrus.WithError(err).Error("could not get auth state")
+			complete = false
+		}
+	}
+	if enterpriseServer := a.env.EnterpriseServer(); enterpriseServer != nil {
+		resp, err := enterpriseServer.GetState(ctx, &enterprise.GetStateRequest{})
+		if err != nil {
+			logrus.WithError(err).Error("could not get enterprise state")
+			complete = false
+		} else {
+			f.enterpriseActive = resp.State == enterprise.State_ACTIVE
+		}
+	}
+	if complete {
+		a.features, a.featuresTime = f, time.Now()
+	}
+	return f
+}
+
+// apiVersions returns the versions of the Pachyderm API served by pachd.
+func apiVersions() []string {
+	return []string{fmt.Sprintf("v%d", version.MajorVersion)}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/version"
 )
 
 // APIServer represents and APIServer
@@ -15,9 +16,12 @@ type APIServer interface {
 func NewAPIServer(env serviceenv.ServiceEnv) APIServer {
 	return &apiServer{
 		Logger: log.NewLogger("admin.API", env.Logger()),
+		env:    env,
 		clusterInfo: &admin.ClusterInfo{
 			ID:           env.ClusterID(),
 			DeploymentID: env.Config().DeploymentID,
+			Version:      version.Version,
+			APIVersions:  apiVersions(),
 		},
 	}
 }
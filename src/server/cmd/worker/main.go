@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	debugserver "github.com/pachyderm/pachyderm/v2/src/server/debug/server"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 	"github.com/pachyderm/pachyderm/v2/src/version"
 	"github.com/pachyderm/pachyderm/v2/src/version/versionpb"
@@ -26,6 +27,10 @@ import (
 )
 
 func main() {
+	// When re-executed to run user code under a memory limit, this execs the
+	// user code and doesn't return.
+	driver.RunMemoryLimiter()
+
 	log.SetFormatter(logutil.FormatterFunc(logutil.Pretty))
 
 	// append pachyderm bins to path to allow use of pachctl
@@ -6,6 +6,7 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -22,9 +23,11 @@ import (
 	"time"
 
 	"github.com/docker/go-units"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -41,6 +44,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfspretty "github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
 	ppspretty "github.com/pachyderm/pachyderm/v2/src/server/pps/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/version"
 
 	"github.com/gogo/protobuf/types"
 	globlib "github.com/pachyderm/ohmyglob"
@@ -135,6 +139,20 @@ func TestSimplePipeline(t *testing.T) {
 			require.Equal(t, "foo", buf.String())
 		}
 	}
+
+	// Excluding the system repos leaves only the data and output commits
+	commitInfos, err = c.WaitCommitSetAll(commitInfo.Commit.ID, client.WithoutRepoTypes(pfs.SpecRepoType, pfs.MetaRepoType))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	commitRepos = nil
+	for _, info := range commitInfos {
+		commitRepos = append(commitRepos, info.Commit.Branch.Repo)
+	}
+	require.ElementsEqual(t, []*pfs.Repo{client.NewRepo(dataRepo), client.NewRepo(pipeline)}, commitRepos)
+	commitInfos, err = c.WaitCommitSetAll(commitInfo.Commit.ID, client.WithoutRepoTypes(pfs.SpecRepoType, pfs.MetaRepoType), client.WithoutRepos(client.NewRepo(dataRepo)))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	require.Equal(t, pipeline, commitInfos[0].Commit.Branch.Repo.Name)
 }
 
 func TestRepoSize(t *testing.T) {
@@ -290,6 +308,11 @@ func TestPipelineWithParallelism(t *testing.T) {
 		require.NoError(t, c.GetFile(outputCommit, fmt.Sprintf("file-%d", i), &buf))
 		require.Equal(t, fmt.Sprintf("%d", i), buf.String())
 	}
+
+	// No autoscaling, so the effective parallelism matches the spec
+	parallelism, err := c.InspectPipelineParallelismEffective(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, 4, parallelism)
 }
 
 func TestPipelineWithLargeFiles(t *testing.T) {
@@ -442,8 +465,12 @@ func TestPipelineInputDataModification(t *testing.T) {
 	// replace the contents of 'file' in dataRepo (from "foo" to "bar")
 	commit2, err := c.StartCommit(dataRepo, "master")
 	require.NoError(t, err)
-	require.NoError(t, c.DeleteFile(commit2, "file"))
-	require.NoError(t, c.PutFile(commit2, "file", strings.NewReader("bar"), client.WithAppendPutFile()))
+	require.NoError(t, c.WithModifyFileClient(commit2, func(mf client.ModifyFile) error {
+		if err := mf.DeleteFile("file"); err != nil {
+			return err
+		}
+		return mf.PutFile("file", strings.NewReader("bar"), client.WithAppendPutFile())
+	}))
 	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
 
 	commitInfos, err = c.WaitCommitSetAll(commit2.ID)
@@ -458,8 +485,12 @@ func TestPipelineInputDataModification(t *testing.T) {
 	// Add a file to dataRepo
 	commit3, err := c.StartCommit(dataRepo, "master")
 	require.NoError(t, err)
-	require.NoError(t, c.DeleteFile(commit3, "file"))
-	require.NoError(t, c.PutFile(commit3, "file2", strings.NewReader("foo"), client.WithAppendPutFile()))
+	require.NoError(t, c.WithModifyFileClient(commit3, func(mf client.ModifyFile) error {
+		if err := mf.DeleteFile("file"); err != nil {
+			return err
+		}
+		return mf.PutFile("file2", strings.NewReader("foo"), client.WithAppendPutFile())
+	}))
 	require.NoError(t, c.FinishCommit(dataRepo, commit3.Branch.Name, commit3.ID))
 
 	commitInfos, err = c.WaitCommitSetAll(commit3.ID)
@@ -1896,6 +1927,14 @@ func TestWaitJobSetFailures(t *testing.T) {
 				}
 			}
 		}
+		jobSetInfo, err := c.InspectJobSetInfo(commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(jobSetInfo.Jobs))
+		if i == 0 {
+			require.Equal(t, pps.JobState_JOB_SUCCESS.String(), jobSetInfo.State.String())
+		} else {
+			require.Equal(t, pps.JobState_JOB_FAILURE.String(), jobSetInfo.State.String())
+		}
 	}
 }
 
@@ -2295,6 +2334,11 @@ func TestPrettyPrinting(t *testing.T) {
 	require.NoError(t, pfspretty.PrintDetailedRepoInfo(pfspretty.NewPrintableRepoInfo(repoInfo)))
 	for _, c := range commitInfos {
 		require.NoError(t, pfspretty.PrintDetailedCommitInfo(os.Stdout, pfspretty.NewPrintableCommitInfo(c)))
+		commitJSON, err := pfspretty.MarshalJSONCommitInfo(pfspretty.NewPrintableCommitInfo(c))
+		require.NoError(t, err)
+		var parsedCommit map[string]interface{}
+		require.NoError(t, json.Unmarshal(commitJSON, &parsedCommit))
+		require.Equal(t, c.Commit.Branch.Name, parsedCommit["original_branch"])
 	}
 
 	fileInfo, err := c.InspectFile(commit, "file")
@@ -2303,10 +2347,25 @@ func TestPrettyPrinting(t *testing.T) {
 	pipelineInfo, err := c.InspectPipeline(pipelineName, true)
 	require.NoError(t, err)
 	require.NoError(t, ppspretty.PrintDetailedPipelineInfo(os.Stdout, ppspretty.NewPrintablePipelineInfo(pipelineInfo)))
+	pipelineJSON, err := ppspretty.MarshalJSONPipelineInfo(ppspretty.NewPrintablePipelineInfo(pipelineInfo))
+	require.NoError(t, err)
+	var parsedPipeline map[string]interface{}
+	require.NoError(t, json.Unmarshal(pipelineJSON, &parsedPipeline))
+	require.Equal(t, pipelineName, parsedPipeline["name"])
+	// proto fields are rendered as with --raw
+	pfsInput := parsedPipeline["input"].(map[string]interface{})["pfs"].(map[string]interface{})
+	require.Equal(t, dataRepo, pfsInput["repo"])
+	require.Equal(t, "/*", pfsInput["glob"])
 	jobInfos, err := c.ListJob("", nil, -1, true)
 	require.NoError(t, err)
 	require.True(t, len(jobInfos) > 0)
 	require.NoError(t, ppspretty.PrintDetailedJobInfo(os.Stdout, ppspretty.NewPrintableJobInfo(jobInfos[0])))
+	jobJSON, err := ppspretty.MarshalJSONJobInfo(ppspretty.NewPrintableJobInfo(jobInfos[0]))
+	require.NoError(t, err)
+	var parsedJob map[string]interface{}
+	require.NoError(t, json.Unmarshal(jobJSON, &parsedJob))
+	require.Equal(t, jobInfos[0].Job.ID, parsedJob["id"])
+	require.Equal(t, "success", parsedJob["state"])
 }
 
 func TestDeleteAll(t *testing.T) {
@@ -3062,6 +3121,168 @@ func TestStopPipeline(t *testing.T) {
 	require.Equal(t, "foo\n", buffer.String())
 }
 
+func TestStopStartPipelines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestStopStartPipelines_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	var pipelines []string
+	for i := 0; i < 2; i++ {
+		pipelineName := tu.UniqueString("pipeline")
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+			nil,
+			client.NewPFSInput(dataRepo, "/*"),
+			"",
+			false,
+		))
+		pipelines = append(pipelines, pipelineName)
+	}
+	requireStopped := func(stopped bool) {
+		for _, pipelineName := range pipelines {
+			pipelineInfo, err := c.InspectPipeline(pipelineName, false)
+			require.NoError(t, err)
+			require.Equal(t, stopped, pipelineInfo.Stopped)
+		}
+	}
+
+	// Stop both pipelines together, they should both be paused
+	require.NoError(t, c.StopPipelines(pipelines, true))
+	requireStopped(true)
+	for _, pipelineName := range pipelines {
+		require.NoErrorWithinTRetry(t, 60*time.Second, func() error {
+			pipelineInfo, err := c.InspectPipeline(pipelineName, false)
+			if err != nil {
+				return err
+			}
+			if pipelineInfo.State != pps.PipelineState_PIPELINE_PAUSED {
+				return errors.Errorf("expected %q to be in PAUSED, but was in %s", pipelineName, pipelineInfo.State)
+			}
+			return nil
+		})
+	}
+
+	// An atomic start that includes a missing pipeline starts nothing
+	missing := tu.UniqueString("missing")
+	require.YesError(t, c.StartPipelines(append(pipelines, missing), true))
+	requireStopped(true)
+
+	// A non-atomic start reports the missing pipeline, but starts the others
+	err := c.StartPipelines(append(pipelines, missing), false)
+	require.YesError(t, err)
+	require.Matches(t, missing, err.Error())
+	require.Matches(t, "1 of 3", err.Error())
+	requireStopped(false)
+
+	// The restarted pipelines process new data
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	commitInfos, err := c.WaitCommitSetAll(commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, 7, len(commitInfos))
+}
+
+func TestMaxConcurrentJobs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestMaxConcurrentJobs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	// Several pipelines fan out from the same input, and each job records
+	// when its user code ran
+	var pipelines []string
+	for i := 0; i < 4; i++ {
+		pipelineName := tu.UniqueString("TestMaxConcurrentJobs")
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{
+				"date +%s.%N > /pfs/out/start",
+				"sleep 10",
+				"date +%s.%N > /pfs/out/end",
+			},
+			nil,
+			client.NewPFSInput(dataRepo, "/"),
+			"",
+			false,
+		))
+		pipelines = append(pipelines, pipelineName)
+	}
+
+	// The limit is passed to the workers by pachd
+	pipelineInfo, err := c.InspectPipeline(pipelines[0], false)
+	require.NoError(t, err)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	var limit int
+	require.NoError(t, backoff.Retry(func() error {
+		rc, err := tu.GetKubeClient(t).CoreV1().ReplicationControllers(v1.NamespaceDefault).Get(rcName, metav1.GetOptions{})
+		if err != nil {
+			return err // retry
+		}
+		for _, env := range rc.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == "MAX_CONCURRENT_JOBS" {
+				limit, err = strconv.Atoi(env.Value)
+				return err
+			}
+		}
+		return errors.Errorf("MAX_CONCURRENT_JOBS is not set on %s", rcName)
+	}, backoff.NewTestingBackOff()))
+	if limit <= 0 || limit >= len(pipelines) {
+		t.Skipf("pachd must be deployed with MAX_CONCURRENT_JOBS between 1 and %d", len(pipelines)-1)
+	}
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	_, err = c.WaitCommitSetAll(commit.ID)
+	require.NoError(t, err)
+
+	// Each start and end is an event, and no more than 'limit' jobs may be
+	// running between them
+	type event struct {
+		time  float64
+		delta int
+	}
+	var events []event
+	for _, pipelineName := range pipelines {
+		for file, delta := range map[string]int{"start": 1, "end": -1} {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(client.NewCommit(pipelineName, "master", commit.ID), file, &buf))
+			tm, err := strconv.ParseFloat(strings.TrimSpace(buf.String()), 64)
+			require.NoError(t, err)
+			events = append(events, event{time: tm, delta: delta})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].time == events[j].time {
+			return events[i].delta < events[j].delta
+		}
+		return events[i].time < events[j].time
+	})
+	var running, maxRunning int
+	for _, e := range events {
+		running += e.delta
+		if running > maxRunning {
+			maxRunning = running
+		}
+	}
+	require.True(t, maxRunning <= limit, "%d jobs ran at once, but the limit is %d", maxRunning, limit)
+}
+
 func TestAutoscalingStandby(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -3584,6 +3805,24 @@ func TestPipelineThatSymlinks(t *testing.T) {
 	require.NoError(t, c.GetFile(outputCommit, "dir3/dir4/foobar", &buffer))
 	require.Equal(t, "foobar\n", buffer.String())
 
+	// Symlinks are uploaded as the files and directories they point to, so
+	// walking the output visits their contents, in lexical order
+	var paths []string
+	require.NoError(t, c.WalkFile(outputCommit, "/", func(fi *pfs.FileInfo) error {
+		paths = append(paths, fi.File.Path)
+		return nil
+	}))
+	require.Equal(t, []string{"/", "/bar", "/buzz", "/dir/", "/dir/dir2/", "/dir/dir2/foo", "/dir3/", "/dir3/dir4/", "/dir3/dir4/foobar", "/foo"}, paths)
+	paths = nil
+	require.NoError(t, c.WalkFile(outputCommit, "/dir", func(fi *pfs.FileInfo) error {
+		paths = append(paths, fi.File.Path)
+		if fi.FileType == pfs.FileType_FILE {
+			return errutil.ErrBreak
+		}
+		return nil
+	}))
+	require.Equal(t, []string{"/dir/", "/dir/dir2/", "/dir/dir2/foo"}, paths)
+
 	// create pipeline with empty files
 	pipelineName = tu.UniqueString("pipeline")
 	input := client.NewPFSInput(dataRepo, "/")
@@ -3995,6 +4234,20 @@ func TestGetLogs(t *testing.T) {
 			return err
 		}
 
+		// Get only user logs from pipeline, the server should drop the rest
+		iter = c.GetUserLogs(pipelineName, "", nil, "", false, 0)
+		var numUserLogs int
+		for iter.Next() {
+			require.True(t, iter.Message().User)
+			numUserLogs++
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		if numUserLogs < numLogs {
+			return errors.Errorf("got %d user log lines, expected at least %d", numUserLogs, numLogs)
+		}
+
 		// Get logs from pipeline, using job
 		// (1) Get job ID, from pipeline that just ran
 		jobInfos, err := c.ListJob(pipelineName, nil, -1, true)
@@ -4246,6 +4499,63 @@ func TestLokiLogs(t *testing.T) {
 	require.Equal(t, numFiles, foundFoos, "didn't receive enough log lines containing foo")
 }
 
+func TestLokiLogsRequireEnterprise(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	_, err := c.Enterprise.Deactivate(c.Ctx(), &enterprise.DeactivateRequest{})
+	require.NoError(t, err)
+
+	features, err := c.InspectEnterpriseFeatures()
+	require.NoError(t, err)
+	require.False(t, features.Enterprise)
+	require.False(t, features.LokiLogs)
+	iter := c.GetLogsLoki("", "", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.True(t, errors.Is(iter.Err(), client.ErrLokiLogsNotEnabled))
+
+	tu.ActivateEnterprise(t, c)
+	features, err = c.InspectEnterpriseFeatures()
+	require.NoError(t, err)
+	require.True(t, features.Enterprise)
+	require.True(t, features.LokiLogs)
+	iter = c.GetLogsLoki("", "", nil, "", false, false, 0)
+	for iter.Next() {
+	}
+	require.NoError(t, iter.Err())
+}
+
+func TestInspectClusterLimits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	_, err := c.Enterprise.Deactivate(c.Ctx(), &enterprise.DeactivateRequest{})
+	require.NoError(t, err)
+
+	limits, err := c.InspectClusterLimits()
+	require.NoError(t, err)
+	require.True(t, limits.MaxPipelines > 0)
+	require.True(t, limits.MaxParallelism > 0)
+
+	dataRepo := tu.UniqueString("TestInspectClusterLimits_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	req := basicPipelineReq(tu.UniqueString("TestInspectClusterLimits"), dataRepo)
+	req.ParallelismSpec = &pps.ParallelismSpec{Constant: uint64(limits.MaxParallelism) + 1}
+	_, err = c.PpsAPIClient.CreatePipeline(c.Ctx(), req)
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("parallelism more than %d", limits.MaxParallelism), err.Error())
+
+	tu.ActivateEnterprise(t, c)
+	limits, err = c.InspectClusterLimits()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), limits.MaxPipelines)
+	require.Equal(t, int64(0), limits.MaxParallelism)
+}
+
 func TestAllDatumsAreProcessed(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -4361,6 +4671,8 @@ func TestDatumStatusRestart(t *testing.T) {
 				require.NoError(t, err)
 				require.True(t, datumStarted.Before(_datumStarted))
 				datumStarted = _datumStarted
+				// The datum being processed is the only one in the worker's queue
+				require.Equal(t, int64(1), workerStatus.QueueSize)
 				return nil
 			}
 			return errors.Errorf("worker status from wrong job")
@@ -4509,6 +4821,71 @@ func TestPipelineResourceRequest(t *testing.T) {
 	require.Equal(t, "10M", disk.String())
 }
 
+func TestPipelineMetadata(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelineMetadata")
+	pipelineName := tu.UniqueString("TestPipelineMetadata_Pipeline")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &pps.Transform{
+				Cmd: []string{"cp", path.Join("/pfs", dataRepo, "file"), "/pfs/out/file"},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			Metadata: &pps.Metadata{
+				Annotations: map[string]string{"cost-center": "42"},
+				// "app" is set by pachyderm, and must not be overwritten
+				Labels: map[string]string{"team": "data", "app": "mine"},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+		})
+	require.NoError(t, err)
+
+	pipelineInfo, err := c.InspectPipeline(pipelineName, false)
+	require.NoError(t, err)
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	checkMeta := func(meta metav1.ObjectMeta) {
+		require.Equal(t, "data", meta.Labels["team"])
+		require.Equal(t, rcName, meta.Labels["app"])
+		require.Equal(t, "42", meta.Annotations["cost-center"])
+	}
+	kubeClient := tu.GetKubeClient(t)
+	require.NoError(t, backoff.Retry(func() error {
+		rc, err := kubeClient.CoreV1().ReplicationControllers(v1.NamespaceDefault).Get(rcName, metav1.GetOptions{})
+		if err != nil {
+			return err // retry
+		}
+		checkMeta(rc.ObjectMeta)
+		checkMeta(rc.Spec.Template.ObjectMeta)
+		return nil
+	}, backoff.NewTestingBackOff()))
+	require.NoError(t, backoff.Retry(func() error {
+		podList, err := kubeClient.CoreV1().Pods(v1.NamespaceDefault).List(
+			metav1.ListOptions{
+				LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+					map[string]string{"app": rcName, "team": "data"},
+				)),
+			})
+		if err != nil {
+			return err // retry
+		}
+		if len(podList.Items) != 1 {
+			return errors.Errorf("could not find single pod for pipeline %s", pipelineInfo.Pipeline.Name)
+		}
+		checkMeta(podList.Items[0].ObjectMeta)
+		return nil
+	}, backoff.NewTestingBackOff()))
+}
+
 func TestPipelineResourceLimit(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -5453,6 +5830,57 @@ func TestUnionInput(t *testing.T) {
 		require.Equal(t, 8, len(fileInfos))
 	})
 
+	t.Run("union dedup", func(t *testing.T) {
+		input := client.NewDedupUnionInput(
+			client.NewPFSInput(repos[0], "/*"),
+			client.NewPFSInput(repos[1], "/*"),
+		)
+		// Each repo has the same paths, so only the first repo's datums remain
+		dis, err := c.ListDatumInputAll(input)
+		require.NoError(t, err)
+		require.Equal(t, numFiles, len(dis))
+		for _, di := range dis {
+			require.Equal(t, repos[0], di.Data[0].File.Commit.Branch.Repo.Name)
+		}
+
+		pipeline := tu.UniqueString("pipeline")
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{
+				"cp /pfs/*/* /pfs/out",
+			},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			input,
+			"",
+			false,
+		))
+		commitInfo, err := c.WaitCommit(pipeline, "master", "")
+		require.NoError(t, err)
+		jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, true)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		require.True(t, jobInfo.Details.Input.Dedup)
+		require.Equal(t, int64(numFiles), jobInfo.DataProcessed)
+
+		// dedup is only valid on unions
+		dedupCross := client.NewCrossInput(client.NewPFSInput(repos[0], "/*"), client.NewPFSInput(repos[1], "/*"))
+		dedupCross.Dedup = true
+		require.YesError(t, c.CreatePipeline(
+			tu.UniqueString("pipeline"),
+			"",
+			[]string{"bash"},
+			[]string{"true"},
+			nil,
+			dedupCross,
+			"",
+			false,
+		))
+	})
+
 	t.Run("union crosses", func(t *testing.T) {
 		pipeline := tu.UniqueString("pipeline")
 		require.NoError(t, c.CreatePipeline(
@@ -6843,6 +7271,16 @@ func TestLongDatums(t *testing.T) {
 		require.NoError(t, c.GetFile(outputCommit, fmt.Sprintf("file%d", i), &buf))
 		require.Equal(t, "foo", buf.String())
 	}
+
+	distribution, err := c.InspectJobDatumDistribution(pipeline, commit1.ID)
+	require.NoError(t, err)
+	var datums, dataBytes int64
+	for _, d := range distribution {
+		datums += d.Datums
+		dataBytes += d.Bytes
+	}
+	require.Equal(t, int64(numFiles), datums)
+	require.Equal(t, int64(numFiles*len("foo")), dataBytes)
 }
 
 func TestPipelineWithDatumTimeout(t *testing.T) {
@@ -7098,7 +7536,8 @@ func TestPipelineWithJobTimeout(t *testing.T) {
 	// Block on the job being complete before we call ListDatum
 	jobInfo, err := c.WaitJob(jobs[0].Job.Pipeline.Name, jobs[0].Job.ID, false)
 	require.NoError(t, err)
-	require.Equal(t, pps.JobState_JOB_KILLED.String(), jobInfo.State.String())
+	require.Equal(t, pps.JobState_JOB_FAILURE.String(), jobInfo.State.String())
+	require.True(t, strings.Contains(jobInfo.Reason, "timeout"))
 	started, err := types.TimestampFromProto(jobInfo.Started)
 	require.NoError(t, err)
 	finished, err := types.TimestampFromProto(jobInfo.Finished)
@@ -9705,7 +10144,17 @@ func TestPipelineAncestry(t *testing.T) {
 		info, err := c.InspectPipeline(fmt.Sprintf("%s^%d", pipeline, 3-i), true)
 		require.NoError(t, err)
 		require.Equal(t, fmt.Sprintf("user:%d", i), info.Details.Transform.User)
+
+		info, err = c.InspectPipelineVersion(pipeline, uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), info.Version)
+		require.Equal(t, fmt.Sprintf("user:%d", i), info.Details.Transform.User)
 	}
+	_, err := c.InspectPipelineVersion(pipeline, 4)
+	require.YesError(t, err)
+	require.Matches(t, "only 3 versions", err.Error())
+	_, err = c.InspectPipelineVersion(pipeline, 0)
+	require.YesError(t, err)
 
 	infos, err := c.ListPipeline(true)
 	require.NoError(t, err)
@@ -9859,3 +10308,3519 @@ func TestLoad(t *testing.T) {
 	require.NoError(t, cmdutil.Encoder("", buf).EncodeProto(resp))
 	require.Equal(t, "", resp.Error, buf.String())
 }
+
+func TestStdinFromInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestStdinFromInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "input", strings.NewReader("foo\nbar\n")))
+	require.NoError(t, c.PutFile(commit, "other", strings.NewReader("baz\n")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestStdinFromInput")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:            []string{"sh", "-c", "cat > /pfs/out/stdin"},
+				StdinFromInput: fmt.Sprintf("$%s/input", dataRepo),
+			},
+			Input: client.NewPFSInput(dataRepo, "/"),
+		},
+	)
+	require.NoError(t, err)
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "stdin", &buf))
+	require.Equal(t, "foo\nbar\n", buf.String())
+
+	// stdin and stdin_from_input are mutually exclusive
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(tu.UniqueString("TestStdinFromInput_invalid")),
+			Transform: &pps.Transform{
+				Cmd:            []string{"sh"},
+				Stdin:          []string{"cat > /pfs/out/stdin"},
+				StdinFromInput: fmt.Sprintf("$%s/input", dataRepo),
+			},
+			Input: client.NewPFSInput(dataRepo, "/"),
+		},
+	)
+	require.YesError(t, err)
+}
+
+func TestDedupAgainst(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDedupAgainst_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("TestDedupAgainst")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{"echo static > /pfs/out/static"},
+			},
+			Input:        client.NewPFSInput(dataRepo, "/"),
+			DedupAgainst: "master",
+		},
+	)
+	require.NoError(t, err)
+
+	var outputCommits []*pfs.Commit
+	for i := 0; i < 2; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+		outputCommits = append(outputCommits, client.NewCommit(pipeline, "master", commit.ID))
+	}
+
+	// The second job reprocesses the datum, but its output is unchanged so
+	// nothing is uploaded
+	jobInfo, err := c.InspectJob(pipeline, outputCommits[1].ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.Stats.UploadBytes)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(outputCommits[1], "static", &buf))
+	require.Equal(t, "static\n", buf.String())
+
+	newFiles, oldFiles, err := c.DiffFileAll(outputCommits[1], "", outputCommits[0], "", false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(newFiles))
+	require.Equal(t, 0, len(oldFiles))
+}
+
+func TestGetDatumCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	repo1 := tu.UniqueString("TestGetDatumCount1")
+	repo2 := tu.UniqueString("TestGetDatumCount2")
+	require.NoError(t, c.CreateRepo(repo1))
+	require.NoError(t, c.CreateRepo(repo2))
+
+	numFiles := 5
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(repo1, "master", ""), fmt.Sprintf("file-%d", i), strings.NewReader("foo"), client.WithAppendPutFile()))
+		require.NoError(t, c.PutFile(client.NewCommit(repo2, "master", ""), fmt.Sprintf("file-%d", i), strings.NewReader("foo"), client.WithAppendPutFile()))
+	}
+
+	input := client.NewCrossInput(
+		client.NewPFSInput(repo1, "/*"),
+		client.NewPFSInput(repo2, "/*"),
+	)
+	count, err := c.GetDatumCount("", input)
+	require.NoError(t, err)
+	require.Equal(t, int64(numFiles*numFiles), count)
+
+	pipeline := tu.UniqueString("TestGetDatumCount")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"true"},
+			},
+			Input: input,
+		},
+	)
+	require.NoError(t, err)
+
+	// The count for the pipeline should match the datums processed by its job
+	count, err = c.GetDatumCount(pipeline, nil)
+	require.NoError(t, err)
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+	dis, err := c.ListDatumAll(pipeline, commitInfo.Commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(dis)), count)
+}
+
+func TestFailureReport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestFailureReport_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "good", strings.NewReader("foo\n")))
+	require.NoError(t, c.PutFile(commit, "bad1", strings.NewReader("foo\n")))
+	require.NoError(t, c.PutFile(commit, "bad2", strings.NewReader("foo\n")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestFailureReport")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if ls /pfs/%s/bad*; then exit 1; fi", dataRepo),
+				},
+			},
+			Input:         client.NewPFSInput(dataRepo, "/*"),
+			FailureReport: true,
+		},
+	)
+	require.NoError(t, err)
+
+	jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+
+	var buf bytes.Buffer
+	reportCommit := client.NewCommit(pipeline, client.FailureReportBranch, "")
+	require.NoError(t, c.GetFile(reportCommit, commit.ID+".json", &buf))
+	report := &pps.FailureReport{}
+	require.NoError(t, jsonpb.Unmarshal(&buf, report))
+	require.Equal(t, jobInfo.Job.ID, report.Job.ID)
+	require.Equal(t, jobInfo.Reason, report.Reason)
+	require.Equal(t, 2, len(report.FailedDatums))
+	var failedFiles []string
+	for _, fd := range report.FailedDatums {
+		require.Equal(t, 1, len(fd.Data))
+		failedFiles = append(failedFiles, fd.Data[0].File.Path)
+	}
+	require.ElementsEqual(t, []string{"/bad1", "/bad2"}, failedFiles)
+}
+
+func TestTransactCommits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	var repos []string
+	var inputs []*pps.Input
+	for i := 0; i < 3; i++ {
+		repo := tu.UniqueString(fmt.Sprintf("TestTransactCommits_data%d", i))
+		require.NoError(t, c.CreateRepo(repo))
+		repos = append(repos, repo)
+		inputs = append(inputs, client.NewPFSInput(repo, "/*"))
+	}
+
+	pipeline := tu.UniqueString("TestTransactCommits")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/* /pfs/%s/* /pfs/%s/* > /pfs/out/file", repos[0], repos[1], repos[2]),
+		},
+		nil,
+		client.NewCrossInput(inputs...),
+		"",
+		false,
+	))
+
+	id, err := c.TransactCommits(func(cb *client.CommitsBuilder) error {
+		for _, repo := range repos {
+			cb.PutFile(repo, "master", "file", strings.NewReader(repo+"\n"))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	commitInfos, err := c.WaitCommitSetAll(id)
+	require.NoError(t, err)
+	// the three input commits, the output commit and the meta commit
+	require.Equal(t, 5, len(commitInfos))
+	for _, repo := range repos {
+		commitInfo, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, id, commitInfo.Commit.ID)
+	}
+
+	// the pipeline should process all three input commits in a single job
+	jobInfos, err := c.ListJob(pipeline, nil, -1, true)
+	require.NoError(t, err)
+	var triggered []*pps.JobInfo
+	for _, jobInfo := range jobInfos {
+		if jobInfo.Job.ID == id {
+			triggered = append(triggered, jobInfo)
+		}
+	}
+	require.Equal(t, 1, len(triggered))
+	require.Equal(t, pps.JobState_JOB_SUCCESS, triggered[0].State)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", id), "file", &buf))
+	require.Equal(t, fmt.Sprintf("%s\n%s\n%s\n", repos[0], repos[1], repos[2]), buf.String())
+}
+
+func TestPassthrough(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPassthrough_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	numFiles := 5
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("dir/file%d", i), strings.NewReader(fmt.Sprintf("foo%d\n", i))))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestPassthrough")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					// only transform file0, the other files are passed through
+					fmt.Sprintf("if [ -f /pfs/%s/dir/file0 ]; then mkdir -p /pfs/out/dir; echo bar > /pfs/out/dir/file0; fi", dataRepo),
+				},
+			},
+			Input:       client.NewPFSInput(dataRepo, "/dir/*"),
+			Passthrough: true,
+		},
+	)
+	require.NoError(t, err)
+
+	commitInfo, err := c.WaitCommit(pipeline, "master", commit.ID)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "dir/file0", &buf))
+	require.Equal(t, "bar\n", buf.String())
+	for i := 1; i < numFiles; i++ {
+		buf.Reset()
+		require.NoError(t, c.GetFile(commitInfo.Commit, fmt.Sprintf("dir/file%d", i), &buf))
+		require.Equal(t, fmt.Sprintf("foo%d\n", i), buf.String())
+	}
+	fileInfos, err := c.ListFileAll(commitInfo.Commit, "dir")
+	require.NoError(t, err)
+	require.Equal(t, numFiles, len(fileInfos))
+}
+
+func TestListJobsFiltered(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestListJobsFiltered_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	goodPipeline := tu.UniqueString("TestListJobsFiltered_good")
+	require.NoError(t, c.CreatePipeline(
+		goodPipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	badPipeline := tu.UniqueString("TestListJobsFiltered_bad")
+	require.NoError(t, c.CreatePipeline(
+		badPipeline,
+		"",
+		[]string{"bash"},
+		[]string{"exit 1"},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	// jobs from before the window shouldn't be returned
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "file1", strings.NewReader("foo")))
+	commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	time.Sleep(time.Second)
+	since := time.Now()
+	var failedIDs []string
+	for i := 0; i < 2; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), fmt.Sprintf("file%d", i+2), strings.NewReader("foo")))
+		commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+		require.NoError(t, err)
+		_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+		require.NoError(t, err)
+		failedIDs = append([]string{commitInfo.Commit.ID}, failedIDs...)
+	}
+
+	jobInfos, cursor, err := c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, time.Time{}, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, "", cursor)
+	require.Equal(t, 2, len(jobInfos))
+	for i, jobInfo := range jobInfos {
+		require.Equal(t, badPipeline, jobInfo.Job.Pipeline.Name)
+		require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+		require.Equal(t, failedIDs[i], jobInfo.Job.ID)
+	}
+
+	// page through the same jobs one at a time
+	jobInfos, cursor, err = c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, time.Time{}, 1, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, failedIDs[0], jobInfos[0].Job.ID)
+	require.NotEqual(t, "", cursor)
+	jobInfos, cursor, err = c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, time.Time{}, 1, cursor)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, failedIDs[1], jobInfos[0].Job.ID)
+	require.Equal(t, "", cursor)
+
+	// paging continues from a cursor whose job has since been deleted
+	jobInfos, cursor, err = c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, time.Time{}, 1, "")
+	require.NoError(t, err)
+	require.Equal(t, failedIDs[0], jobInfos[0].Job.ID)
+	require.NoError(t, c.DeleteJob(badPipeline, failedIDs[0]))
+	jobInfos, _, err = c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, time.Time{}, 1, cursor)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, failedIDs[1], jobInfos[0].Job.ID)
+	_, _, err = c.ListJobsFiltered(nil, time.Time{}, time.Time{}, 1, "not-a-cursor")
+	require.YesError(t, err)
+
+	// a window that ends before the failures contains none of them
+	jobInfos, _, err = c.ListJobsFiltered([]pps.JobState{pps.JobState_JOB_FAILURE}, since, since, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobInfos))
+}
+
+func TestPreviousOutput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPreviousOutput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("TestPreviousOutput")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"total=$(cat /pfs/previous/total 2>/dev/null || echo 0)",
+					fmt.Sprintf("echo $((total + $(cat /pfs/%s/value))) > /pfs/out/total", dataRepo),
+				},
+			},
+			Input:          client.NewPFSInput(dataRepo, "/"),
+			PreviousOutput: "previous",
+		},
+	)
+	require.NoError(t, err)
+
+	total := 0
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "value", strings.NewReader(fmt.Sprintf("%d\n", i))))
+		commitInfo, err := c.WaitCommit(pipeline, "master", "")
+		require.NoError(t, err)
+		total += i
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(commitInfo.Commit, "total", &buf))
+		require.Equal(t, fmt.Sprintf("%d\n", total), buf.String())
+	}
+
+	// the previous output can't shadow an input
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline:       client.NewPipeline(tu.UniqueString("TestPreviousOutput_invalid")),
+			Transform:      &pps.Transform{Cmd: []string{"true"}},
+			Input:          client.NewPFSInput(dataRepo, "/"),
+			PreviousOutput: dataRepo,
+		},
+	)
+	require.YesError(t, err)
+}
+
+func TestShardByKey(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestShardByKey_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	keyRepo := tu.UniqueString("TestShardByKey_keys")
+	require.NoError(t, c.CreateRepo(keyRepo))
+	numKeys, filesPerKey := 4, 4
+	for k := 0; k < numKeys; k++ {
+		require.NoError(t, c.PutFile(client.NewCommit(keyRepo, "master", ""), fmt.Sprintf("key%d", k), strings.NewReader("")))
+		for i := 0; i < filesPerKey; i++ {
+			require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), fmt.Sprintf("key%d-%d", k, i), strings.NewReader(fmt.Sprintf("%d-%d\n", k, i))))
+		}
+	}
+
+	// The name of each output file depends on the order in which the datums
+	// with the same key are processed by a worker.
+	var outputs []map[string]string
+	for run := 0; run < 2; run++ {
+		pipeline := tu.UniqueString("TestShardByKey")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd: []string{"bash"},
+					Stdin: []string{
+						fmt.Sprintf("key=$(ls /pfs/%s)", keyRepo),
+						"counter=/tmp/$PACH_JOB_ID-$key",
+						"n=$(( $(cat $counter 2>/dev/null || echo 0) + 1 ))",
+						"echo $n > $counter",
+						fmt.Sprintf("cat /pfs/%s/* > /pfs/out/$key-$n", dataRepo),
+					},
+				},
+				ParallelismSpec: &pps.ParallelismSpec{Constant: 4},
+				DatumSetSpec:    &pps.DatumSetSpec{Number: 1},
+				Input: client.NewJoinInput(
+					client.NewPFSInputOpts("", dataRepo, "", "/(key?)-?", "$1", "", false, false, nil),
+					client.NewPFSInputOpts("", keyRepo, "", "/(key?)", "$1", "", false, false, nil),
+				),
+				ShardByKey: true,
+			},
+		)
+		require.NoError(t, err)
+		commitInfo, err := c.WaitCommit(pipeline, "master", "")
+		require.NoError(t, err)
+
+		output := make(map[string]string)
+		fileInfos, err := c.ListFileAll(commitInfo.Commit, "/")
+		require.NoError(t, err)
+		for _, fi := range fileInfos {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(commitInfo.Commit, fi.File.Path, &buf))
+			output[fi.File.Path] = buf.String()
+		}
+		require.Equal(t, numKeys*filesPerKey, len(output))
+		outputs = append(outputs, output)
+
+		// datums with the same key are processed by the same worker
+		dis, err := c.ListDatumAll(pipeline, commitInfo.Commit.ID)
+		require.NoError(t, err)
+		workers := make(map[string]string)
+		for _, di := range dis {
+			key := path.Base(di.Data[1].File.Path)
+			if worker, ok := workers[key]; ok {
+				require.Equal(t, worker, di.WorkerID)
+			}
+			workers[key] = di.WorkerID
+		}
+	}
+	require.Equal(t, outputs[0], outputs[1])
+
+	// shard_by_key requires a keyed input
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline:   client.NewPipeline(tu.UniqueString("TestShardByKey_invalid")),
+			Transform:  &pps.Transform{Cmd: []string{"true"}},
+			Input:      client.NewPFSInput(dataRepo, "/*"),
+			ShardByKey: true,
+		},
+	)
+	require.YesError(t, err)
+}
+
+func TestListJobF(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestListJobF_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestListJobF")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+		require.NoError(t, err)
+		_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+		require.NoError(t, err)
+	}
+
+	jobInfos, err := c.ListJob(pipeline, nil, 0, false)
+	require.NoError(t, err)
+	var streamed []*pps.JobInfo
+	require.NoError(t, c.ListJobF(pipeline, nil, 0, false, func(ji *pps.JobInfo) error {
+		streamed = append(streamed, ji)
+		return nil
+	}))
+	require.Equal(t, len(jobInfos), len(streamed))
+	for i := range jobInfos {
+		require.Equal(t, jobInfos[i].Job.ID, streamed[i].Job.ID)
+	}
+
+	// ErrBreak stops the iteration early without an error
+	var count int
+	require.NoError(t, c.ListJobF(pipeline, nil, 0, false, func(ji *pps.JobInfo) error {
+		count++
+		if count == 2 {
+			return errutil.ErrBreak
+		}
+		return nil
+	}))
+	require.Equal(t, 2, count)
+}
+
+func TestLogsCarryDatumID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestLogsCarryDatumID_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	numFiles := 3
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestLogsCarryDatumID")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			// the user code doesn't log anything identifying the datum
+			fmt.Sprintf("echo processing $(ls /pfs/%s)", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	commitInfo, err := c.WaitCommit(pipeline, "master", commit.ID)
+	require.NoError(t, err)
+
+	datumIDs := make(map[string]string)
+	dis, err := c.ListDatumAll(pipeline, commitInfo.Commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, numFiles, len(dis))
+	for _, di := range dis {
+		datumIDs[path.Base(di.Data[0].File.Path)] = di.Datum.ID
+	}
+
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		found := 0
+		iter := c.GetLogs(pipeline, commitInfo.Commit.ID, nil, "", false, false, 0)
+		for iter.Next() {
+			msg := iter.Message()
+			if !msg.User || !strings.HasPrefix(msg.Message, "processing ") {
+				continue
+			}
+			file := strings.TrimPrefix(msg.Message, "processing ")
+			if msg.DatumID != datumIDs[file] {
+				return errors.Errorf("log line %q has datum ID %q, expected %q", msg.Message, msg.DatumID, datumIDs[file])
+			}
+			if msg.JobID != commitInfo.Commit.ID {
+				return errors.Errorf("log line %q has job ID %q, expected %q", msg.Message, msg.JobID, commitInfo.Commit.ID)
+			}
+			found++
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		if found != numFiles {
+			return errors.Errorf("found %d datum log lines, expected %d", found, numFiles)
+		}
+		return nil
+	})
+}
+
+func TestReprocessPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestReprocessPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	numFiles := 5
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestReprocessPipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	_, err = c.WaitCommit(pipeline, "master", commit.ID)
+	require.NoError(t, err)
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+
+	job, err := c.ReprocessPipeline(pipeline)
+	require.NoError(t, err)
+	require.NotEqual(t, commit.ID, job.ID)
+	jobInfo, err := c.WaitJob(pipeline, job.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.True(t, jobInfo.Reprocess)
+	require.Equal(t, int64(numFiles), jobInfo.DataTotal)
+	require.Equal(t, int64(numFiles), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+	require.Equal(t, pipelineInfo.Version, jobInfo.PipelineVersion)
+
+	// The pipeline was not updated
+	newPipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.Equal(t, pipelineInfo.Version, newPipelineInfo.Version)
+	require.Equal(t, pipelineInfo.SpecCommit.ID, newPipelineInfo.SpecCommit.ID)
+
+	// A job triggered by an input commit after the reprocess still skips the
+	// unchanged datums
+	commit, err = c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", numFiles), strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	jobInfo, err = c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.False(t, jobInfo.Reprocess)
+	require.Equal(t, int64(numFiles+1), jobInfo.DataTotal)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(numFiles), jobInfo.DataSkipped)
+}
+
+// TestNoPartialOutputFiles checks that a datum whose user code is killed
+// partway through writing an output file doesn't leave that partial file in
+// the output commit. Output files are only uploaded once the user code exits
+// successfully, so the retried datum's complete file is the only one visible.
+func TestNoPartialOutputFiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestNoPartialOutputFiles_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	content := strings.Repeat("foo\n", 1000)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader(content)))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestNoPartialOutputFiles")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			// On the first attempt, write half of the file and then die
+			"if [ ! -f /tmp/attempted ]; then",
+			"  touch /tmp/attempted",
+			fmt.Sprintf("  head -c 100 /pfs/%s/file > /pfs/out/file", dataRepo),
+			"  kill -9 $$",
+			"fi",
+			fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	commitInfo, err := c.WaitCommit(pipeline, "master", commit.ID)
+	require.NoError(t, err)
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+	fileInfos, err := c.ListFileAll(commitInfo.Commit, "/")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fileInfos))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "file", &buf))
+	require.Equal(t, content, buf.String())
+}
+
+func TestDeletePipelines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDeletePipelines_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	// Create a chain of pipelines: dataRepo -> pipelines[0] -> pipelines[1] -> pipelines[2]
+	pipelines := []string{
+		tu.UniqueString("TestDeletePipelines0"),
+		tu.UniqueString("TestDeletePipelines1"),
+		tu.UniqueString("TestDeletePipelines2"),
+	}
+	input := dataRepo
+	for _, pipeline := range pipelines {
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", input)},
+			nil,
+			client.NewPFSInput(input, "/*"),
+			"",
+			false,
+		))
+		input = pipeline
+	}
+
+	// The last pipeline consumes the others' output, so it must be included
+	err := c.DeletePipelines(pipelines[:2], false, false)
+	require.YesError(t, err)
+	require.Matches(t, pipelines[2], err.Error())
+	pipelineInfos, err := c.ListPipeline(false)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pipelineInfos))
+
+	// Deleting the whole chain works regardless of the order it's given in
+	require.NoError(t, c.DeletePipelines(pipelines, false, false))
+	pipelineInfos, err = c.ListPipeline(false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pipelineInfos))
+	repoInfos, err := c.ListRepo()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfos))
+	require.Equal(t, dataRepo, repoInfos[0].Repo.Name)
+}
+
+func TestInspectCronState(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	pipeline := tu.UniqueString("TestInspectCronState")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{"cp /pfs/time/* /pfs/out/"},
+		nil,
+		client.NewCronInputOpts("time", "", "@every 10s", true),
+		"",
+		false,
+	))
+
+	var states []*client.CronState
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		var err error
+		states, err = c.InspectCronState(pipeline)
+		if err != nil {
+			return err
+		}
+		if len(states) != 1 {
+			return errors.Errorf("expected 1 cron input, got %d", len(states))
+		}
+		if states[0].LastTick.IsZero() {
+			return errors.Errorf("cron input hasn't ticked yet")
+		}
+		return nil
+	})
+	state := states[0]
+	require.Equal(t, "time", state.Name)
+	require.Equal(t, fmt.Sprintf("%s_time", pipeline), state.Repo)
+	require.True(t, state.Overwrite)
+	require.Equal(t, 10*time.Second, state.NextTick.Sub(state.LastTick))
+}
+
+func TestDatumProcessTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDatumProcessTime_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "file1", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+
+	pipeline := tu.UniqueString("TestDatumProcessTime")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"sleep 2",
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	_, err = c.WaitCommit(pipeline, "master", commit1.ID)
+	require.NoError(t, err)
+
+	dis, err := c.ListDatumAll(pipeline, commit1.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dis))
+	processTime, err := types.DurationFromProto(dis[0].Stats.ProcessTime)
+	require.NoError(t, err)
+	require.True(t, processTime >= 2*time.Second, "process time %v is too short", processTime)
+	require.NotNil(t, dis[0].Stats.DownloadTime)
+	require.NotNil(t, dis[0].Stats.UploadTime)
+
+	// The second job skips file1, which should report zero durations
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "file2", strings.NewReader("bar")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+	_, err = c.WaitCommit(pipeline, "master", commit2.ID)
+	require.NoError(t, err)
+
+	dis, err = c.ListDatumAll(pipeline, commit2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(dis))
+	for _, di := range dis {
+		processTime, err := types.DurationFromProto(di.Stats.ProcessTime)
+		require.NoError(t, err)
+		if di.State == pps.DatumState_SKIPPED {
+			require.Equal(t, time.Duration(0), processTime)
+			require.NotNil(t, di.Stats.DownloadTime)
+			require.NotNil(t, di.Stats.UploadTime)
+		} else {
+			require.True(t, processTime >= 2*time.Second, "process time %v is too short", processTime)
+		}
+	}
+}
+
+func TestPipelineOutputBranch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineOutputBranch_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	createPipeline := func(name, outputBranch string) {
+		require.NoError(t, c.CreatePipeline(
+			name,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+			nil,
+			client.NewPFSInput(dataRepo, "/*"),
+			outputBranch,
+			false,
+		))
+	}
+	staging := tu.UniqueString("TestPipelineOutputBranch_staging")
+	createPipeline(staging, "staging")
+	production := tu.UniqueString("TestPipelineOutputBranch_production")
+	createPipeline(production, "")
+
+	// The output branch defaults to master
+	pipelineInfo, err := c.InspectPipeline(production, true)
+	require.NoError(t, err)
+	require.Equal(t, "master", pipelineInfo.Details.OutputBranch)
+	_, err = c.WaitCommit(production, "master", commit.ID)
+	require.NoError(t, err)
+
+	pipelineInfo, err = c.InspectPipeline(staging, true)
+	require.NoError(t, err)
+	require.Equal(t, "staging", pipelineInfo.Details.OutputBranch)
+	commitInfo, err := c.WaitCommit(staging, "staging", commit.ID)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "file", &buf))
+	require.Equal(t, "foo", buf.String())
+
+	// Only the chosen branch exists in the output and meta repos
+	_, err = c.InspectBranch(staging, "master")
+	require.YesError(t, err)
+	_, err = c.PfsAPIClient.InspectBranch(c.Ctx(), &pfs.InspectBranchRequest{
+		Branch: client.NewSystemRepo(staging, pfs.MetaRepoType).NewBranch("staging"),
+	})
+	require.NoError(t, err)
+
+	// Datums are read from the meta commit on the chosen branch
+	dis, err := c.ListDatumAll(staging, commitInfo.Commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dis))
+}
+
+func TestDatumMemoryScaling(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestDatumMemoryScaling_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "small", strings.NewReader("a")))
+	require.NoError(t, c.PutFile(commit, "large", bytes.NewReader(make([]byte, 4*units.MB))))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestDatumMemoryScaling")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*; do", dataRepo),
+					// ulimit -d reports the limit in KiB
+					fmt.Sprintf("  echo $%s $(ulimit -d) > /pfs/out/$(basename $f)", client.DatumMemoryLimitEnv),
+					"done",
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+			DatumMemoryScaling: &pps.DatumMemoryScaling{
+				Base:    "256Mi",
+				PerByte: 64,
+				Max:     "1Gi",
+			},
+		})
+	require.NoError(t, err)
+
+	commitInfo, err := c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	limits := make(map[string][]int64)
+	for _, file := range []string{"small", "large"} {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(commitInfo.Commit, file, &buf))
+		fields := strings.Fields(buf.String())
+		require.Equal(t, 2, len(fields))
+		for _, field := range fields {
+			n, err := strconv.ParseInt(field, 10, 64)
+			require.NoError(t, err)
+			limits[file] = append(limits[file], n)
+		}
+	}
+	require.Equal(t, int64(256*units.MiB+64), limits["small"][0])
+	require.Equal(t, int64(256*units.MiB+64*4*units.MB), limits["large"][0])
+	// The limits are applied to the user code
+	require.Equal(t, limits["small"][0]/units.KiB, limits["small"][1])
+	require.Equal(t, limits["large"][0]/units.KiB, limits["large"][1])
+	require.True(t, limits["large"][0] > limits["small"][0])
+
+	// Datums are never given more than the max
+	commit, err = c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "huge", bytes.NewReader(make([]byte, 16*units.MB))))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	commitInfo, err = c.WaitCommit(pipeline, "master", commit.ID)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "huge", &buf))
+	require.Equal(t, fmt.Sprintf("%d %d\n", int64(units.GiB), int64(units.GiB/units.KiB)), buf.String())
+
+	// Invalid scaling rules are rejected
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline:  client.NewPipeline(tu.UniqueString("TestDatumMemoryScaling_invalid")),
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			Input:     client.NewPFSInput(dataRepo, "/*"),
+			DatumMemoryScaling: &pps.DatumMemoryScaling{
+				Base: "1Gi",
+				Max:  "256Mi",
+			},
+		})
+	require.YesError(t, err)
+	require.Matches(t, "invalid datum_memory_scaling", err.Error())
+}
+
+func TestCreatePipelineDryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestCreatePipelineDryRun_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestCreatePipelineDryRun")
+
+	// A valid spec is resolved, with defaults filled in
+	pipelineInfo, err := c.CreatePipelineDryRun(&pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(pipeline),
+		Transform: &pps.Transform{Cmd: []string{"true"}},
+		Input:     client.NewPFSInput(dataRepo, "/*"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, pipeline, pipelineInfo.Pipeline.Name)
+	require.Equal(t, "master", pipelineInfo.Details.OutputBranch)
+	require.NotEqual(t, "", pipelineInfo.Details.Transform.Image)
+	require.Equal(t, dataRepo, pipelineInfo.Details.Input.Pfs.Name)
+	require.Equal(t, "", pipelineInfo.AuthToken)
+
+	// ...but nothing is created
+	_, err = c.InspectPipeline(pipeline, false)
+	require.YesError(t, err)
+	_, err = c.InspectRepo(pipeline)
+	require.YesError(t, err)
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(pipeline),
+		Transform: &pps.Transform{Cmd: []string{"true"}},
+		Input:     client.NewPFSInput(dataRepo, "/*"),
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	_, err = c.InspectPipeline(pipeline, false)
+	require.YesError(t, err)
+
+	// Invalid specs are rejected
+	for _, req := range []*pps.CreatePipelineRequest{
+		{ // self-referential
+			Pipeline:  client.NewPipeline(pipeline),
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			Input:     client.NewPFSInput(pipeline, "/"),
+		},
+		{ // duplicate input names
+			Pipeline:  client.NewPipeline(pipeline),
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			Input: client.NewCrossInput(
+				client.NewPFSInput(dataRepo, "/*"),
+				client.NewPFSInput(dataRepo, "/*"),
+			),
+		},
+		{ // unparseable pod patch
+			Pipeline:  client.NewPipeline(pipeline),
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			Input:     client.NewPFSInput(dataRepo, "/*"),
+			PodPatch:  "not-json",
+		},
+	} {
+		_, err := c.CreatePipelineDryRun(req)
+		require.YesError(t, err)
+		req.DryRun = true
+		_, err = c.PpsAPIClient.CreatePipeline(context.Background(), req)
+		require.YesError(t, err)
+	}
+}
+
+func TestQuarantineDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestQuarantineDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestQuarantineDatums")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*; do", dataRepo),
+					"  if grep -q bad $f; then exit 1; fi",
+					"  cp $f /pfs/out/",
+					"done",
+				},
+			},
+			Input:           client.NewPFSInput(dataRepo, "/*"),
+			QuarantineAfter: 2,
+		})
+	require.NoError(t, err)
+
+	putFile := func(name, content string) *pps.JobInfo {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, name, strings.NewReader(content)))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		return jobInfo
+	}
+	datumState := func(jobID, file string) (string, pps.DatumState) {
+		dis, err := c.ListDatumAll(pipeline, jobID)
+		require.NoError(t, err)
+		for _, di := range dis {
+			if path.Base(di.Data[0].File.Path) == file {
+				return di.Datum.ID, di.State
+			}
+		}
+		t.Fatalf("no datum for %s in job %s", file, jobID)
+		return "", pps.DatumState_UNKNOWN
+	}
+
+	// The bad datum fails the first two jobs...
+	require.Equal(t, pps.JobState_JOB_FAILURE, putFile("bad", "bad").State)
+	require.Equal(t, pps.JobState_JOB_FAILURE, putFile("good1", "good").State)
+
+	// ...and is then quarantined, so the next job succeeds
+	jobInfo := putFile("good2", "good")
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	badID, state := datumState(jobInfo.Job.ID, "bad")
+	require.Equal(t, pps.DatumState_QUARANTINED, state)
+	_, state = datumState(jobInfo.Job.ID, "good1")
+	require.Equal(t, pps.DatumState_SUCCESS, state)
+
+	// Later jobs keep skipping it
+	jobInfo = putFile("good3", "good")
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	_, state = datumState(jobInfo.Job.ID, "bad")
+	require.Equal(t, pps.DatumState_QUARANTINED, state)
+	var buf bytes.Buffer
+	require.YesError(t, c.GetFile(jobInfo.OutputCommit, "bad", &buf))
+
+	// Once released, the datum is processed (and fails) again
+	require.NoError(t, c.ReleaseQuarantine(pipeline, badID))
+	jobInfo = putFile("good4", "good")
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	_, state = datumState(jobInfo.Job.ID, "bad")
+	require.Equal(t, pps.DatumState_FAILED, state)
+}
+
+func TestPipelineMetricsHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelineMetricsHistory_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineMetricsHistory")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("if [ -e /pfs/%s/bad ]; then exit 1; fi", dataRepo),
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/"),
+		"",
+		false,
+	))
+
+	commitStates := []pps.JobState{
+		pps.JobState_JOB_SUCCESS,
+		pps.JobState_JOB_SUCCESS,
+		pps.JobState_JOB_FAILURE,
+		pps.JobState_JOB_SUCCESS,
+	}
+	for i, state := range commitStates {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		switch i {
+		case 2:
+			require.NoError(t, c.PutFile(commit, "bad", strings.NewReader("bad")))
+		case 3:
+			require.NoError(t, c.DeleteFile(commit, "bad"))
+		default:
+			require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		}
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, state, jobInfo.State)
+	}
+
+	// Creating the pipeline also created a (successful) job
+	expected := append([]pps.JobState{pps.JobState_JOB_SUCCESS}, commitStates...)
+	points, err := c.GetPipelineMetricsHistory(pipeline, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(points))
+	var succeeded, failed int64
+	for i, point := range points {
+		if expected[i] == pps.JobState_JOB_SUCCESS {
+			succeeded++
+		} else {
+			failed++
+		}
+		require.Equal(t, succeeded, point.Succeeded)
+		require.Equal(t, failed, point.Failed)
+		require.True(t, point.AvgJobDuration > 0)
+		if i > 0 {
+			require.False(t, point.Time.Before(points[i-1].Time))
+		}
+	}
+	require.Equal(t, int64(4), points[len(points)-1].Succeeded)
+	require.Equal(t, int64(1), points[len(points)-1].Failed)
+
+	// None of the jobs finished in the last nanosecond
+	points, err = c.GetPipelineMetricsHistory(pipeline, time.Nanosecond)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(points))
+}
+
+func TestRerunPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRerunPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestRerunPipeline")
+	// The "bad" datum fails the first time it's processed by the worker, as
+	// though an external resource it depends on was unavailable
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*; do", dataRepo),
+					"  if grep -q bad $f && [ ! -f /tmp/available ]; then",
+					"    touch /tmp/available",
+					"    exit 1",
+					"  fi",
+					"  cp $f /pfs/out/",
+					"done",
+				},
+			},
+			Input:      client.NewPFSInput(dataRepo, "/*"),
+			DatumTries: 1,
+		})
+	require.NoError(t, err)
+
+	numGood := 3
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < numGood; i++ {
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("good%d", i), strings.NewReader("good")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+	commit, err = c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "bad", strings.NewReader("bad")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	jobInfo, err = c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+
+	rerun := func(reprocessSpecs ...string) *pps.JobInfo {
+		require.NoError(t, c.RerunPipeline(pipeline, reprocessSpecs...))
+		jobInfos, err := c.ListJob(pipeline, nil, 0, false)
+		require.NoError(t, err)
+		jobInfo, err := c.WaitJob(pipeline, jobInfos[0].Job.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		require.Equal(t, pipelineInfo.Version, jobInfo.PipelineVersion)
+		return jobInfo
+	}
+
+	// Rerunning until success only processes the failed datum
+	jobInfo = rerun(client.ReprocessSpecUntilSuccess)
+	require.False(t, jobInfo.Reprocess)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(numGood), jobInfo.DataSkipped)
+
+	// A plain rerun processes every datum
+	jobInfo = rerun()
+	require.True(t, jobInfo.Reprocess)
+	require.Equal(t, int64(numGood+1), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+
+	require.YesError(t, c.RerunPipeline(pipeline, "sometimes"))
+	require.YesError(t, c.RerunPipeline(pipeline, client.ReprocessSpecEveryJob, client.ReprocessSpecUntilSuccess))
+
+	// The pipeline was not updated
+	newPipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.Equal(t, pipelineInfo.Version, newPipelineInfo.Version)
+	require.Equal(t, pipelineInfo.SpecCommit.ID, newPipelineInfo.SpecCommit.ID)
+}
+
+func TestPipelineConfigMap(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	// make a config map to reference
+	k := tu.GetKubeClient(t)
+	configMapName := tu.UniqueString("test-config-map")
+	_, err := k.CoreV1().ConfigMaps(v1.NamespaceDefault).Create(
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: configMapName,
+			},
+			Data: map[string]string{
+				"foo": "foo\n",
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, k.CoreV1().ConfigMaps(v1.NamespaceDefault).Delete(configMapName, &metav1.DeleteOptions{}))
+	}()
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineConfigMap_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	createPipeline := func(pipelineName string, configMap *pps.ConfigMapMount) error {
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipelineName),
+				Transform: &pps.Transform{
+					Cmd: []string{"sh"},
+					Stdin: []string{
+						"cat /var/config/foo > /pfs/out/foo",
+						"echo $foo > /pfs/out/foo_env",
+					},
+					ConfigMaps: []*pps.ConfigMapMount{configMap},
+				},
+				Input: client.NewPFSInput(dataRepo, "/*"),
+			})
+		return err
+	}
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, createPipeline(pipeline, &pps.ConfigMapMount{
+		Name:      configMapName,
+		Key:       "foo",
+		MountPath: "/var/config",
+		EnvVar:    "foo",
+	}))
+
+	// Missing config maps and keys are rejected
+	require.YesError(t, createPipeline(tu.UniqueString("pipeline"), &pps.ConfigMapMount{
+		Name:      tu.UniqueString("missing-config-map"),
+		MountPath: "/var/config",
+	}))
+	require.YesError(t, createPipeline(tu.UniqueString("pipeline"), &pps.ConfigMapMount{
+		Name:   configMapName,
+		Key:    "bar",
+		EnvVar: "bar",
+	}))
+
+	commit := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo\n")))
+	commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.WaitJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(jobInfo.OutputCommit, "foo", &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(jobInfo.OutputCommit, "foo_env", &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+}
+
+func TestInspectDatumSkipReason(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestInspectDatumSkipReason_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestInspectDatumSkipReason")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	putFile := func(name string) *pps.JobInfo {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, name, strings.NewReader(name)))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		return jobInfo
+	}
+	firstJob := putFile("a")
+	secondJob := putFile("b")
+
+	dis, err := c.ListDatumAll(pipeline, secondJob.Job.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(dis))
+	for _, di := range dis {
+		reason, msg, err := c.InspectDatumSkipReason(pipeline, secondJob.Job.ID, di.Datum.ID)
+		require.NoError(t, err)
+		switch path.Base(di.Data[0].File.Path) {
+		case "a":
+			require.Equal(t, client.DatumSkipUnchanged, reason)
+			require.True(t, strings.Contains(msg, firstJob.Job.ID))
+		case "b":
+			require.Equal(t, client.DatumNotSkipped, reason)
+		default:
+			t.Fatalf("unexpected datum %v", di.Data[0].File)
+		}
+	}
+}
+
+func TestPipelinePreflightCmd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelinePreflightCmd_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelinePreflightCmd")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:          []string{"bash"},
+				Stdin:        []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+				PreflightCmd: []string{"bash"},
+				PreflightStdin: []string{
+					fmt.Sprintf("if [ ! -f /pfs/%s/required ]; then", dataRepo),
+					"  echo 'required file is missing' >&2",
+					"  exit 1",
+					"fi",
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+		})
+	require.NoError(t, err)
+
+	putFile := func(name string) *pps.JobInfo {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, name, strings.NewReader(name)))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		return jobInfo
+	}
+
+	// The preflight command vetoes the job before any datums are processed
+	jobInfo := putFile("file")
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	require.True(t, strings.Contains(jobInfo.Reason, "required file is missing"))
+	require.Equal(t, int64(0), jobInfo.DataProcessed)
+
+	jobInfo = putFile("required")
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(2), jobInfo.DataProcessed)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(jobInfo.OutputCommit, "file", &buf))
+	require.Equal(t, "file", buf.String())
+}
+
+func TestInspectPipelineHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestInspectPipelineHistory_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestInspectPipelineHistory")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	var jobIDs []string
+	for i := 0; i < 3; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+		require.NoError(t, err)
+		jobIDs = append([]string{jobInfo.Job.ID}, jobIDs...)
+	}
+
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pipelineInfo.RecentJobs))
+
+	pipelineInfo, err = c.InspectPipelineHistory(pipeline, false, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pipelineInfo.RecentJobs))
+	for i, summary := range pipelineInfo.RecentJobs {
+		require.Equal(t, jobIDs[i], summary.Job.ID)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, summary.State)
+		require.NotNil(t, summary.Finished)
+	}
+
+	// Creating the pipeline also created a job
+	pipelineInfo, err = c.InspectPipelineHistory(pipeline, false, 100)
+	require.NoError(t, err)
+	require.Equal(t, len(jobIDs)+1, len(pipelineInfo.RecentJobs))
+}
+
+func TestExportImportPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	// make a secret to reference, which outlives DeleteAll as pachyderm didn't
+	// create it
+	k := tu.GetKubeClient(t)
+	secretName := tu.UniqueString("test-secret")
+	_, err := k.CoreV1().Secrets(v1.NamespaceDefault).Create(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: secretName,
+			},
+			Data: map[string][]byte{
+				"foo": []byte("foo"),
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, k.CoreV1().Secrets(v1.NamespaceDefault).Delete(secretName, &metav1.DeleteOptions{}))
+	}()
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestExportImportPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestExportImportPipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+					"echo -n $foo > /pfs/out/secret",
+				},
+				Secrets: []*pps.SecretMount{
+					{
+						Name:   secretName,
+						Key:    "foo",
+						EnvVar: "foo",
+					},
+				},
+			},
+			Input:       client.NewPFSInput(dataRepo, "/*"),
+			Description: "exported pipeline",
+		})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.ExportPipeline(pipeline, &buf))
+	exported := buf.String()
+	bundle := &pps.PipelineBundle{}
+	require.NoError(t, jsonpb.UnmarshalString(exported, bundle))
+	require.Equal(t, uint64(1), bundle.Version)
+	require.Equal(t, []string{secretName}, bundle.Secrets)
+	require.Equal(t, pipeline, bundle.Spec.Pipeline.Name)
+
+	// Import the pipeline into an empty cluster
+	require.NoError(t, c.DeleteAll())
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.ImportPipeline(strings.NewReader(exported)))
+	pipelineInfo, err := c.InspectPipeline(pipeline, true)
+	require.NoError(t, err)
+	require.Equal(t, "exported pipeline", pipelineInfo.Details.Description)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("file")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	buf.Reset()
+	require.NoError(t, c.GetFile(jobInfo.OutputCommit, "secret", &buf))
+	require.Equal(t, "foo", buf.String())
+
+	// Bundles referencing missing secrets are rejected
+	require.NoError(t, c.DeleteAll())
+	bundle.Secrets = []string{tu.UniqueString("missing-secret")}
+	bundleJSON, err := (&jsonpb.Marshaler{}).MarshalToString(bundle)
+	require.NoError(t, err)
+	require.YesError(t, c.ImportPipeline(strings.NewReader(bundleJSON)))
+
+	// The bundle also names the secret holding the pipeline's egress
+	// credentials
+	require.NoError(t, c.CreateRepo(dataRepo))
+	egressPipeline := tu.UniqueString("TestExportImportPipeline_egress")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(egressPipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+			Egress: &pps.Egress{
+				URL:    "s3://bucket/dir",
+				Secret: &pps.SecretMount{Name: secretName},
+			},
+		})
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, c.ExportPipeline(egressPipeline, &buf))
+	bundle = &pps.PipelineBundle{}
+	require.NoError(t, jsonpb.UnmarshalString(buf.String(), bundle))
+	require.Equal(t, []string{secretName}, bundle.Secrets)
+}
+
+func TestPipelineHeartbeat(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineHeartbeat_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	pipeline := tu.UniqueString("TestPipelineHeartbeat")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Image: "trinitronx/python-simplehttpserver",
+				Cmd:   []string{"sh"},
+				Stdin: []string{"cd /pfs", "exec python -m SimpleHTTPServer 8000"},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+			Input:           client.NewPFSInput(dataRepo, "/"),
+			Service: &pps.Service{
+				InternalPort: 8000,
+				ExternalPort: 31801,
+			},
+			Heartbeat: &pps.Heartbeat{Interval: types.DurationProto(time.Second)},
+		})
+	require.NoError(t, err)
+
+	latest := func() time.Time {
+		var result time.Time
+		require.NoErrorWithinTRetry(t, time.Minute, func() error {
+			heartbeats, err := c.InspectWorkerHeartbeat(pipeline)
+			if err != nil {
+				return err
+			}
+			if len(heartbeats) == 0 {
+				return errors.Errorf("no heartbeats yet")
+			}
+			for _, hb := range heartbeats {
+				ts, err := types.TimestampFromProto(hb.Timestamp)
+				if err != nil {
+					return err
+				}
+				if ts.After(result) {
+					result = ts
+				}
+			}
+			return nil
+		})
+		return result
+	}
+	first := latest()
+	time.Sleep(5 * time.Second)
+	require.True(t, latest().After(first))
+
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.PipelineState_PIPELINE_RUNNING, pipelineInfo.State)
+
+	// Pipelines without a heartbeat don't record one
+	require.NoError(t, c.CreatePipeline(
+		pipeline+"-noheartbeat",
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	_, err = c.InspectWorkerHeartbeat(pipeline + "-noheartbeat")
+	require.YesError(t, err)
+}
+
+func TestEgressWithSecretFailure(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	// credentials for an object store that can't be reached
+	k := tu.GetKubeClient(t)
+	secretName := tu.UniqueString("egress-secret")
+	_, err := k.CoreV1().Secrets(v1.NamespaceDefault).Create(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: secretName,
+			},
+			Data: map[string][]byte{
+				"AMAZON_REGION":   []byte("us-east-1"),
+				"AMAZON_ID":       []byte("id"),
+				"AMAZON_SECRET":   []byte("secret"),
+				"CUSTOM_ENDPOINT": []byte("http://localhost:1"),
+			},
+		},
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, k.CoreV1().Secrets(v1.NamespaceDefault).Delete(secretName, &metav1.DeleteOptions{}))
+	}()
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestEgressWithSecretFailure_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestEgressWithSecretFailure")
+	createPipeline := func(egress *pps.Egress) error {
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd:   []string{"bash"},
+					Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+				},
+				Input:  client.NewPFSInput(dataRepo, "/*"),
+				Egress: egress,
+			})
+		return err
+	}
+	// The secret must exist, and is only supported for s3
+	require.YesError(t, createPipeline(&pps.Egress{
+		URL:    "s3://bucket/prefix",
+		Secret: &pps.SecretMount{Name: tu.UniqueString("missing-secret")},
+	}))
+	require.YesError(t, createPipeline(&pps.Egress{
+		URL:    "gs://bucket/prefix",
+		Secret: &pps.SecretMount{Name: secretName},
+	}))
+	require.NoError(t, createPipeline(&pps.Egress{
+		URL:    "s3://bucket/prefix",
+		Secret: &pps.SecretMount{Name: secretName},
+	}))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	// The failed egress puts the pipeline in CRASHING, and the job is retried
+	// rather than finishing
+	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
+		pipelineInfo, err := c.InspectPipeline(pipeline, false)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.State != pps.PipelineState_PIPELINE_CRASHING {
+			return errors.Errorf("expected pipeline to be crashing, but it's %v", pipelineInfo.State)
+		}
+		if !strings.Contains(pipelineInfo.Reason, "could not egress") {
+			return errors.Errorf("unexpected reason: %q", pipelineInfo.Reason)
+		}
+		return nil
+	})
+	jobInfo, err := c.InspectJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_EGRESSING, jobInfo.State)
+
+	// The pipeline stays CRASHING while the egress keeps failing, even though
+	// its workers are up
+	for i := 0; i < 6; i++ {
+		time.Sleep(5 * time.Second)
+		pipelineInfo, err := c.InspectPipeline(pipeline, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.PipelineState_PIPELINE_CRASHING, pipelineInfo.State)
+	}
+}
+
+func TestPipelineParallelismDatumsPerWorker(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineParallelismDatumsPerWorker_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"sleep 5",
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		&pps.ParallelismSpec{
+			DatumsPerWorker: 10,
+			Max:             4,
+		},
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	// Nothing to process, so a single worker runs
+	parallelism, err := c.InspectPipelineParallelismEffective(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, 1, parallelism)
+
+	// A burst of datums scales the pipeline up to its max
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		require.NoError(t, c.PutFile(commit1, fmt.Sprintf("file-%d", i), strings.NewReader(fmt.Sprintf("%d", i))))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
+		parallelism, err := c.InspectPipelineParallelismEffective(pipeline)
+		if err != nil {
+			return err
+		}
+		if parallelism != 4 {
+			return errors.Errorf("expected 4 workers, but pipeline targets %d", parallelism)
+		}
+		return nil
+	})
+	_, err = c.WaitCommitSetAll(commit1.ID)
+	require.NoError(t, err)
+
+	// Few datums scale it back down
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(commit2, "/"))
+	require.NoError(t, c.PutFile(commit2, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+	_, err = c.WaitCommitSetAll(commit2.ID)
+	require.NoError(t, err)
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		parallelism, err := c.InspectPipelineParallelismEffective(pipeline)
+		if err != nil {
+			return err
+		}
+		if parallelism != 1 {
+			return errors.Errorf("expected 1 worker, but pipeline targets %d", parallelism)
+		}
+		return nil
+	})
+
+	// datums_per_worker requires a max, and excludes a constant
+	require.YesError(t, c.CreatePipeline(
+		tu.UniqueString("pipeline"),
+		"",
+		[]string{"true"},
+		nil,
+		&pps.ParallelismSpec{DatumsPerWorker: 10},
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	require.YesError(t, c.CreatePipeline(
+		tu.UniqueString("pipeline"),
+		"",
+		[]string{"true"},
+		nil,
+		&pps.ParallelismSpec{DatumsPerWorker: 10, Max: 4, Constant: 2},
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+}
+
+func TestPipelineJobHistoryLimit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineJobHistoryLimit_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineJobHistoryLimit")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+			},
+			Input:           client.NewPFSInput(dataRepo, "/*"),
+			JobHistoryLimit: 2,
+		})
+	require.NoError(t, err)
+
+	var commitIDs []string
+	for i := 0; i < 5; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file-%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+		commitIDs = append(commitIDs, commit.ID)
+	}
+
+	// Only the two most recent jobs remain
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+		if err != nil {
+			return err
+		}
+		if len(jobInfos) != 2 {
+			return errors.Errorf("expected 2 jobs, but there are %d", len(jobInfos))
+		}
+		return nil
+	})
+	jobInfos, err := c.ListJob(pipeline, nil, -1, false)
+	require.NoError(t, err)
+	require.ElementsEqual(t, commitIDs[3:], []string{jobInfos[0].Job.ID, jobInfos[1].Job.ID})
+	_, err = c.InspectJob(pipeline, commitIDs[0], false)
+	require.YesError(t, err)
+
+	// The pruned jobs' meta commits are squashed, while the remaining jobs'
+	// meta commits are kept
+	metaRepo := client.NewSystemRepo(pipeline, pfs.MetaRepoType)
+	for i, id := range commitIDs {
+		_, err := c.PfsAPIClient.InspectCommit(c.Ctx(), &pfs.InspectCommitRequest{
+			Commit: metaRepo.NewCommit("master", id),
+		})
+		if i < 3 {
+			require.YesError(t, err)
+			require.True(t, errutil.IsNotFoundError(err))
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// The pruned jobs' output is kept
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", commitIDs[0]), "file-0", &buf))
+	require.Equal(t, "foo", buf.String())
+}
+
+func TestInspectInputCommitReadiness(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectInputCommitReadiness_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"cat /pfs/branch-a/file >> /pfs/out/file",
+			"cat /pfs/branch-b/file >> /pfs/out/file",
+		},
+		nil,
+		client.NewCrossInput(
+			client.NewPFSInputOpts("branch-a", dataRepo, "branchA", "/*", "", "", false, false, nil),
+			client.NewPFSInputOpts("branch-b", dataRepo, "branchB", "/*", "", "", false, false, nil),
+		),
+		"",
+		false,
+	))
+
+	commitA, err := c.StartCommit(dataRepo, "branchA")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commitA, "/file", strings.NewReader("data A\n")))
+	require.NoError(t, c.FinishCommit(dataRepo, commitA.Branch.Name, commitA.ID))
+
+	readiness, err := c.InspectInputCommitReadiness(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(readiness))
+	require.Equal(t, "branch-a", readiness[0].Name)
+	require.True(t, readiness[0].Ready)
+	require.Equal(t, commitA.ID, readiness[0].Head.ID)
+	require.Equal(t, "branch-b", readiness[1].Name)
+	require.False(t, readiness[1].Ready)
+	require.Equal(t, "head commit has no files", readiness[1].Reason)
+
+	// An open commit on branchB still blocks the pipeline
+	commitB, err := c.StartCommit(dataRepo, "branchB")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commitB, "/file", strings.NewReader("data B\n")))
+	readiness, err = c.InspectInputCommitReadiness(pipeline)
+	require.NoError(t, err)
+	require.False(t, readiness[1].Ready)
+	require.Equal(t, "head commit isn't finished", readiness[1].Reason)
+
+	require.NoError(t, c.FinishCommit(dataRepo, commitB.Branch.Name, commitB.ID))
+	readiness, err = c.InspectInputCommitReadiness(pipeline)
+	require.NoError(t, err)
+	require.True(t, readiness[0].Ready)
+	require.True(t, readiness[1].Ready)
+}
+
+func TestJobInfoFailedDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestJobInfoFailedDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	dataCommit := client.NewCommit(dataRepo, "master", "")
+	numFiles := 15
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(dataCommit, fmt.Sprintf("file-%d", i), strings.NewReader("foo\n")))
+	}
+
+	tries := int64(2)
+	pipeline := tu.UniqueString("TestJobInfoFailedDatums")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"echo datum blew up >&2",
+					"exit 1",
+				},
+			},
+			Input:      client.NewPFSInput(dataRepo, "/*"),
+			DatumTries: tries,
+		},
+	)
+	require.NoError(t, err)
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.WaitJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	// The failed datums are bounded, even though every datum fails
+	require.Equal(t, 10, len(jobInfo.FailedDatums))
+	for _, failedDatum := range jobInfo.FailedDatums {
+		require.NotEqual(t, "", failedDatum.Datum.ID)
+		require.Equal(t, tries-1, failedDatum.Retries)
+		require.True(t, strings.Contains(strings.Join(failedDatum.Logs, "\n"), "datum blew up"))
+	}
+}
+
+func TestCronPipelineTickFormat(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	pipeline := tu.UniqueString("TestCronPipelineTickFormat")
+	input := client.NewCronInput("time", "@every 1h")
+	input.Cron.Format = client.CronFormatUnix
+	input.Cron.Json = true
+	input.Cron.Overwrite = true
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{"cp /pfs/time/* /pfs/out/tick"},
+		nil,
+		input,
+		"",
+		false,
+	))
+
+	require.NoError(t, c.RunCron(pipeline))
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "tick", &buf))
+	var tick struct {
+		Tick string `json:"tick"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &tick))
+	seconds, err := strconv.ParseInt(tick.Tick, 10, 64)
+	require.NoError(t, err)
+	require.True(t, time.Since(time.Unix(seconds, 0)) < time.Hour)
+
+	// A layout that doesn't reference the time is rejected
+	input.Cron.Format = "not a layout"
+	require.YesError(t, c.CreatePipeline(
+		tu.UniqueString("TestCronPipelineTickFormat"),
+		"",
+		[]string{"/bin/bash"},
+		[]string{"cp /pfs/time/* /pfs/out/tick"},
+		nil,
+		input,
+		"",
+		false,
+	))
+}
+
+func TestCronPipelineRetention(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	pipeline := tu.UniqueString("TestCronPipelineRetention")
+	input := client.NewCronInput("time", "@every 5s")
+	input.Cron.KeepTicks = 2
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{"cp /pfs/time/* /pfs/out/"},
+		nil,
+		input,
+		"",
+		false,
+	))
+	pipelineInfo, err := c.InspectPipeline(pipeline, true)
+	require.NoError(t, err)
+	cronRepo := pipelineInfo.Details.Input.Cron.Repo
+
+	// Once enough ticks have happened, only the last two are retained, in
+	// both the cron repo and the pipeline's output
+	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
+		commitInfos, err := c.ListCommit(client.NewRepo(cronRepo), client.NewCommit(cronRepo, "master", ""), nil, 0)
+		if err != nil {
+			return err
+		}
+		var ticks int
+		for _, ci := range commitInfos {
+			if ci.Origin.Kind == pfs.OriginKind_USER {
+				ticks++
+			}
+		}
+		if ticks != 2 {
+			return errors.Errorf("expected 2 tick commits, but there are %d", ticks)
+		}
+		commitInfo, err := c.InspectCommit(cronRepo, "master", "")
+		if err != nil {
+			return err
+		}
+		if _, err := c.WaitCommitSetAll(commitInfo.Commit.ID); err != nil {
+			return err
+		}
+		for _, repo := range []string{cronRepo, pipeline} {
+			files, err := c.ListFileAll(client.NewCommit(repo, "master", commitInfo.Commit.ID), "")
+			if err != nil {
+				return err
+			}
+			if len(files) != 2 {
+				return errors.Errorf("expected 2 files in %s, but there are %d", repo, len(files))
+			}
+		}
+		return nil
+	})
+
+	// keep_duration must be positive
+	input.Cron.KeepDuration = types.DurationProto(0)
+	require.YesError(t, c.CreatePipeline(
+		tu.UniqueString("TestCronPipelineRetention"),
+		"",
+		[]string{"/bin/bash"},
+		[]string{"cp /pfs/time/* /pfs/out/"},
+		nil,
+		input,
+		"",
+		false,
+	))
+}
+
+func TestPipelineScriptFromInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineScriptFromInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	scriptRepo := tu.UniqueString("TestPipelineScriptFromInput_scripts")
+	require.NoError(t, c.CreateRepo(scriptRepo))
+
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "file", strings.NewReader("foo\n")))
+	require.NoError(t, c.PutFile(client.NewCommit(scriptRepo, "master", ""), "run.sh", strings.NewReader(
+		fmt.Sprintf("cp /pfs/%s/file /pfs/out/file\necho bar >> /pfs/out/file\n", dataRepo))))
+
+	pipeline := tu.UniqueString("TestPipelineScriptFromInput")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:             []string{"bash"},
+				ScriptFromInput: "scripts/run.sh",
+			},
+			Input: client.NewCrossInput(
+				client.NewPFSInput(dataRepo, "/*"),
+				client.NewPFSInputOpts("scripts", scriptRepo, "", "/", "", "", false, false, nil),
+			),
+		},
+	)
+	require.NoError(t, err)
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.WaitJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "file", &buf))
+	require.Equal(t, "foo\nbar\n", buf.String())
+
+	// Removing the script fails the next job
+	require.NoError(t, c.DeleteFile(client.NewCommit(scriptRepo, "master", ""), "run.sh"))
+	require.NoError(t, c.PutFile(client.NewCommit(scriptRepo, "master", ""), "other.sh", strings.NewReader("exit 0\n")))
+	commitInfo, err = c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err = c.WaitJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	require.True(t, strings.Contains(jobInfo.Reason, "run.sh"))
+
+	// The script must come from one of the pipeline's inputs
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(tu.UniqueString("TestPipelineScriptFromInput_invalid")),
+			Transform: &pps.Transform{
+				Cmd:             []string{"bash"},
+				ScriptFromInput: "nonexistent/run.sh",
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+		},
+	)
+	require.YesError(t, err)
+}
+
+func TestInspectCommitSetState(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectCommitSetState_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("TestInspectCommitSetState")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"sleep 20",
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo\n")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	// The pipeline is still sleeping, so its output commit is open
+	state, err := c.InspectCommitSetState(commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, client.CommitSetStateRunning, state.State)
+	require.True(t, state.Open > 0)
+	require.True(t, state.Finished > 0)
+
+	_, err = c.WaitCommitSetAll(commit.ID)
+	require.NoError(t, err)
+	state, err = c.InspectCommitSetState(commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, client.CommitSetStateSuccess, state.State)
+	require.Equal(t, 0, state.Open)
+}
+
+func TestDiffPipelineSpecs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDiffPipelineSpecs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("TestDiffPipelineSpecs")
+	createPipeline := func(stdin string, update bool) {
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{stdin},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewPFSInput(dataRepo, "/*"),
+			"",
+			update,
+		))
+	}
+	createPipeline("echo foo >/pfs/out/file", false)
+	createPipeline("echo bar >/pfs/out/file", true)
+
+	diffs, err := c.DiffPipelineSpecs(pipeline, 1, 2)
+	require.NoError(t, err)
+	var transformDiff *client.PipelineSpecDiff
+	for _, diff := range diffs {
+		require.NotEqual(t, "input", diff.Field)
+		require.NotEqual(t, "parallelism_spec", diff.Field)
+		if diff.Field == "transform" {
+			transformDiff = diff
+		}
+	}
+	require.NotNil(t, transformDiff)
+	require.True(t, strings.Contains(transformDiff.A, "echo foo"))
+	require.True(t, strings.Contains(transformDiff.B, "echo bar"))
+
+	// A version is identical to itself
+	diffs, err = c.DiffPipelineSpecs(pipeline, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(diffs))
+
+	_, err = c.DiffPipelineSpecs(pipeline, 1, 3)
+	require.YesError(t, err)
+}
+
+func TestPipelineCrashBackoff(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineCrashBackoff_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	pipeline := tu.UniqueString("TestPipelineCrashBackoff")
+	maxRetries := int64(3)
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Image: "bs/badimage:vcrap",
+				Cmd:   []string{"true"},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+			CrashBackoff: &pps.CrashBackoff{
+				Initial:    types.DurationProto(time.Second),
+				Max:        types.DurationProto(2 * time.Second),
+				Multiplier: 2,
+				MaxRetries: maxRetries,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The pipeline crashes, and then gives up rather than retrying forever
+	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
+		pipelineInfo, err := c.InspectPipeline(pipeline, false)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.State != pps.PipelineState_PIPELINE_FAILURE {
+			return errors.Errorf("expected pipeline to be in FAILURE, but was in %s", pipelineInfo.State)
+		}
+		require.True(t, strings.Contains(pipelineInfo.Reason, fmt.Sprintf("%d retries", maxRetries)))
+		return nil
+	})
+
+	// An invalid backoff is rejected
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(tu.UniqueString("TestPipelineCrashBackoff_invalid")),
+			Transform: &pps.Transform{
+				Cmd: []string{"true"},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+			CrashBackoff: &pps.CrashBackoff{
+				Initial:    types.DurationProto(time.Second),
+				Multiplier: 0.5,
+			},
+		},
+	)
+	require.YesError(t, err)
+}
+
+func TestInspectFileProvenance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectFileProvenance_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.WithModifyFileClient(client.NewCommit(dataRepo, "master", ""), func(mf client.ModifyFile) error {
+		for _, name := range []string{"a", "b", "c"} {
+			if err := mf.PutFile(name, strings.NewReader(name+"\n")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	pipeline := tu.UniqueString("TestInspectFileProvenance")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("for f in /pfs/%s/*; do cp $f /pfs/out/$(basename $f).out; done", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		provenance, err := c.InspectFileProvenance(commitInfo.Commit, name+".out")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(provenance.Datum.Data))
+		require.Equal(t, dataRepo, provenance.Datum.Data[0].File.Commit.Branch.Repo.Name)
+		require.Equal(t, "/"+name, provenance.Datum.Data[0].File.Path)
+	}
+
+	// Files in an input repo weren't written by a datum
+	_, err = c.InspectFileProvenance(client.NewCommit(dataRepo, "master", ""), "a")
+	require.YesError(t, err)
+}
+
+func TestInspectDatumByPath(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectDatumByPath_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.WithModifyFileClient(client.NewCommit(dataRepo, "master", ""), func(mf client.ModifyFile) error {
+		for _, name := range []string{"a", "b", "c"} {
+			if err := mf.PutFile(name, strings.NewReader(name+"\n")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	pipeline := tu.UniqueString("TestInspectDatumByPath")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	di, err := c.InspectDatumByPath(pipeline, commitInfo.Commit.ID, []string{"b"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(di.Data))
+	require.Equal(t, "/b", di.Data[0].File.Path)
+
+	byID, err := c.InspectDatum(pipeline, commitInfo.Commit.ID, di.Datum.ID)
+	require.NoError(t, err)
+	require.Equal(t, byID.Datum.ID, di.Datum.ID)
+
+	_, err = c.InspectDatumByPath(pipeline, commitInfo.Commit.ID, []string{"/nonexistent"})
+	require.YesError(t, err)
+}
+
+func TestSubscribeJobTransitions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestSubscribeJobTransitions_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestSubscribeJobTransitions")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"sleep 5",
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	var states []pps.JobState
+	done := make(chan error, 1)
+	go func() {
+		done <- c.SubscribeJobTransitions(pipeline, func(ji *pps.JobInfo) error {
+			if ji.Job.ID != commit.ID {
+				return nil
+			}
+			states = append(states, ji.State)
+			if pps.IsTerminal(ji.State) {
+				return errutil.ErrBreak
+			}
+			return nil
+		})
+	}()
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Minute):
+		t.Fatal("timed out waiting for the job to finish")
+	}
+	require.True(t, len(states) > 1)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, states[len(states)-1])
+	for i := 1; i < len(states); i++ {
+		require.NotEqual(t, states[i-1], states[i])
+	}
+}
+
+func TestPipelineOutputAncestryInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineOutputAncestryInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineOutputAncestryInput")
+
+	// Reading the head of the pipeline's own output branch is still a cycle
+	require.YesError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"true"},
+		nil,
+		nil,
+		client.NewCrossInput(
+			client.NewPFSInput(dataRepo, "/"),
+			client.NewPFSInputOpts("", pipeline, "master", "/", "", "", false, false, nil),
+		),
+		"",
+		false,
+	))
+
+	// Each job adds one to the count written by the previous job
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"prev=0",
+			fmt.Sprintf("if [ -f /pfs/%s/count ]; then prev=$(cat /pfs/%s/count); fi", pipeline, pipeline),
+			"echo $((prev+1)) > /pfs/out/count",
+		},
+		nil,
+		client.NewJoinInput(
+			client.NewPFSInputOpts("", dataRepo, "", "/", "root", "", true, false, nil),
+			client.NewPFSInputOpts("", pipeline, "master^", "/", "root", "", true, false, nil),
+		),
+		"",
+		false,
+	))
+
+	for i := 1; i <= 3; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		_, err = c.WaitCommitSetAll(commit.ID)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(client.NewCommit(pipeline, "master", commit.ID), "count", &buf))
+		require.Equal(t, fmt.Sprintf("%d\n", i), buf.String())
+	}
+}
+
+func TestInspectBranchProvenance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	aRepo := tu.UniqueString("A")
+	require.NoError(t, c.CreateRepo(aRepo))
+	eRepo := tu.UniqueString("E")
+	require.NoError(t, c.CreateRepo(eRepo))
+
+	// A -> B, (B, E) -> C, C -> D
+	bPipeline := tu.UniqueString("B")
+	require.NoError(t, c.CreatePipeline(bPipeline, "", []string{"true"}, nil, nil,
+		client.NewPFSInput(aRepo, "/"), "", false))
+	cPipeline := tu.UniqueString("C")
+	require.NoError(t, c.CreatePipeline(cPipeline, "", []string{"true"}, nil, nil,
+		client.NewCrossInput(
+			client.NewPFSInput(bPipeline, "/"),
+			client.NewPFSInput(eRepo, "/"),
+		), "", false))
+	dPipeline := tu.UniqueString("D")
+	require.NoError(t, c.CreatePipeline(dPipeline, "", []string{"true"}, nil, nil,
+		client.NewPFSInput(cPipeline, "/"), "", false))
+
+	userRepos := func(branches []*pfs.Branch) []string {
+		var repos []string
+		for _, branch := range branches {
+			if branch.Repo.Type == pfs.UserRepoType {
+				repos = append(repos, branch.Repo.Name)
+			}
+		}
+		return repos
+	}
+
+	provenance, subvenance, err := c.InspectBranchProvenance(client.NewBranch(cPipeline, "master"))
+	require.NoError(t, err)
+	upstream := userRepos(provenance)
+	require.ElementsEqual(t, []string{aRepo, bPipeline, eRepo}, upstream)
+	indexOf := func(repos []string, repo string) int {
+		for i, r := range repos {
+			if r == repo {
+				return i
+			}
+		}
+		return -1
+	}
+	require.True(t, indexOf(upstream, aRepo) < indexOf(upstream, bPipeline))
+	require.Equal(t, []string{dPipeline}, userRepos(subvenance))
+
+	_, subvenance, err = c.InspectBranchProvenance(client.NewBranch(aRepo, "master"))
+	require.NoError(t, err)
+	require.Equal(t, []string{bPipeline, cPipeline, dPipeline}, userRepos(subvenance))
+}
+
+func TestPipelineMaxInFlightJobs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineMaxInFlightJobs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineMaxInFlightJobs")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				"sleep 5",
+				fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+			},
+		},
+		Input:           client.NewPFSInput(dataRepo, "/*"),
+		ParallelismSpec: &pps.ParallelismSpec{Constant: 2},
+		MaxInFlightJobs: -1,
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.YesError(t, err)
+	require.Matches(t, "max_in_flight_jobs must be non-negative", err.Error())
+	request.MaxInFlightJobs = 1
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	var commitIDs []string
+	for i := 0; i < 3; i++ {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file-%d", i), strings.NewReader("foo")))
+		require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+		commitIDs = append(commitIDs, commit.ID)
+	}
+
+	// Poll the pipeline until every job is done, checking that no more than
+	// one job is ever processing at a time
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.WaitCommitSetAll(commitIDs[len(commitIDs)-1])
+		done <- err
+	}()
+	for finished := false; !finished; {
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+			finished = true
+		case <-time.After(time.Second):
+			pipelineInfo, err := c.InspectPipeline(pipeline, true)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), pipelineInfo.Details.MaxInFlightJobs)
+			require.True(t, pipelineInfo.Details.JobsInFlight <= 1)
+		}
+	}
+	for _, id := range commitIDs {
+		jobInfo, err := c.InspectJob(pipeline, id, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	}
+	pipelineInfo, err := c.InspectPipeline(pipeline, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), pipelineInfo.Details.JobsInFlight)
+}
+
+func TestListDatumFromFileset(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestListDatumFromFileset_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestListDatumFromFileset")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	commit := client.NewCommit(dataRepo, "master", "")
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.PutFile(commit, fmt.Sprintf("file-%d", i), strings.NewReader("foo")))
+	}
+	commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, true)
+	require.NoError(t, err)
+	fsID := jobInfo.Details.DatumFilesetId
+	require.NotEqual(t, "", fsID)
+	jobInfo, err = c.InspectJob(pipeline, commitInfo.Commit.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, fsID, jobInfo.Details.DatumFilesetId)
+
+	// The job's datums can still be listed after its input changes
+	require.NoError(t, c.DeleteFile(commit, "file-0"))
+	require.NoError(t, c.PutFile(commit, "file-3", strings.NewReader("foo")))
+	commitInfo, err = c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	_, err = c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+
+	var paths []string
+	require.NoError(t, c.ListDatumFromFileset(fsID, func(di *pps.DatumInfo) error {
+		require.Equal(t, jobInfo.Job.ID, di.Datum.Job.ID)
+		require.Equal(t, pps.DatumState_SUCCESS, di.State)
+		for _, fi := range di.Data {
+			paths = append(paths, fi.File.Path)
+		}
+		return nil
+	}))
+	require.ElementsEqual(t, []string{"/file-0", "/file-1", "/file-2"}, paths)
+}
+
+func TestLazyEmptyFilesMixedCross(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	lazyRepo := tu.UniqueString("TestLazyEmptyFilesMixedCross_lazy")
+	emptyRepo := tu.UniqueString("TestLazyEmptyFilesMixedCross_empty")
+	plainRepo := tu.UniqueString("TestLazyEmptyFilesMixedCross_plain")
+	for _, repo := range []string{lazyRepo, emptyRepo, plainRepo} {
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(client.NewCommit(repo, "master", ""), "file", strings.NewReader("foo\n")))
+	}
+
+	// Setting both lazy and empty_files on one input is rejected
+	pipeline := tu.UniqueString("TestLazyEmptyFilesMixedCross")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd: []string{"bash"},
+			Stdin: []string{
+				// The lazy input is a named pipe, the empty input is an empty
+				// file and the plain input is a regular file with content
+				fmt.Sprintf("test -p /pfs/%s/file", lazyRepo),
+				fmt.Sprintf("test -f /pfs/%s/file && ! test -s /pfs/%s/file", emptyRepo, emptyRepo),
+				fmt.Sprintf("test -f /pfs/%s/file && test -s /pfs/%s/file && ! test -p /pfs/%s/file", plainRepo, plainRepo, plainRepo),
+				fmt.Sprintf("cat /pfs/%s/file /pfs/%s/file > /pfs/out/file", lazyRepo, plainRepo),
+			},
+		},
+		Input: client.NewCrossInput(
+			&pps.Input{Pfs: &pps.PFSInput{Repo: lazyRepo, Glob: "/", Lazy: true, EmptyFiles: true}},
+			&pps.Input{Pfs: &pps.PFSInput{Repo: emptyRepo, Glob: "/", EmptyFiles: true}},
+			client.NewPFSInput(plainRepo, "/"),
+		),
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.YesError(t, err)
+	require.Matches(t, "both 'lazy' and 'empty_files'", err.Error())
+	request.Input.Cross[0].Pfs.EmptyFiles = false
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	commitInfo, err := c.InspectCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	commitInfos, err := c.WaitCommitSetAll(commitInfo.Commit.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "file", &buf))
+	require.Equal(t, "foo\nfoo\n", buf.String())
+}
+
+func TestPipelineNoMeta(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineNoMeta_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineNoMeta")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd:   []string{"bash"},
+			Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		},
+		Input:           client.NewPFSInput(dataRepo, "/*"),
+		ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+		NoMeta:          true,
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	_, err = c.InspectRepo(pipeline)
+	require.NoError(t, err)
+	_, err = c.PfsAPIClient.InspectRepo(context.Background(), &pfs.InspectRepoRequest{
+		Repo: client.NewSystemRepo(pipeline, pfs.MetaRepoType),
+	})
+	require.YesError(t, err)
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "file-1", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+	// The pipeline has no meta repo, so the commitset only has a commit in
+	// the data and output repos
+	commitInfos, err := c.WaitCommitSetAll(commit1.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	jobInfo, err := c.InspectJob(pipeline, commit1.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, "", jobInfo.Details.DatumFilesetId)
+	_, err = c.ListDatumAll(pipeline, commit1.ID)
+	require.YesError(t, err)
+	require.Matches(t, "no_meta", err.Error())
+
+	// Without a record of the parent's datums, every datum is reprocessed
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "file-2", strings.NewReader("bar")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+	_, err = c.WaitCommitSetAll(commit2.ID)
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(pipeline, commit2.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(2), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+
+	// The output is rewritten from scratch, so deleted inputs leave no output
+	commit3, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteFile(commit3, "file-1"))
+	require.NoError(t, c.FinishCommit(dataRepo, commit3.Branch.Name, commit3.ID))
+	_, err = c.WaitCommitSetAll(commit3.ID)
+	require.NoError(t, err)
+	files, err := c.ListFileAll(client.NewCommit(pipeline, "master", commit3.ID), "/")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	require.Equal(t, "/file-2", files[0].File.Path)
+
+	request.Update = true
+	request.NoMeta = false
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.YesError(t, err)
+	require.Matches(t, "no_meta cannot be changed", err.Error())
+}
+
+func TestPipelineOutputControlFiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineOutputControlFiles_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineOutputControlFiles")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+			fmt.Sprintf("echo processed $(ls /pfs/%s) > /pfs/out/%s", dataRepo, client.OutputDescriptionFile),
+			fmt.Sprintf("if [ -f /pfs/%s/bad ]; then echo found bad input > /pfs/out/%s; fi", dataRepo, client.OutputErrorFile),
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(dataRepo, "/"),
+		"",
+		false,
+	))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "good", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+	commitInfos, err := c.WaitCommitSetAll(commit1.ID)
+	require.NoError(t, err)
+	outCommit := client.NewCommit(pipeline, "master", commit1.ID)
+	for _, ci := range commitInfos {
+		if proto.Equal(ci.Commit.Branch.Repo, outCommit.Branch.Repo) {
+			require.Equal(t, "processed good", ci.Description)
+			require.Equal(t, "", ci.Error)
+		}
+	}
+	jobInfo, err := c.InspectJob(pipeline, commit1.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	// The control files are removed from the output
+	files, err := c.ListFileAll(outCommit, "/")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	require.Equal(t, "/good", files[0].File.Path)
+
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "bad", strings.NewReader("bar")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+	_, err = c.WaitCommitSetAll(commit2.ID)
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(pipeline, commit2.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	require.Equal(t, "found bad input", jobInfo.Reason)
+	commitInfo, err := c.InspectCommit(pipeline, "master", commit2.ID)
+	require.NoError(t, err)
+	require.Equal(t, "found bad input", commitInfo.Error)
+	require.Equal(t, "processed bad good", commitInfo.Description)
+}
+
+func TestRestartJobDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRestartJobDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestRestartJobDatums")
+	// The "bad" datum fails the first time it's processed by the worker, as
+	// though an external resource it depends on was briefly unavailable
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*; do", dataRepo),
+					"  if grep -q bad $f && [ ! -f /tmp/available ]; then",
+					"    touch /tmp/available",
+					"    exit 1",
+					"  fi",
+					"  cp $f /pfs/out/",
+					"done",
+				},
+			},
+			Input:      client.NewPFSInput(dataRepo, "/*"),
+			DatumTries: 1,
+		})
+	require.NoError(t, err)
+
+	numGood := 3
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < numGood; i++ {
+		require.NoError(t, c.PutFile(commit1, fmt.Sprintf("good%d", i), strings.NewReader("good")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+	jobInfo, err := c.WaitJob(pipeline, commit1.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "bad", strings.NewReader("bad")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+	jobInfo, err = c.WaitJob(pipeline, commit2.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+
+	// Only the pipeline's most recent job can be restarted
+	_, err = c.RestartJobDatums(pipeline, commit1.ID, true)
+	require.YesError(t, err)
+	require.Matches(t, "not the most recent job", err.Error())
+
+	// Restarting only the failed datums leaves the others skipped
+	job, err := c.RestartJobDatums(pipeline, commit2.ID, true)
+	require.NoError(t, err)
+	jobInfo, err = c.WaitJob(pipeline, job.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(numGood), jobInfo.DataSkipped)
+
+	// Restarting every datum processes all of them again
+	job, err = c.RestartJobDatums(pipeline, job.ID, false)
+	require.NoError(t, err)
+	jobInfo, err = c.WaitJob(pipeline, job.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(numGood+1), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+}
+
+func TestPipelineMaintenanceWindow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPipelineMaintenanceWindow_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPipelineMaintenanceWindow")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd:   []string{"bash"},
+			Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+		},
+		Input: client.NewPFSInput(dataRepo, "/*"),
+		// A window during the first half of every minute
+		MaintenanceSchedule: &pps.MaintenanceSchedule{
+			Cron:     "@every 1m",
+			Duration: types.DurationProto(30 * time.Second),
+		},
+	}
+	// Interval schedules are rejected
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.YesError(t, err)
+	require.Matches(t, "invalid maintenance_schedule", err.Error())
+
+	request.MaintenanceSchedule.Cron = "* * * * *"
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), request)
+	require.NoError(t, err)
+
+	pipelineInfo, err := c.InspectPipeline(pipeline, true)
+	require.NoError(t, err)
+	require.NotNil(t, pipelineInfo.Details.MaintenanceWindow)
+	start, err := types.TimestampFromProto(pipelineInfo.Details.MaintenanceWindow.Start)
+	require.NoError(t, err)
+	end, err := types.TimestampFromProto(pipelineInfo.Details.MaintenanceWindow.End)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, end.Sub(start))
+
+	// Wait for the pipeline to be paused by a window, then commit during it
+	require.NoError(t, backoff.Retry(func() error {
+		pipelineInfo, err = c.InspectPipeline(pipeline, true)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.State != pps.PipelineState_PIPELINE_PAUSED {
+			return errors.Errorf("expected pipeline to be in state PAUSED, but was in %s",
+				pipelineInfo.State)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+	require.Matches(t, "maintenance window", pipelineInfo.Reason)
+	require.False(t, pipelineInfo.Stopped)
+	end, err = types.TimestampFromProto(pipelineInfo.Details.MaintenanceWindow.End)
+	require.NoError(t, err)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+
+	// The job doesn't finish until the window has closed
+	jobInfo, err := c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	finished, err := types.TimestampFromProto(jobInfo.Finished)
+	require.NoError(t, err)
+	require.True(t, !finished.Before(end))
+
+	pipelineInfo, err = c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.NotEqual(t, pps.PipelineState_PIPELINE_FAILURE, pipelineInfo.State)
+}
+
+func TestUpdatePipelineReprocessGlob(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestUpdatePipelineReprocessGlob_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestUpdatePipelineReprocessGlob")
+	request := func(version string) *pps.CreatePipelineRequest {
+		return &pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*/*; do", dataRepo),
+					fmt.Sprintf("  echo %s > /pfs/out/$(basename $(dirname $f))_$(basename $f)", version),
+					"done",
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*/*"),
+		}
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), request("v1"))
+	require.NoError(t, err)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for _, p := range []string{"a/1", "a/2", "b/1"} {
+		require.NoError(t, c.PutFile(commit, p, strings.NewReader(p)))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	_, err = c.WaitCommitSetAll(commit.ID)
+	require.NoError(t, err)
+
+	// reprocess_glob is only valid for updates
+	req := request("v2")
+	req.ReprocessGlob = "/a/*"
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), req)
+	require.YesError(t, err)
+	require.Matches(t, "reprocess_glob", err.Error())
+
+	// Only the datums under /a are reprocessed by the updated pipeline
+	req.Update = true
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), req)
+	require.NoError(t, err)
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		jobInfos, err := c.ListJob(pipeline, nil, -1, true)
+		if err != nil {
+			return err
+		}
+		if len(jobInfos) != 2 {
+			return errors.Errorf("expected 2 jobs, got %d", len(jobInfos))
+		}
+		return nil
+	})
+	jobInfos, err := c.ListJob(pipeline, nil, -1, true)
+	require.NoError(t, err)
+	jobInfo, err := c.WaitJob(pipeline, jobInfos[0].Job.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, uint64(2), jobInfo.PipelineVersion)
+	require.Equal(t, int64(2), jobInfo.DataProcessed)
+	require.Equal(t, int64(1), jobInfo.DataSkipped)
+
+	expected := map[string]string{"a_1": "v2\n", "a_2": "v2\n", "b_1": "v1\n"}
+	for name, content := range expected {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(jobInfo.OutputCommit, name, &buf))
+		require.Equal(t, content, buf.String())
+	}
+
+	// Later jobs skip as usual
+	commit, err = c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "c/1", strings.NewReader("c/1")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	jobInfo, err = c.WaitJob(pipeline, commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	require.Equal(t, int64(3), jobInfo.DataSkipped)
+}
+
+func TestPFSInputLeafDirs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestPFSInputLeafDirs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit := client.NewCommit(dataRepo, "master", "")
+	for _, p := range []string{"s1/a", "s1/b", "s2/x/c", "s2/y/d", "s3/e", "s3/sub/f", "top"} {
+		require.NoError(t, c.PutFile(commit, p, strings.NewReader(p)))
+	}
+
+	leafDirs := func(globs ...string) []string {
+		dis, err := c.ListDatumInputAll(&pps.Input{
+			Pfs: &pps.PFSInput{
+				Repo:     dataRepo,
+				Glob:     globs[0],
+				Globs:    globs[1:],
+				LeafDirs: true,
+			},
+		})
+		require.NoError(t, err)
+		var paths []string
+		for _, di := range dis {
+			require.Equal(t, 1, len(di.Data))
+			paths = append(paths, di.Data[0].File.Path)
+		}
+		sort.Strings(paths)
+		return paths
+	}
+	// Directories with subdirectories (/s2, /s3) and top-level files aren't datums
+	require.Equal(t, []string{"/s1/", "/s2/x/", "/s2/y/", "/s3/sub/"}, leafDirs("/"))
+	require.Equal(t, []string{"/s2/x/", "/s2/y/"}, leafDirs("/s2"))
+	// Overlapping matches produce each leaf directory once
+	require.Equal(t, []string{"/s1/", "/s2/x/", "/s2/y/", "/s3/sub/"}, leafDirs("/*", "/s2/*"))
+	require.Equal(t, []string{"/s1/"}, leafDirs("/s1/*", "/s1"))
+
+	// leaf_dirs is incompatible with join_on
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(tu.UniqueString("TestPFSInputLeafDirs")),
+		Transform: &pps.Transform{Cmd: []string{"true"}},
+		Input: &pps.Input{Pfs: &pps.PFSInput{
+			Repo:     dataRepo,
+			Glob:     "/(*)",
+			JoinOn:   "$1",
+			LeafDirs: true,
+		}},
+	})
+	require.YesError(t, err)
+	require.Matches(t, "leaf_dirs", err.Error())
+
+	// invalid globs are rejected when the pipeline is created
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(tu.UniqueString("TestPFSInputLeafDirs")),
+		Transform: &pps.Transform{Cmd: []string{"true"}},
+		Input: &pps.Input{Pfs: &pps.PFSInput{
+			Repo:  dataRepo,
+			Glob:  "/*",
+			Globs: []string{"/["},
+		}},
+	})
+	require.YesError(t, err)
+	require.Matches(t, "invalid glob", err.Error())
+}
+
+func TestInspectCluster(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	clusterInfo, err := c.InspectCluster()
+	require.NoError(t, err)
+	require.NotEqual(t, "", clusterInfo.ID)
+	require.NotEqual(t, "", clusterInfo.DeploymentID)
+	require.Equal(t, uint32(version.MajorVersion), clusterInfo.Version.Major)
+	require.Equal(t, uint32(version.MinorVersion), clusterInfo.Version.Minor)
+	require.OneOfEquals(t, fmt.Sprintf("v%d", version.MajorVersion), clusterInfo.APIVersions)
+	// DeleteAll deactivates auth
+	require.False(t, clusterInfo.AuthActive)
+}
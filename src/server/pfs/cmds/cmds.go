@@ -389,13 +389,21 @@ $ {{alias}} test@fork -p XXX`,
 			}
 			if raw {
 				return cmdutil.Encoder(output, os.Stdout).EncodeProto(commitInfo)
-			} else if output != "" {
-				return errors.New("cannot set --output (-o) without --raw")
+			} else if output != "" && output != "json" {
+				return cmdutil.ErrOutputRequiresRaw
 			}
 			ci := &pretty.PrintableCommitInfo{
 				CommitInfo:     commitInfo,
 				FullTimestamps: fullTimestamps,
 			}
+			if output == "json" {
+				result, err := pretty.MarshalJSONCommitInfo(ci)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(result))
+				return nil
+			}
 			return pretty.PrintDetailedCommitInfo(os.Stdout, ci)
 		}),
 	}
@@ -646,14 +654,22 @@ $ {{alias}} foo@XXX -b bar@baz`,
 
 			if raw {
 				return cmdutil.Encoder(output, os.Stdout).EncodeProto(commitInfo)
-			} else if output != "" {
-				return errors.New("cannot set --output (-o) without --raw")
+			} else if output != "" && output != "json" {
+				return cmdutil.ErrOutputRequiresRaw
 			}
 
 			ci := &pretty.PrintableCommitInfo{
 				CommitInfo:     commitInfo,
 				FullTimestamps: fullTimestamps,
 			}
+			if output == "json" {
+				result, err := pretty.MarshalJSONCommitInfo(ci)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(result))
+				return nil
+			}
 			return pretty.PrintDetailedCommitInfo(os.Stdout, ci)
 		}),
 	}
@@ -1542,6 +1558,7 @@ Objects are a low-level resource and should not be accessed directly by most use
 	commands = append(commands, cmdutil.CreateDocsAlias(objectDocs, "object", " object$"))
 
 	var fix bool
+	var fsckRepo string
 	fsck := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Run a file system consistency check on pfs.",
@@ -1553,15 +1570,21 @@ Objects are a low-level resource and should not be accessed directly by most use
 			}
 			defer c.Close()
 			errors := false
-			if err = c.Fsck(fix, func(resp *pfs.FsckResponse) error {
+			onResponse := func(resp *pfs.FsckResponse) error {
 				if resp.Error != "" {
 					errors = true
 					fmt.Printf("Error: %s\n", resp.Error)
 				} else {
-					fmt.Printf("Fix applied: %v", resp.Fix)
+					fmt.Printf("Fix applied: %v\n", resp.Fix)
 				}
 				return nil
-			}); err != nil {
+			}
+			if fsckRepo != "" {
+				err = c.FsckRepo(fsckRepo, fix, onResponse)
+			} else {
+				err = c.Fsck(fix, onResponse)
+			}
+			if err != nil {
 				return err
 			}
 			if !errors {
@@ -1571,6 +1594,7 @@ Objects are a low-level resource and should not be accessed directly by most use
 		}),
 	}
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
+	fsck.Flags().StringVar(&fsckRepo, "repo", "", "Only check (and fix) the given repo.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
 	var branchStr string
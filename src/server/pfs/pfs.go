@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"google.golang.org/grpc/codes"
@@ -233,6 +234,17 @@ func IsCommitNotFoundErr(err error) bool {
 	return commitNotFoundRe.MatchString(grpcutil.ScrubGRPC(err).Error())
 }
 
+// IsAncestorNotFoundErr returns true if 'err' is a commit-not-found error from
+// resolving 'commitID', and 'commitID' refers to an ancestor of a commit (e.g.
+// "<id>^") that goes back past the start of its branch.
+func IsAncestorNotFoundErr(commitID string, err error) bool {
+	if !IsCommitNotFoundErr(err) {
+		return false
+	}
+	_, ancestors, parseErr := ancestry.Parse(commitID)
+	return parseErr == nil && ancestors > 0
+}
+
 // IsCommitSetNotFoundErr returns true if 'err' has an error message that matches
 // ErrCommitSetNotFound
 func IsCommitSetNotFoundErr(err error) bool {
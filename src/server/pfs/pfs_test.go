@@ -3,12 +3,24 @@ package pfs
 import (
 	"testing"
 
-	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
+// newCommit is client.NewCommit, which can't be imported here as the client
+// imports this package.
+func newCommit(repo, branch, id string) *pfs.Commit {
+	return &pfs.Commit{
+		Branch: &pfs.Branch{
+			Repo: &pfs.Repo{Name: repo, Type: pfs.UserRepoType},
+			Name: branch,
+		},
+		ID: id,
+	}
+}
+
 func TestErrorMatching(t *testing.T) {
-	c := client.NewCommit("foo", "bar", "")
+	c := newCommit("foo", "bar", "")
 	require.True(t, IsCommitNotFoundErr(ErrCommitNotFound{c}))
 	require.False(t, IsCommitNotFoundErr(ErrCommitDeleted{c}))
 	require.False(t, IsCommitNotFoundErr(ErrCommitFinished{c}))
@@ -21,3 +33,11 @@ func TestErrorMatching(t *testing.T) {
 	require.False(t, IsCommitFinishedErr(ErrCommitDeleted{c}))
 	require.True(t, IsCommitFinishedErr(ErrCommitFinished{c}))
 }
+
+func TestAncestorNotFoundErr(t *testing.T) {
+	c := newCommit("foo", "bar", "0123456789abcdef0123456789abcdef")
+	require.True(t, IsAncestorNotFoundErr(c.ID+"^", ErrCommitNotFound{c}))
+	require.True(t, IsAncestorNotFoundErr(c.ID+"~2", ErrParentCommitNotFound{c}))
+	require.False(t, IsAncestorNotFoundErr(c.ID, ErrCommitNotFound{c}))
+	require.False(t, IsAncestorNotFoundErr(c.ID+"^", ErrCommitDeleted{c}))
+}
@@ -1,6 +1,7 @@
 package pretty
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"io"
@@ -10,6 +11,7 @@ import (
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
 	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -243,7 +245,9 @@ Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
 Finished: {{prettyAgo .Finished}}{{end}}{{end}}{{if .Details}}
-Size: {{prettySize .Details.SizeBytes}}{{end}}
+Size: {{prettySize .Details.SizeBytes}}
+Files: {{.Details.FileCount}}
+Directories: {{.Details.DirCount}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -251,6 +255,45 @@ Size: {{prettySize .Details.SizeBytes}}{{end}}
 	return template.Execute(w, commitInfo)
 }
 
+// commitInfoJSON is the structured form of the output of
+// PrintDetailedCommitInfo.
+type commitInfoJSON struct {
+	Commit         string `json:"commit"`
+	OriginalBranch string `json:"original_branch"`
+	Description    string `json:"description,omitempty"`
+	Parent         string `json:"parent,omitempty"`
+	Started        string `json:"started,omitempty"`
+	Finished       string `json:"finished,omitempty"`
+	Size           string `json:"size,omitempty"`
+	Files          int64  `json:"files,omitempty"`
+	Directories    int64  `json:"directories,omitempty"`
+}
+
+// MarshalJSONCommitInfo renders the same fields as PrintDetailedCommitInfo as
+// indented JSON, for tools that need to parse the output.
+func MarshalJSONCommitInfo(commitInfo *PrintableCommitInfo) ([]byte, error) {
+	ci := commitInfoJSON{
+		Commit:         commitInfo.Commit.Branch.Repo.Name + "@" + commitInfo.Commit.ID,
+		OriginalBranch: commitInfo.Commit.Branch.Name,
+		Description:    commitInfo.Description,
+		Started:        pretty.Timestamp(commitInfo.Started, commitInfo.FullTimestamps),
+		Finished:       pretty.Timestamp(commitInfo.Finished, commitInfo.FullTimestamps),
+	}
+	if commitInfo.ParentCommit != nil {
+		ci.Parent = commitInfo.ParentCommit.ID
+	}
+	if commitInfo.Details != nil {
+		ci.Size = pretty.Size(commitInfo.Details.SizeBytes)
+		ci.Files = commitInfo.Details.FileCount
+		ci.Directories = commitInfo.Details.DirCount
+	}
+	result, err := json.MarshalIndent(ci, "", "  ")
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return result, nil
+}
+
 // PrintFileInfo pretty-prints file info.
 // If recurse is false and directory size is 0, display "-" instead
 // If fast is true and file size is 0, display "-" instead
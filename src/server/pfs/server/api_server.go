@@ -3,6 +3,7 @@ package server
 import (
 	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,12 +15,14 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"gopkg.in/yaml.v3"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
@@ -224,7 +227,7 @@ func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.AP
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
+	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, request.CommitSet, func(ci *pfs.CommitInfo) error {
 		sent++
 		return respServer.Send(ci)
 	})
@@ -257,12 +260,32 @@ func (a *apiServer) ListCommitSet(request *pfs.ListCommitSetRequest, serv pfs.AP
 	})
 }
 
+// AliasCommitInTransaction creates an alias of 'commit' on 'branch' in the
+// transaction's commitset and propagates 'branch', so that downstream branches
+// get new commits even though the contents of 'branch' are unchanged.
+func (a *apiServer) AliasCommitInTransaction(txnCtx *txncontext.TransactionContext, commit *pfs.Commit, branch *pfs.Branch) (*pfs.Commit, error) {
+	commitInfo, err := a.driver.aliasCommit(txnCtx, commit, branch)
+	if err != nil {
+		return nil, err
+	}
+	if err := txnCtx.PropagateBranch(branch); err != nil {
+		return nil, err
+	}
+	return commitInfo.Commit, nil
+}
+
 // SquashCommitSetInTransaction is identical to SquashCommitSet except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) SquashCommitSetInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.SquashCommitSetRequest) error {
 	return a.driver.squashCommitSet(txnCtx, request.CommitSet)
 }
 
+// SquashCommitInTransaction squashes a single commit into its children,
+// leaving the rest of its CommitSet in place.  This is not an RPC.
+func (a *apiServer) SquashCommitInTransaction(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) error {
+	return a.driver.squashCommit(txnCtx, commit)
+}
+
 // SquashCommitSet implements the protobuf pfs.SquashCommitSet RPC
 func (a *apiServer) SquashCommitSet(ctx context.Context, request *pfs.SquashCommitSetRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -287,6 +310,18 @@ func (a *apiServer) DropCommitSet(ctx context.Context, request *pfs.DropCommitSe
 	return &types.Empty{}, nil
 }
 
+// DeleteCommit implements the protobuf pfs.DeleteCommit RPC
+func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteCommit(txnCtx, request.Commit)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // SubscribeCommit implements the protobuf pfs.SubscribeCommit RPC
 func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream pfs.API_SubscribeCommitServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -483,17 +518,25 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 	case "http":
 		fallthrough
 	case "https":
-		resp, err := http.Get(src.URL)
+		if src.Recursive {
+			return 0, errors.Errorf("recursive put file is not supported for %s urls", url.Scheme)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
 		if err != nil {
-			return 0, err
-		} else if resp.StatusCode >= 400 {
-			return 0, errors.Errorf("error retrieving content from %q: %s", src.URL, resp.Status)
+			return 0, errors.EnsureStack(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
 		}
 		defer func() {
 			if err := resp.Body.Close(); retErr == nil {
 				retErr = err
 			}
 		}()
+		if resp.StatusCode >= 400 {
+			return 0, errors.Errorf("error retrieving content from %q: %s", src.URL, resp.Status)
+		}
 		return 0, uw.Put(dstPath, tag, true, resp.Body)
 	default:
 		url, err := obj.ParseURL(src.URL)
@@ -544,7 +587,21 @@ func (a *apiServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.API_GetFi
 		err = grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
 			var err error
 			bytesWritten, err = withGetFileWriter(w, func(w io.Writer) error {
-				return getFileTar(ctx, w, src)
+				switch request.Compression {
+				case pfs.Compression_UNCOMPRESSED:
+					return getFileTar(ctx, w, src)
+				case pfs.Compression_GZIP:
+					gw, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+					if err != nil {
+						return errors.EnsureStack(err)
+					}
+					if err := getFileTar(ctx, gw, src); err != nil {
+						return err
+					}
+					return errors.EnsureStack(gw.Close())
+				default:
+					return errors.Errorf("unrecognized compression: %v", request.Compression)
+				}
 			})
 			return err
 		})
@@ -568,11 +625,30 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		if err := checkSingleFile(ctx, src); err != nil {
 			return 0, err
 		}
+		if request.Compression != pfs.Compression_UNCOMPRESSED {
+			if err := server.SetHeader(metadata.Pairs(pfs.CompressionHeader, request.Compression.String())); err != nil {
+				return 0, errors.EnsureStack(err)
+			}
+		}
 		var n int64
 		if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
 			n = fileset.SizeFromIndex(file.Index())
 			return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
-				return file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset))
+				switch request.Compression {
+				case pfs.Compression_UNCOMPRESSED:
+					return file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset), chunk.WithSizeBytes(request.SizeBytes))
+				case pfs.Compression_GZIP:
+					gw, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+					if err != nil {
+						return errors.EnsureStack(err)
+					}
+					if err := file.Content(ctx, gw, chunk.WithOffsetBytes(request.Offset), chunk.WithSizeBytes(request.SizeBytes)); err != nil {
+						return err
+					}
+					return errors.EnsureStack(gw.Close())
+				default:
+					return errors.Errorf("unrecognized compression: %v", request.Compression)
+				}
 			})
 		}); err != nil {
 			return 0, err
@@ -647,7 +723,7 @@ func getFileTar(ctx context.Context, w io.Writer, src Source) error {
 func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	return a.driver.inspectFile(ctx, request.File)
+	return a.driver.inspectFile(ctx, request.File, request.Digests...)
 }
 
 // ListFile implements the protobuf pfs.ListFile RPC
@@ -657,10 +733,16 @@ func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
+	if err := a.driver.listFile(server.Context(), request.File, request.PageToken, func(fi *pfs.FileInfo) error {
+		if request.PageSize > 0 && int64(sent) >= request.PageSize {
+			return errutil.ErrBreak
+		}
 		sent++
 		return server.Send(fi)
-	})
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return err
+	}
+	return nil
 }
 
 // WalkFile implements the protobuf pfs.WalkFile RPC
@@ -722,7 +804,7 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
 	}(time.Now())
-	if err := a.driver.fsck(fsckServer.Context(), request.Fix, func(resp *pfs.FsckResponse) error {
+	if err := a.driver.fsck(fsckServer.Context(), request.Repo, request.Fix, func(resp *pfs.FsckResponse) error {
 		sent++
 		return fsckServer.Send(resp)
 	}); err != nil {
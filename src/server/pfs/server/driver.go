@@ -22,6 +22,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -48,6 +49,8 @@ const (
 	fileSetsRepo         = client.FileSetsRepoName
 	defaultTTL           = client.DefaultTTL
 	maxTTL               = 30 * time.Minute
+	// digestCacheSize is the number of file digests kept in memory
+	digestCacheSize = 10000
 )
 
 // IsPermissionError returns true if a given error is a permission error.
@@ -82,6 +85,9 @@ type driver struct {
 	storage     *fileset.Storage
 	commitStore commitStore
 	compactor   *compactor
+	// digestCache holds file digests computed by inspectFile, keyed by
+	// algorithm and content hash
+	digestCache kv.GetPut
 }
 
 func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPrefix string) (*driver, error) {
@@ -105,13 +111,14 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 
 	// Setup driver struct.
 	d := &driver{
-		env:        env,
-		txnEnv:     txnEnv,
-		etcdClient: etcdClient,
-		prefix:     etcdPrefix,
-		repos:      repos,
-		commits:    commits,
-		branches:   branches,
+		env:         env,
+		txnEnv:      txnEnv,
+		etcdClient:  etcdClient,
+		prefix:      etcdPrefix,
+		repos:       repos,
+		commits:     commits,
+		branches:    branches,
+		digestCache: kv.NewMemCache(digestCacheSize),
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -436,6 +443,9 @@ func (d *driver) startCommit(
 	if branch == nil || branch.Name == "" {
 		return nil, errors.Errorf("branch must be specified")
 	}
+	if parent != nil && parent.Branch != nil && !proto.Equal(parent.Branch.Repo, branch.Repo) {
+		return nil, errors.Errorf("parent commit %s must be in the same repo as branch %s", parent, branch)
+	}
 	// Check that caller is authorized
 	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
 		return nil, err
@@ -1076,6 +1086,12 @@ func passesCommitOriginFilter(commitInfo *pfs.CommitInfo, all bool, originKind p
 	return commitInfo.Origin.Kind != pfs.OriginKind_ALIAS
 }
 
+// passesCommitSetFilter is a helper function for listCommit that returns true
+// if commitInfo is in commitSet, or if no commitSet is given.
+func passesCommitSetFilter(commitInfo *pfs.CommitInfo, commitSet *pfs.CommitSet) bool {
+	return commitSet == nil || commitInfo.Commit.ID == commitSet.ID
+}
+
 func (d *driver) listCommit(
 	ctx context.Context,
 	repo *pfs.Repo,
@@ -1085,6 +1101,7 @@ func (d *driver) listCommit(
 	reverse bool,
 	all bool,
 	originKind pfs.OriginKind,
+	commitSet *pfs.CommitSet,
 	cb func(*pfs.CommitInfo) error,
 ) error {
 	// Validate arguments
@@ -1168,7 +1185,7 @@ func (d *driver) listCommit(
 				}
 				lastRev = createRev
 			}
-			if passesCommitOriginFilter(ci, all, originKind) {
+			if passesCommitOriginFilter(ci, all, originKind) && passesCommitSetFilter(ci, commitSet) {
 				cis = append(cis, proto.Clone(ci).(*pfs.CommitInfo))
 			}
 			return nil
@@ -1205,7 +1222,7 @@ func (d *driver) listCommit(
 			if err := d.commits.ReadOnly(ctx).Get(cursor, commitInfo); err != nil {
 				return err
 			}
-			if passesCommitOriginFilter(commitInfo, all, originKind) {
+			if passesCommitOriginFilter(commitInfo, all, originKind) && passesCommitSetFilter(commitInfo, commitSet) {
 				if err := cb(commitInfo); err != nil {
 					if errors.Is(err, errutil.ErrBreak) {
 						return nil
@@ -1340,6 +1357,7 @@ func (d *driver) listCommitSet(ctx context.Context, cb func(*pfs.CommitSetInfo)
 		return cb(&pfs.CommitSetInfo{
 			CommitSet: client.NewCommitSet(commitInfo.Commit.ID),
 			Commits:   commitInfos,
+			Edges:     pfs.CommitSetEdges(commitInfos),
 		})
 	})
 }
@@ -1521,6 +1539,48 @@ func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset
 	return nil
 }
 
+// deleteCommit deletes a single commit, leaving the rest of its CommitSet in
+// place. Only user commits that no other commit in the CommitSet is provenant
+// on can be deleted, so that no downstream output is orphaned.
+func (d *driver) deleteCommit(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) error {
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Origin.Kind != pfs.OriginKind_USER {
+		return errors.Errorf("cannot delete commit %s because it was not created by a user", commitInfo.Commit)
+	}
+	if commitInfo.Commit.Branch.Repo.Type == pfs.SpecRepoType {
+		return errors.Errorf("cannot delete commit %s because it updated a pipeline", commitInfo.Commit)
+	}
+	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, client.NewCommitSet(commitInfo.Commit.ID))
+	if err != nil {
+		return err
+	}
+	for _, ci := range commitInfos {
+		for _, b := range ci.DirectProvenance {
+			if proto.Equal(b, commitInfo.Commit.Branch) {
+				return errors.Errorf("cannot delete commit %s because commit %s is downstream of it", commitInfo.Commit, ci.Commit)
+			}
+		}
+	}
+	return d.squashCommitSetInternal(txnCtx, []*pfs.CommitInfo{commitInfo})
+}
+
+// squashCommit squashes a single commit into its children, leaving the rest of
+// its CommitSet in place. Like squashCommitSet, it fails if the commit has no
+// children.
+func (d *driver) squashCommit(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) error {
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	if len(commitInfo.ChildCommits) == 0 {
+		return &pfsserver.ErrSquashWithoutChildren{Commit: commitInfo.Commit}
+	}
+	return d.squashCommitSetInternal(txnCtx, []*pfs.CommitInfo{commitInfo})
+}
+
 func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet) error {
 	// Look up the commits in the CommitSet
 	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitset)
@@ -1,6 +1,10 @@
 package server
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"hash"
+	"io"
 	"path"
 	"path/filepath"
 	"strings"
@@ -171,6 +175,20 @@ func (d *driver) copyFile(ctx context.Context, uw *fileset.UnorderedWriter, dst
 	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 		return idx.Path == srcPath || strings.HasPrefix(idx.Path, srcPath+"/")
 	})
+	if srcPath != "/" {
+		// Copying a path that doesn't exist is almost certainly a mistake, so
+		// surface it rather than silently copying nothing.
+		var exists bool
+		if err := fs.Iterate(ctx, func(_ fileset.File) error {
+			exists = true
+			return errutil.ErrBreak
+		}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+			return err
+		}
+		if !exists {
+			return &pfsserver.ErrFileNotFound{File: src}
+		}
+	}
 	fs = fileset.NewIndexMapper(fs, func(idx *index.Index) *index.Index {
 		idx2 := *idx
 		idx2.Path = pathTransform(idx2.Path)
@@ -201,7 +219,7 @@ func (d *driver) getFile(ctx context.Context, file *pfs.File) (Source, error) {
 	return NewErrOnEmpty(s, &pfsserver.ErrFileNotFound{File: file}), nil
 }
 
-func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo, error) {
+func (d *driver) inspectFile(ctx context.Context, file *pfs.File, digests ...string) (*pfs.FileInfo, error) {
 	p := cleanPath(file.Path)
 	if p == "/" {
 		p = ""
@@ -224,6 +242,14 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 		p2 := fi.File.Path
 		if p2 == p || p2 == p+"/" {
 			ret = fi
+			if len(digests) > 0 {
+				if fi.FileType != pfs.FileType_FILE {
+					return errors.Errorf("cannot compute digests of directory %q", p2)
+				}
+				var err error
+				ret.Digests, err = d.fileDigests(ctx, fi, f, digests)
+				return err
+			}
 		}
 		return nil
 	}); err != nil {
@@ -232,9 +258,82 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	return ret, nil
 }
 
-func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
+// newDigest returns a hash for the named digest algorithm.
+func newDigest(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "md5":
+		return md5.New(), nil
+	case "sha256":
+		return sha256.New(), nil
+	default:
+		return nil, errors.Errorf("unsupported digest algorithm %q", algorithm)
+	}
+}
+
+// fileDigests computes the requested digests of a file's content. Digests are
+// cached by the file's content hash, so the content is only read for digests
+// that haven't been computed before.
+func (d *driver) fileDigests(ctx context.Context, fi *pfs.FileInfo, f fileset.File, algorithms []string) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+	hashes := make(map[string]hash.Hash)
+	var writers []io.Writer
+	for _, algorithm := range algorithms {
+		if _, ok := digests[algorithm]; ok {
+			continue
+		}
+		if _, ok := hashes[algorithm]; ok {
+			continue
+		}
+		h, err := newDigest(algorithm)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.digestCache.Get(ctx, digestCacheKey(algorithm, fi.Hash), func(v []byte) error {
+			digests[algorithm] = append([]byte{}, v...)
+			return nil
+		}); err == nil {
+			continue
+		} else if !pacherr.IsNotExist(err) {
+			return nil, err
+		}
+		hashes[algorithm] = h
+		writers = append(writers, h)
+	}
+	if len(writers) == 0 {
+		return digests, nil
+	}
+	if err := f.Content(ctx, io.MultiWriter(writers...)); err != nil {
+		return nil, err
+	}
+	for algorithm, h := range hashes {
+		digests[algorithm] = h.Sum(nil)
+		if err := d.digestCache.Put(ctx, digestCacheKey(algorithm, fi.Hash), digests[algorithm]); err != nil {
+			return nil, err
+		}
+	}
+	return digests, nil
+}
+
+func digestCacheKey(algorithm string, contentHash []byte) []byte {
+	return append([]byte(algorithm+"/"), contentHash...)
+}
+
+// listFile lists the files in the directory 'file'. If pageToken is set, the
+// listing resumes after the file or directory with that path.
+func (d *driver) listFile(ctx context.Context, file *pfs.File, pageToken string, cb func(*pfs.FileInfo) error) error {
 	name := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithDatum(file.Datum))
+	filter := index.WithPrefix(name)
+	if pageToken != "" {
+		// Seek past the token, and past everything under it if it's a
+		// directory. Paths are UTF-8, so they never contain 0xff, and every
+		// path with the name as a prefix sorts before name+"\xff".
+		lower := pageToken + "\x00"
+		if strings.HasSuffix(pageToken, "/") {
+			lower = pageToken + "\xff"
+		}
+		filter = index.WithRange(&index.PathRange{Lower: lower, Upper: name + "\xff"})
+	}
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, filter, index.WithDatum(file.Datum))
 	if err != nil {
 		return err
 	}
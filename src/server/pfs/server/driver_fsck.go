@@ -10,8 +10,10 @@ import (
 
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 )
 
 func equalBranches(a, b []*pfs.Branch) bool {
@@ -129,11 +131,30 @@ func (e ErrMissingBranchHead) Error() string {
 // 1. Branch provenance is transitive
 // 2. Head commit provenance has heads of branch's branch provenance
 // If fix is true it will attempt to fix as many of these issues as it can.
-func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse) error) error {
-	onError := func(err error) error { return cb(&pfs.FsckResponse{Error: err.Error()}) }
-
-	// TODO(global ids): no fixable fsck issues?
-	// onFix := func(fix string) error { return cb(&pfs.FsckResponse{Fix: fix}) }
+// Currently, missing subvenance and missing child commits can be fixed, as
+// both are the back-references of a relationship the other side records.
+// If repo is set, only the problems in that repo are reported and fixed.
+func (d *driver) fsck(ctx context.Context, repo *pfs.Repo, fix bool, cb func(*pfs.FsckResponse) error) error {
+	if repo != nil {
+		if err := d.repos.ReadOnly(ctx).Get(pfsdb.RepoKey(repo), &pfs.RepoInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return errors.EnsureStack(err)
+		}
+	}
+	inScope := func(r *pfs.Repo) bool {
+		return repo == nil || pfsdb.RepoKey(r) == pfsdb.RepoKey(repo)
+	}
+	onError := func(commit *pfs.Commit, err error) error {
+		return cb(&pfs.FsckResponse{Error: err.Error(), Commit: commit})
+	}
+	onFix := func(commit *pfs.Commit, fix string) error {
+		return cb(&pfs.FsckResponse{Fix: fix, Commit: commit})
+	}
+	// the fixable problems found, applied after all checks if 'fix' is set
+	var missingSubvenance []ErrBranchSubvenanceTransitivity
+	var missingChildren []ErrCommitAncestryBroken
 
 	// collect all the info for the branches and commits in pfs
 	branchInfos := make(map[string]*pfs.BranchInfo)
@@ -171,30 +192,44 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 			}
 		}
 
-		if !equalBranches(append(bi.Provenance, bi.Branch), union) {
-			if err := onError(ErrBranchProvenanceTransitivity{
-				BranchInfo:     bi,
-				FullProvenance: union,
-			}); err != nil {
-				return err
-			}
-		}
-
-		// every provenant branch should have this branch in its subvenance
+		// every provenant branch should have this branch in its subvenance.
+		// The problem belongs to the provenant branch, so it's checked even if
+		// this branch is out of scope, and skipped if the provenant one is.
 		for _, provBranch := range bi.Provenance {
 			provBranchInfo := branchInfos[pfsdb.BranchKey(provBranch)]
+			if provBranchInfo == nil {
+				continue // reported as ErrBranchInfoNotFound below
+			}
+			if !inScope(provBranchInfo.Branch.Repo) {
+				continue
+			}
 			if !branchInSet(bi.Branch, provBranchInfo.Subvenance) {
-				if err := onError(ErrBranchSubvenanceTransitivity{
+				problem := ErrBranchSubvenanceTransitivity{
 					BranchInfo:        provBranchInfo,
 					MissingSubvenance: bi.Branch,
-				}); err != nil {
+				}
+				if err := onError(provBranchInfo.Head, problem); err != nil {
 					return err
 				}
+				missingSubvenance = append(missingSubvenance, problem)
+			}
+		}
+
+		if !inScope(bi.Branch.Repo) {
+			continue
+		}
+
+		if !equalBranches(append(bi.Provenance, bi.Branch), union) {
+			if err := onError(bi.Head, ErrBranchProvenanceTransitivity{
+				BranchInfo:     bi,
+				FullProvenance: union,
+			}); err != nil {
+				return err
 			}
 		}
 
 		if bi.Head == nil {
-			if err := onError(ErrMissingBranchHead{
+			if err := onError(nil, ErrMissingBranchHead{
 				Branch: bi.Branch,
 			}); err != nil {
 				return err
@@ -207,7 +242,7 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 			for _, provBranch := range bi.Provenance {
 				provBranchInfo, ok := branchInfos[pfsdb.BranchKey(provBranch)]
 				if !ok {
-					if err := onError(ErrBranchInfoNotFound{Branch: provBranch}); err != nil {
+					if err := onError(bi.Head, ErrBranchInfoNotFound{Branch: provBranch}); err != nil {
 						return err
 					}
 					continue
@@ -215,7 +250,7 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 				if provBranchInfo.Head != nil {
 					// in this case, the headCommit Provenance should contain provBranch.Head
 					if _, ok := commitInfos[pfsdb.CommitKey(bi.Head)]; !ok {
-						if err := onError(ErrCommitInfoNotFound{
+						if err := onError(bi.Head, ErrCommitInfoNotFound{
 							Location: "head commit provenance (=>)",
 							Commit:   bi.Head,
 						}); err != nil {
@@ -229,11 +264,14 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 
 	// For every commit
 	for _, commitInfo := range commitInfos {
+		if !inScope(commitInfo.Commit.Branch.Repo) {
+			continue
+		}
 		// Every parent commit info should exist and point to this as a child
 		if commitInfo.ParentCommit != nil {
 			parentCommitInfo, ok := commitInfos[pfsdb.CommitKey(commitInfo.ParentCommit)]
 			if !ok {
-				if err := onError(ErrCommitInfoNotFound{
+				if err := onError(commitInfo.Commit, ErrCommitInfoNotFound{
 					Location: fmt.Sprintf("parent commit of %s", commitInfo.Commit),
 					Commit:   commitInfo.ParentCommit,
 				}); err != nil {
@@ -249,12 +287,14 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 				}
 
 				if !found {
-					if err := onError(ErrCommitAncestryBroken{
+					problem := ErrCommitAncestryBroken{
 						Parent: parentCommitInfo.Commit,
 						Child:  commitInfo.Commit,
-					}); err != nil {
+					}
+					if err := onError(commitInfo.Commit, problem); err != nil {
 						return err
 					}
+					missingChildren = append(missingChildren, problem)
 				}
 			}
 		}
@@ -264,7 +304,7 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 			childCommitInfo, ok := commitInfos[pfsdb.CommitKey(child)]
 
 			if !ok {
-				if err := onError(ErrCommitInfoNotFound{
+				if err := onError(commitInfo.Commit, ErrCommitInfoNotFound{
 					Location: fmt.Sprintf("child commit of %s", commitInfo.Commit),
 					Commit:   child,
 				}); err != nil {
@@ -272,7 +312,7 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 				}
 			} else {
 				if childCommitInfo.ParentCommit == nil || !proto.Equal(childCommitInfo.ParentCommit, commitInfo.Commit) {
-					if err := onError(ErrCommitAncestryBroken{
+					if err := onError(commitInfo.Commit, ErrCommitAncestryBroken{
 						Parent: commitInfo.Commit,
 						Child:  childCommitInfo.Commit,
 					}); err != nil {
@@ -286,7 +326,9 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 	// TODO(global ids): is there any verification we can do for commitsets?
 
 	if fix {
-		return dbutil.WithTx(ctx, d.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+		var fixes []*pfs.FsckResponse
+		if err := dbutil.WithTx(ctx, d.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+			fixes = nil
 			for _, ci := range newCommitInfos {
 				// We've observed users getting ErrExists from this create,
 				// which doesn't make a lot of sense, but we insulate against
@@ -295,8 +337,41 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 					return err
 				}
 			}
+			for _, problem := range missingSubvenance {
+				branchInfo := &pfs.BranchInfo{}
+				if err := d.branches.ReadWrite(sqlTx).Update(problem.BranchInfo.Branch, branchInfo, func() error {
+					add(&branchInfo.Subvenance, problem.MissingSubvenance)
+					return nil
+				}); err != nil {
+					return errors.EnsureStack(err)
+				}
+				fixes = append(fixes, &pfs.FsckResponse{
+					Commit: branchInfo.Head,
+					Fix:    fmt.Sprintf("added branch %s to the subvenance of branch %s", problem.MissingSubvenance, problem.BranchInfo.Branch),
+				})
+			}
+			for _, problem := range missingChildren {
+				parentInfo := &pfs.CommitInfo{}
+				if err := d.commits.ReadWrite(sqlTx).Update(problem.Parent, parentInfo, func() error {
+					parentInfo.ChildCommits = append(parentInfo.ChildCommits, problem.Child)
+					return nil
+				}); err != nil {
+					return errors.EnsureStack(err)
+				}
+				fixes = append(fixes, &pfs.FsckResponse{
+					Commit: problem.Child,
+					Fix:    fmt.Sprintf("added commit %s to the children of commit %s", problem.Child, problem.Parent),
+				})
+			}
 			return nil
-		})
+		}); err != nil {
+			return err
+		}
+		for _, f := range fixes {
+			if err := onFix(f.Commit, f.Fix); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -90,7 +90,7 @@ func (d *driver) finishCommits(ctx context.Context) error {
 			compactingDuration := time.Since(start)
 			// Validate the commit.
 			start = time.Now()
-			size, validationError, err := d.validate(ctx, totalId)
+			stats, validationError, err := d.validate(ctx, totalId)
 			if err != nil {
 				return err
 			}
@@ -100,11 +100,13 @@ func (d *driver) finishCommits(ctx context.Context) error {
 				commitInfo := &pfs.CommitInfo{}
 				if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
 					commitInfo.Finished = txnCtx.Timestamp
-					commitInfo.SizeBytesUpperBound = size
+					commitInfo.SizeBytesUpperBound = stats.sizeBytes
 					if commitInfo.Details == nil {
 						commitInfo.Details = &pfs.CommitInfo_Details{}
 					}
-					commitInfo.Details.SizeBytes = size
+					commitInfo.Details.SizeBytes = stats.sizeBytes
+					commitInfo.Details.FileCount = stats.files
+					commitInfo.Details.DirCount = stats.dirs
 					if commitInfo.Error == "" {
 						commitInfo.Error = validationError
 					}
@@ -133,15 +135,50 @@ func (d *driver) finishCommits(ctx context.Context) error {
 	}, watch.IgnoreDelete)
 }
 
+// commitStats are the statistics about a commit's files that are computed
+// while it's validated.
+type commitStats struct {
+	sizeBytes   int64
+	files, dirs int64
+	prevPath    string
+	prevDirs    []string
+}
+
+// add counts the file at p. Files must be added in lexical order, so that the
+// contents of each directory are added contiguously.
+func (s *commitStats) add(p string) {
+	if p == s.prevPath {
+		// the same file, output by another datum
+		return
+	}
+	s.prevPath = p
+	dir := path.Dir(p)
+	if fileset.IsDir(p) {
+		dir = strings.TrimSuffix(p, "/")
+	} else {
+		s.files++
+	}
+	var dirs []string
+	if dir = strings.Trim(dir, "/"); dir != "" {
+		dirs = strings.Split(dir, "/")
+	}
+	common := 0
+	for common < len(dirs) && common < len(s.prevDirs) && dirs[common] == s.prevDirs[common] {
+		common++
+	}
+	s.dirs += int64(len(dirs) - common)
+	s.prevDirs = dirs
+}
+
 // TODO(2.0 optional): Improve the performance of this by doing a logarithmic lookup per new file,
 // rather than a linear scan through all of the files.
-func (d *driver) validate(ctx context.Context, id *fileset.ID) (int64, string, error) {
+func (d *driver) validate(ctx context.Context, id *fileset.ID) (*commitStats, string, error) {
 	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
 	if err != nil {
-		return 0, "", err
+		return nil, "", err
 	}
 	var prev *index.Index
-	var size int64
+	stats := &commitStats{}
 	var validationError string
 	if err := fs.Iterate(ctx, func(f fileset.File) error {
 		idx := f.Index()
@@ -153,12 +190,13 @@ func (d *driver) validate(ctx context.Context, id *fileset.ID) (int64, string, e
 			}
 		}
 		prev = idx
-		size += index.SizeBytes(idx)
+		stats.sizeBytes += index.SizeBytes(idx)
+		stats.add(idx.Path)
 		return nil
 	}); err != nil {
-		return 0, "", err
+		return nil, "", err
 	}
-	return size, validationError, nil
+	return stats, validationError, nil
 }
 
 // finishAliasDescendents will traverse the given commit's descendents, finding all
@@ -3,11 +3,16 @@ package testing
 import (
 	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -21,10 +26,12 @@ import (
 
 	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
@@ -745,6 +752,35 @@ func TestPFS(suite *testing.T) {
 		require.True(t, finished.Before(tFinished))
 	})
 
+	suite.Run("InspectCommitFileCount", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		for _, file := range []string{"foo", "a/bar", "a/b/buzz", "a/b/fizz", "a-b/c/d/e", "z/y"} {
+			require.NoError(t, env.PachClient.PutFile(commit, file, strings.NewReader(file)))
+		}
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+		commitInfo, err := env.PachClient.WaitCommit(repo, commit.Branch.Name, commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), commitInfo.Details.FileCount)
+		// a/, a/b/, a-b/, a-b/c/, a-b/c/d/, z/
+		require.Equal(t, int64(6), commitInfo.Details.DirCount)
+
+		// The counts reflect deletions in later commits
+		commit, err = env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(commit, "a-b"))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+		commitInfo, err = env.PachClient.WaitCommit(repo, commit.Branch.Name, commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), commitInfo.Details.FileCount)
+		require.Equal(t, int64(3), commitInfo.Details.DirCount)
+	})
+
 	suite.Run("InspectCommitWait", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -881,6 +917,86 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, 0, int(repoInfo.Details.SizeBytes))
 	})
 
+	suite.Run("DeleteCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "foo", strings.NewReader("foo\n")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", ""))
+
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "bar", strings.NewReader("bar\n")))
+
+		require.NoError(t, env.PachClient.DeleteCommit(commit2))
+		_, err = env.PachClient.InspectCommit(repo, commit2.Branch.Name, commit2.ID)
+		require.YesError(t, err)
+
+		// The head has been set to the parent, and its data is unchanged
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commit1.ID, commitInfo.Commit.ID)
+		require.Equal(t, 0, len(commitInfo.ChildCommits))
+		files, err := env.PachClient.ListFileAll(commitInfo.Commit, "")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), repoInfo.Details.SizeBytes)
+	})
+
+	suite.Run("DeleteCommitWithChildren", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		var commits []*pfs.Commit
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(commit, fmt.Sprintf("file-%d", i), strings.NewReader("foo\n")))
+			require.NoError(t, finishCommit(env.PachClient, repo, "master", ""))
+			commits = append(commits, commit)
+		}
+
+		// Deleting the middle commit reparents its child onto its parent
+		require.NoError(t, env.PachClient.DeleteCommit(commits[1]))
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", commits[2].ID)
+		require.NoError(t, err)
+		require.Equal(t, commits[0].ID, commitInfo.ParentCommit.ID)
+		commitInfo, err = env.PachClient.InspectCommit(repo, "master", commits[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfo.ChildCommits))
+		require.Equal(t, commits[2].ID, commitInfo.ChildCommits[0].ID)
+	})
+
+	suite.Run("DeleteCommitWithSubvenance", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		upstream := "upstream"
+		downstream := "downstream"
+		require.NoError(t, env.PachClient.CreateRepo(upstream))
+		require.NoError(t, env.PachClient.CreateRepo(downstream))
+		require.NoError(t, env.PachClient.CreateBranch(downstream, "master", "", "", []*pfs.Branch{client.NewBranch(upstream, "master")}))
+
+		commit, err := env.PachClient.StartCommit(upstream, "master")
+		require.NoError(t, err)
+		err = env.PachClient.DeleteCommit(commit)
+		require.YesError(t, err)
+		require.Matches(t, "is downstream of it", err.Error())
+		_, err = env.PachClient.InspectCommit(upstream, "master", commit.ID)
+		require.NoError(t, err)
+	})
+
 	suite.Run("BasicFile", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -2010,6 +2126,52 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, err)
 	})
 
+	suite.Run("ModifyFileMixed", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "foo", strings.NewReader("foo1")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "bar", strings.NewReader("bar1")))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit1.Branch.Name, commit1.ID))
+
+		// Puts, deletes and copies in one stream are applied in order
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.WithModifyFileClient(commit2, func(mf client.ModifyFile) error {
+			if err := mf.DeleteFile("foo"); err != nil {
+				return err
+			}
+			if err := mf.PutFile("foo", strings.NewReader("foo2")); err != nil {
+				return err
+			}
+			if err := mf.CopyFile("baz", commit1.NewFile("bar")); err != nil {
+				return err
+			}
+			if err := mf.DeleteFile("bar"); err != nil {
+				return err
+			}
+			if err := mf.PutFile("buzz", strings.NewReader("buzz")); err != nil {
+				return err
+			}
+			return mf.DeleteFile("buzz")
+		}))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit2.Branch.Name, commit2.ID))
+
+		fileInfos, err := env.PachClient.ListFileAll(commit2, "")
+		require.NoError(t, err)
+		require.ElementsEqualUnderFn(t, []string{"/baz", "/foo"}, fileInfos, FileInfoToPath)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit2, "foo", &buf))
+		require.Equal(t, "foo2", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit2, "baz", &buf))
+		require.Equal(t, "bar1", buf.String())
+	})
+
 	suite.Run("DeleteDir", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -2156,6 +2318,48 @@ func TestPFS(suite *testing.T) {
 		}
 	})
 
+	suite.Run("ListCommitByCommitSet", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", "", []*pfs.Branch{client.NewBranch("in", "master")}))
+		require.NoError(t, finishCommit(env.PachClient, "out", "master", ""))
+		outRepo := client.NewRepo("out")
+
+		var commitIDs []string
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit("in", "master")
+			require.NoError(t, err)
+			require.NoError(t, finishCommit(env.PachClient, "in", "master", ""))
+			require.NoError(t, finishCommit(env.PachClient, "out", "master", ""))
+			commitIDs = append(commitIDs, commit.ID)
+		}
+
+		commitInfos, err := env.PachClient.ListCommitByCommitSet(outRepo, commitIDs[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		require.Equal(t, outRepo, commitInfos[0].Commit.Branch.Repo)
+		require.Equal(t, commitIDs[1], commitInfos[0].Commit.ID)
+
+		// The filter also applies when listing the ancestors of a commit
+		listCommitClient, err := env.PachClient.PfsAPIClient.ListCommit(env.PachClient.Ctx(), &pfs.ListCommitRequest{
+			Repo:      outRepo,
+			To:        outRepo.NewCommit("master", ""),
+			CommitSet: client.NewCommitSet(commitIDs[0]),
+		})
+		require.NoError(t, err)
+		commitInfos, err = clientsdk.ListCommit(listCommitClient)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		require.Equal(t, commitIDs[0], commitInfos[0].Commit.ID)
+
+		commitInfos, err = env.PachClient.ListCommitByCommitSet(outRepo, uuid.NewWithoutDashes())
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitInfos))
+	})
+
 	suite.Run("OffsetRead", func(t *testing.T) {
 		// TODO(2.0 optional): Decide on how to expose offset read.
 		t.Skip("Offset read exists (inefficient), just need to decide on how to expose it in V2")
@@ -2427,6 +2631,26 @@ func TestPFS(suite *testing.T) {
 				}
 			}
 		})
+		t.Run("WithRange", func(t *testing.T) {
+			repo := "rangerepo"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+
+			// Large enough to span several chunks
+			data := random.String(10 * units.MB)
+			commit := client.NewCommit(repo, "master", "")
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(data)))
+
+			for _, r := range [][2]int{{0, 1}, {0, len(data)}, {5, 100}, {units.MB - 10, 3 * units.MB}, {len(data) - 10, 100}} {
+				var b bytes.Buffer
+				require.NoError(t, env.PachClient.GetFileRange(commit, "file", int64(r[0]), int64(r[1]), &b))
+				end := r[0] + r[1]
+				if end > len(data) {
+					end = len(data)
+				}
+				require.Equal(t, data[r[0]:end], b.String())
+			}
+			require.YesError(t, env.PachClient.GetFileRange(commit, "file", 0, 0, &bytes.Buffer{}))
+		})
 	})
 
 	suite.Run("ManyPutsSingleFileSingleCommit", func(t *testing.T) {
@@ -3155,6 +3379,59 @@ func TestPFS(suite *testing.T) {
 		checks()
 	})
 
+	suite.Run("GetFileTARCompressed", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		expected := map[string]string{
+			"/file":          "foo",
+			"/dir/file1":     "bar",
+			"/dir/sub/file2": "baz",
+			"/other/file3":   "qux",
+		}
+		require.NoError(t, env.PachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+			for p, content := range expected {
+				if err := mf.PutFile(p, strings.NewReader(content)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+		getTar := func(path string) map[string]string {
+			rc, err := env.PachClient.GetFileTARCompressed(commit, path)
+			require.NoError(t, err)
+			defer rc.Close()
+			gr, err := gzip.NewReader(rc)
+			require.NoError(t, err)
+			files := make(map[string]string)
+			require.NoError(t, tarutil.Iterate(gr, func(f tarutil.File) error {
+				hdr, err := f.Header()
+				if err != nil {
+					return err
+				}
+				if hdr.Typeflag != tar.TypeReg {
+					return nil
+				}
+				buf := &bytes.Buffer{}
+				if err := f.Content(buf); err != nil {
+					return err
+				}
+				files[hdr.Name] = buf.String()
+				return nil
+			}))
+			return files
+		}
+		require.Equal(t, expected, getTar("/"))
+		require.Equal(t, map[string]string{
+			"/dir/file1":     "bar",
+			"/dir/sub/file2": "baz",
+		}, getTar("/dir"))
+	})
+
 	suite.Run("GlobFile", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -4483,6 +4760,44 @@ func TestPFS(suite *testing.T) {
 		check()
 	})
 
+	suite.Run("PutFileURLError", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/file" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte("foo"))
+		}))
+		defer srv.Close()
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFileURL(commit, "file", srv.URL+"/file", false))
+		// A failed fetch fails the whole request, so none of its files are added
+		err = env.PachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+			if err := mf.PutFileURL("file2", srv.URL+"/file", false); err != nil {
+				return err
+			}
+			return mf.PutFileURL("missing", srv.URL+"/missing", false)
+		})
+		require.YesError(t, err)
+		require.Matches(t, "404", err.Error())
+		err = env.PachClient.PutFileURL(commit, "dir", srv.URL+"/", true)
+		require.YesError(t, err)
+		require.Matches(t, "not supported", err.Error())
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, "/file", fileInfos[0].File.Path)
+	})
+
 	suite.Run("PutFilesObjURL", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -4655,6 +4970,36 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, desc, ri.Description)
 	})
 
+	suite.Run("RepoDescription", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepoWithDescription(repo, "foo"))
+		ri, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, "foo", ri.Description)
+		require.YesError(t, env.PachClient.CreateRepoWithDescription(repo, "bar"))
+
+		require.NoError(t, env.PachClient.UpdateRepoDescription(repo, "bar"))
+		ri, err = env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, "bar", ri.Description)
+
+		// An empty description clears the existing one
+		require.NoError(t, env.PachClient.UpdateRepoDescription(repo, ""))
+		ri, err = env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, "", ri.Description)
+
+		// Updating the description of a missing repo doesn't create it
+		err = env.PachClient.UpdateRepoDescription("missing", "foo")
+		require.YesError(t, err)
+		require.True(t, errutil.IsNotFoundError(err))
+		_, err = env.PachClient.InspectRepo("missing")
+		require.YesError(t, err)
+	})
+
 	suite.Run("DeferredProcessing", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -6003,6 +6348,628 @@ func TestPFS(suite *testing.T) {
 		require.True(t, errutil.IsNotFoundError(err))
 		require.False(t, strings.Contains(err.Error(), pfs.UserRepoType))
 	})
+
+	suite.Run("ListCommitsetsByRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		other := "other"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.CreateRepo(other))
+
+		var ids []string
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, finishCommit(env.PachClient, repo, "master", commit.ID))
+			ids = append(ids, commit.ID)
+
+			// Commits in other repos should not show up
+			otherCommit, err := env.PachClient.StartCommit(other, "master")
+			require.NoError(t, err)
+			require.NoError(t, finishCommit(env.PachClient, other, "master", otherCommit.ID))
+		}
+
+		commitSetInfos, err := env.PachClient.ListCommitsetsByRepo(repo, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(commitSetInfos))
+		for i, csi := range commitSetInfos {
+			require.Equal(t, ids[len(ids)-1-i], csi.CommitSet.ID)
+			var found bool
+			for _, ci := range csi.Commits {
+				if ci.Commit.Branch.Repo.Name == repo {
+					found = true
+					require.NotNil(t, ci.Finished)
+				}
+			}
+			require.True(t, found)
+		}
+
+		commitSetInfos, err = env.PachClient.ListCommitsetsByRepo(repo, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitSetInfos))
+		require.Equal(t, ids[2], commitSetInfos[0].CommitSet.ID)
+		require.Equal(t, ids[1], commitSetInfos[1].CommitSet.ID)
+	})
+
+	suite.Run("GetCommitDiffStats", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		oldCommit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(oldCommit, "dir/same", strings.NewReader("same")))
+		require.NoError(t, env.PachClient.PutFile(oldCommit, "dir/grow", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(oldCommit, "dir/shrink", strings.NewReader("foobar")))
+		require.NoError(t, env.PachClient.PutFile(oldCommit, "dir/delete", strings.NewReader("deleted")))
+		require.NoError(t, env.PachClient.PutFile(oldCommit, "other", strings.NewReader("other")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", oldCommit.ID))
+
+		newCommit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(newCommit, "dir/grow", strings.NewReader("foobarbaz")))
+		require.NoError(t, env.PachClient.PutFile(newCommit, "dir/shrink", strings.NewReader("baz")))
+		require.NoError(t, env.PachClient.DeleteFile(newCommit, "dir/delete"))
+		require.NoError(t, env.PachClient.PutFile(newCommit, "dir/add", strings.NewReader("added")))
+		require.NoError(t, env.PachClient.PutFile(newCommit, "other", strings.NewReader("changed")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", newCommit.ID))
+
+		stats, err := env.PachClient.GetCommitDiffStats(oldCommit, newCommit, "/dir/*")
+		require.NoError(t, err)
+		require.Equal(t, &client.CommitDiffStats{
+			FilesAdded:    1,
+			FilesModified: 2,
+			FilesDeleted:  1,
+			BytesAdded:    int64(len("added") + len("foobarbaz") - len("foo")),
+			BytesRemoved:  int64(len("deleted") + len("foobar") - len("baz")),
+		}, stats)
+
+		// A nil old commit is the parent of the new commit and an empty glob
+		// matches every file
+		stats, err = env.PachClient.GetCommitDiffStats(nil, newCommit, "")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), stats.FilesModified)
+	})
+
+	suite.Run("ListRepoByType", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		for _, repo := range []string{"repo1", "repo2"} {
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+		}
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo: client.NewSystemRepo("repo1", pfs.MetaRepoType),
+		})
+		require.NoError(t, err)
+
+		repoInfos, err := env.PachClient.ListRepoByType(pfs.UserRepoType)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(repoInfos))
+		for _, repoInfo := range repoInfos {
+			require.Equal(t, pfs.UserRepoType, repoInfo.Repo.Type)
+		}
+
+		repoInfos, err = env.PachClient.ListRepoByType(pfs.MetaRepoType)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "repo1", repoInfos[0].Repo.Name)
+		require.Equal(t, pfs.MetaRepoType, repoInfos[0].Repo.Type)
+
+		repoInfos, err = env.PachClient.ListRepoByType(pfs.SpecRepoType)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(repoInfos))
+
+		// An empty type returns every repo
+		repoInfos, err = env.PachClient.ListRepoByType("")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(repoInfos))
+	})
+
+	suite.Run("GetFileIfExists", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "foo", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "bar", strings.NewReader("bar")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit1.Branch.Name, commit1.ID))
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(commit2, "bar"))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.Branch.Name, commit2.ID))
+
+		var buf bytes.Buffer
+		exists, err := env.PachClient.GetFileIfExists(commit2, "foo", &buf)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, "foo", buf.String())
+
+		buf.Reset()
+		exists, err = env.PachClient.GetFileIfExists(commit2, "bar", &buf)
+		require.NoError(t, err)
+		require.False(t, exists)
+		require.Equal(t, 0, buf.Len())
+
+		// The file still exists in the earlier commit
+		exists, err = env.PachClient.GetFileIfExists(commit1, "bar", &buf)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, "bar", buf.String())
+
+		// A missing commit is still an error
+		_, err = env.PachClient.GetFileIfExists(client.NewCommit(repo, "master", uuid.NewWithoutDashes()), "foo", &buf)
+		require.YesError(t, err)
+	})
+
+	suite.Run("GlobFileBreak", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		numFiles := 100
+		require.NoError(t, env.PachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+			for i := 0; i < numFiles; i++ {
+				if err := mf.PutFile(fmt.Sprintf("dir%d/file%d", i%2, i), strings.NewReader("foo")); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+
+		// Only files matching the pattern are sent
+		var matched int
+		require.NoError(t, env.PachClient.GlobFile(commit, "/dir1/*", func(fi *pfs.FileInfo) error {
+			require.True(t, strings.HasPrefix(fi.File.Path, "/dir1/"))
+			matched++
+			return nil
+		}))
+		require.Equal(t, numFiles/2, matched)
+
+		// Returning ErrBreak stops iteration without an error
+		var seen int
+		require.NoError(t, env.PachClient.GlobFile(commit, "/*/*", func(fi *pfs.FileInfo) error {
+			seen++
+			if seen == 10 {
+				return errutil.ErrBreak
+			}
+			return nil
+		}))
+		require.Equal(t, 10, seen)
+	})
+
+	//   B
+	//  ◀ ◀
+	// A   D
+	//  ◀ ◀
+	//   C
+	suite.Run("InspectCommitSetGraph", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("A"))
+		require.NoError(t, env.PachClient.CreateRepo("B"))
+		require.NoError(t, env.PachClient.CreateRepo("C"))
+		require.NoError(t, env.PachClient.CreateRepo("D"))
+		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		require.NoError(t, env.PachClient.CreateBranch("D", "master", "", "", []*pfs.Branch{client.NewBranch("B", "master"), client.NewBranch("C", "master")}))
+
+		ACommit, err := env.PachClient.StartCommit("A", "master")
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(env.PachClient, "A", "master", ""))
+
+		graph, err := env.PachClient.InspectCommitSetGraph(ACommit.ID)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(graph.Commits))
+		var edges []string
+		for _, edge := range graph.Edges {
+			require.Equal(t, ACommit.ID, edge.From.ID)
+			require.Equal(t, ACommit.ID, edge.To.ID)
+			edges = append(edges, edge.From.Branch.Repo.Name+"->"+edge.To.Branch.Repo.Name)
+		}
+		require.ElementsEqual(t, []string{"A->B", "A->C", "B->D", "C->D"}, edges)
+	})
+
+	suite.Run("InspectCommitsetEdges", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("A"))
+		require.NoError(t, env.PachClient.CreateRepo("B"))
+		require.NoError(t, env.PachClient.CreateRepo("C"))
+		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+		require.NoError(t, env.PachClient.CreateBranch("C", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master"), client.NewBranch("B", "master")}))
+
+		ACommit, err := env.PachClient.StartCommit("A", "master")
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(env.PachClient, "A", "master", ""))
+
+		edgeNames := func(edges []*pfs.CommitSetEdge) []string {
+			var result []string
+			for _, edge := range edges {
+				result = append(result, edge.From.Branch.Repo.Name+"->"+edge.To.Branch.Repo.Name)
+			}
+			return result
+		}
+		commitSetInfo, err := env.PachClient.InspectCommitSetGraph(ACommit.ID)
+		require.NoError(t, err)
+		require.Equal(t, ACommit.ID, commitSetInfo.CommitSet.ID)
+		require.Equal(t, 3, len(commitSetInfo.Commits))
+		require.ElementsEqual(t, []string{"A->B", "A->C", "B->C"}, edgeNames(commitSetInfo.Edges))
+
+		commitSetInfos, err := env.PachClient.ListCommitsetsByRepo("A", 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitSetInfos))
+		require.ElementsEqual(t, []string{"A->B", "A->C", "B->C"}, edgeNames(commitSetInfos[0].Edges))
+	})
+
+	suite.Run("WaitCommitSetState", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("A"))
+		require.NoError(t, env.PachClient.CreateRepo("B"))
+		require.NoError(t, env.PachClient.CreateBranch("B", "master", "", "", []*pfs.Branch{client.NewBranch("A", "master")}))
+
+		ACommit, err := env.PachClient.StartCommit("A", "master")
+		require.NoError(t, err)
+		repoA, repoB := client.NewRepo("A"), client.NewRepo("B")
+
+		// Both commits have started
+		commitInfos, err := env.PachClient.WaitCommitSetState(ACommit.ID, nil, pfs.CommitState_STARTED)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+
+		// B's commit isn't ready until A's commit is finished
+		ctx, cancel := context.WithTimeout(env.Context, time.Second)
+		defer cancel()
+		_, err = env.PachClient.WithCtx(ctx).WaitCommitSetState(ACommit.ID, []*pfs.Repo{repoB}, pfs.CommitState_READY)
+		require.YesError(t, err)
+
+		require.NoError(t, finishCommit(env.PachClient, "A", "master", ""))
+		commitInfos, err = env.PachClient.WaitCommitSetState(ACommit.ID, []*pfs.Repo{repoB}, pfs.CommitState_READY)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		require.Equal(t, "B", commitInfos[0].Commit.Branch.Repo.Name)
+		require.Nil(t, commitInfos[0].Finished)
+
+		require.NoError(t, finishCommit(env.PachClient, "B", "master", ""))
+		commitInfos, err = env.PachClient.WaitCommitSetState(ACommit.ID, []*pfs.Repo{repoB, repoA}, pfs.CommitState_FINISHED)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		require.Equal(t, "B", commitInfos[0].Commit.Branch.Repo.Name)
+		require.Equal(t, "A", commitInfos[1].Commit.Branch.Repo.Name)
+		for _, commitInfo := range commitInfos {
+			require.NotNil(t, commitInfo.Finished)
+		}
+	})
+
+	suite.Run("GetFileCompressed", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		content := strings.Repeat("compressible ", 100*units.KB)
+		require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(content)))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+
+		var buf bytes.Buffer
+		codec, err := env.PachClient.GetFileCompressed(commit, "file", &buf)
+		require.NoError(t, err)
+		require.Equal(t, pfs.Compression_GZIP, codec)
+		require.True(t, buf.Len() < len(content))
+		r, err := gzip.NewReader(&buf)
+		require.NoError(t, err)
+		decompressed, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, content, string(decompressed))
+
+		// Directories can't be fetched compressed, as with GetFile
+		_, err = env.PachClient.GetFileCompressed(commit, "/", &buf)
+		require.YesError(t, err)
+
+		// Uncompressed responses don't report a codec
+		gfc, err := env.PachClient.PfsAPIClient.GetFile(env.PachClient.Ctx(), &pfs.GetFileRequest{
+			File: commit.NewFile("file"),
+		})
+		require.NoError(t, err)
+		header, err := gfc.Header()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(header.Get(pfs.CompressionHeader)))
+	})
+
+	suite.Run("DiffFilePaths", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		for _, file := range []string{"a", "b", "dir/c"} {
+			require.NoError(t, env.PachClient.PutFile(commit1, file, strings.NewReader(file)))
+		}
+		require.NoError(t, finishCommit(env.PachClient, repo, commit1.Branch.Name, commit1.ID))
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "a", strings.NewReader("modified")))
+		require.NoError(t, env.PachClient.DeleteFile(commit2, "b"))
+		require.NoError(t, env.PachClient.PutFile(commit2, "dir/d", strings.NewReader("d")))
+		require.NoError(t, env.PachClient.PutFile(commit2, "newdir/e", strings.NewReader("e")))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit2.Branch.Name, commit2.ID))
+
+		paths := func(fis []*pfs.FileInfo) []string {
+			var result []string
+			for _, fi := range fis {
+				result = append(result, fi.File.Path)
+			}
+			return result
+		}
+		added, deleted, err := env.PachClient.DiffFilePaths(commit1, commit2, "/")
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{"/a", "/dir/d", "/newdir/e"}, paths(added))
+		require.ElementsEqual(t, []string{"/a", "/b"}, paths(deleted))
+
+		added, deleted, err = env.PachClient.DiffFilePaths(commit1, commit2, "/dir")
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{"/dir/d"}, paths(added))
+		require.Equal(t, 0, len(deleted))
+
+		// The path need only exist in one of the commits
+		added, deleted, err = env.PachClient.DiffFilePaths(commit1, commit2, "/newdir")
+		require.NoError(t, err)
+		require.ElementsEqual(t, []string{"/newdir/e"}, paths(added))
+		require.Equal(t, 0, len(deleted))
+		added, deleted, err = env.PachClient.DiffFilePaths(commit2, commit1, "/newdir")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(added))
+		require.ElementsEqual(t, []string{"/newdir/e"}, paths(deleted))
+	})
+
+	suite.Run("CopyFileAcrossRepos", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		srcRepo := "src"
+		require.NoError(t, env.PachClient.CreateRepo(srcRepo))
+		dstRepo := "dst"
+		require.NoError(t, env.PachClient.CreateRepo(dstRepo))
+
+		srcCommit := client.NewCommit(srcRepo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(srcCommit, "dir/file", strings.NewReader("foo\n")))
+		dstCommit := client.NewCommit(dstRepo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(dstCommit, "file", strings.NewReader("bar\n")))
+
+		require.NoError(t, env.PachClient.CopyFile(dstCommit, "copied", srcCommit, "dir"))
+		require.NoError(t, env.PachClient.CopyFile(dstCommit, "file", srcCommit, "dir/file", client.WithAppendCopyFile()))
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(dstCommit, "copied/file", &buf))
+		require.Equal(t, "foo\n", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(dstCommit, "file", &buf))
+		require.Equal(t, "bar\nfoo\n", buf.String())
+
+		err := env.PachClient.CopyFile(dstCommit, "missing", srcCommit, "nonexistent")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsFileNotFoundErr(err))
+	})
+
+	suite.Run("ListFilePage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+			for i := 0; i < 10; i++ {
+				if err := mf.PutFile(fmt.Sprintf("dir/%02d", i), strings.NewReader("foo\n")); err != nil {
+					return err
+				}
+			}
+			for _, file := range []string{"dir/sub/a", "dir/sub/b", "other"} {
+				if err := mf.PutFile(file, strings.NewReader("foo\n")); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+		all, err := env.PachClient.ListFileAll(commit, "dir")
+		require.NoError(t, err)
+		require.Equal(t, 11, len(all))
+
+		var paged []*pfs.FileInfo
+		var token string
+		for pages := 0; ; pages++ {
+			require.True(t, pages < 11)
+			var page []*pfs.FileInfo
+			page, token, err = env.PachClient.ListFilePage(commit, "dir", token, 3)
+			require.NoError(t, err)
+			require.True(t, len(page) <= 3)
+			paged = append(paged, page...)
+			if token == "" {
+				break
+			}
+		}
+		require.Equal(t, len(all), len(paged))
+		for i := range all {
+			require.Equal(t, all[i].File.Path, paged[i].File.Path)
+		}
+		// The sub-directory is listed once, and nothing outside of dir is listed
+		require.Equal(t, "/dir/sub/", paged[len(paged)-1].File.Path)
+	})
+
+	suite.Run("FsckRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		input := "input"
+		output := "output"
+		require.NoError(t, env.PachClient.CreateRepo(input))
+		require.NoError(t, env.PachClient.CreateRepo(output))
+		require.NoError(t, env.PachClient.CreateBranch(output, "master", "", "", []*pfs.Branch{client.NewBranch(input, "master")}))
+		for i := 0; i < 3; i++ {
+			require.NoError(t, env.PachClient.PutFile(client.NewCommit(input, "master", ""), "file", strings.NewReader("1")))
+		}
+
+		var problems []*pfs.FsckResponse
+		require.NoError(t, env.PachClient.FsckRepo(output, false, func(resp *pfs.FsckResponse) error {
+			problems = append(problems, resp)
+			return nil
+		}))
+		require.Equal(t, 0, len(problems))
+
+		// Drop the output branch from the input branch's subvenance, and the
+		// head commit from its parent's children
+		inputCommits, err := env.PachClient.ListCommit(client.NewRepo(input), client.NewCommit(input, "master", ""), nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(inputCommits))
+		head, parent := inputCommits[0].Commit, inputCommits[1].Commit
+		branches := pfsdb.Branches(env.ServiceEnv.GetDBClient(), env.ServiceEnv.GetPostgresListener())
+		commits := pfsdb.Commits(env.ServiceEnv.GetDBClient(), env.ServiceEnv.GetPostgresListener())
+		require.NoError(t, dbutil.WithTx(env.Context, env.ServiceEnv.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+			branchInfo := &pfs.BranchInfo{}
+			if err := branches.ReadWrite(sqlTx).Update(client.NewBranch(input, "master"), branchInfo, func() error {
+				branchInfo.Subvenance = nil
+				return nil
+			}); err != nil {
+				return err
+			}
+			commitInfo := &pfs.CommitInfo{}
+			return commits.ReadWrite(sqlTx).Update(parent, commitInfo, func() error {
+				commitInfo.ChildCommits = nil
+				return nil
+			})
+		}))
+
+		fsck := func(repo string, fix bool) (errs, fixes []*pfs.FsckResponse) {
+			require.NoError(t, env.PachClient.FsckRepo(repo, fix, func(resp *pfs.FsckResponse) error {
+				if resp.Error != "" {
+					errs = append(errs, resp)
+				} else {
+					fixes = append(fixes, resp)
+				}
+				return nil
+			}))
+			return errs, fixes
+		}
+		checkProblems := func(errs []*pfs.FsckResponse) {
+			require.Equal(t, 2, len(errs))
+			sort.Slice(errs, func(i, j int) bool { return errs[i].Error < errs[j].Error })
+			require.Matches(t, "missing branch", errs[0].Error)
+			require.Equal(t, head.ID, errs[0].Commit.ID)
+			require.Matches(t, "disagree about their parent/child relationship", errs[1].Error)
+			require.Equal(t, head.ID, errs[1].Commit.ID)
+		}
+
+		// Both problems are in the input repo, so checking or fixing the
+		// output repo doesn't touch them
+		errs, fixes := fsck(output, true)
+		require.Equal(t, 0, len(errs))
+		require.Equal(t, 0, len(fixes))
+		errs, _ = fsck(input, false)
+		checkProblems(errs)
+
+		errs, fixes = fsck(input, true)
+		checkProblems(errs)
+		require.Equal(t, 2, len(fixes))
+		for _, f := range fixes {
+			require.Equal(t, head.ID, f.Commit.ID)
+		}
+		errs, fixes = fsck(input, false)
+		require.Equal(t, 0, len(errs))
+		require.Equal(t, 0, len(fixes))
+		require.NoError(t, env.PachClient.FsckFastExit())
+
+		require.YesError(t, env.PachClient.FsckRepo("nonexistent", false, func(resp *pfs.FsckResponse) error {
+			return nil
+		}))
+	})
+
+	suite.Run("InspectFileDigests", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		content := "foo\n"
+		require.NoError(t, env.PachClient.PutFile(commit, "dir/file", strings.NewReader(content)))
+		md5Sum := md5.Sum([]byte(content))
+		sha256Sum := sha256.Sum256([]byte(content))
+
+		fi, err := env.PachClient.InspectFile(commit, "dir/file")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fi.Digests))
+		// The second call is served from the digest cache
+		for i := 0; i < 2; i++ {
+			fi, err = env.PachClient.InspectFileDigests(commit, "dir/file", "md5", "sha256")
+			require.NoError(t, err)
+			require.Equal(t, 2, len(fi.Digests))
+			require.Equal(t, md5Sum[:], fi.Digests["md5"])
+			require.Equal(t, sha256Sum[:], fi.Digests["sha256"])
+		}
+
+		_, err = env.PachClient.InspectFileDigests(commit, "dir/file", "crc32")
+		require.YesError(t, err)
+		require.Matches(t, "unsupported digest algorithm", err.Error())
+		_, err = env.PachClient.InspectFileDigests(commit, "dir", "md5")
+		require.YesError(t, err)
+	})
+
+	suite.Run("StartCommitParent", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commits []*pfs.Commit
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(commit, fmt.Sprintf("file-%d", i), strings.NewReader("foo")))
+			require.NoError(t, finishCommit(env.PachClient, repo, "master", ""))
+			commits = append(commits, commit)
+		}
+
+		// Fork history from the first commit onto a new branch
+		fork, err := env.PachClient.StartCommitParent(repo, "experiment", commits[0])
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(fork, "other", strings.NewReader("bar")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "experiment", ""))
+		commitInfo, err := env.PachClient.InspectCommit(repo, "experiment", "")
+		require.NoError(t, err)
+		require.Equal(t, commits[0].ID, commitInfo.ParentCommit.ID)
+		files, err := env.PachClient.ListFileAll(commitInfo.Commit, "")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+
+		// master is unchanged
+		commitInfo, err = env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commits[2].ID, commitInfo.Commit.ID)
+
+		// The parent must be in the same repo
+		require.NoError(t, env.PachClient.CreateRepo("other"))
+		_, err = env.PachClient.StartCommitParent("other", "master", commits[0])
+		require.YesError(t, err)
+		require.Matches(t, "same repo", err.Error())
+	})
 }
 
 var (
@@ -147,6 +147,16 @@ func (a *validatedAPIServer) SquashCommitSet(ctx context.Context, request *pfs.S
 	return a.apiServer.SquashCommitSet(ctx, request)
 }
 
+func (a *validatedAPIServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitRequest) (*types.Empty, error) {
+	if request.Commit == nil {
+		return nil, errors.New("commit cannot be nil")
+	}
+	if err := a.env.AuthServer().CheckRepoIsAuthorized(ctx, request.Commit.Branch.Repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+		return nil, err
+	}
+	return a.apiServer.DeleteCommit(ctx, request)
+}
+
 func (a *validatedAPIServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileServer) error {
 	if request.File == nil {
 		return errors.New("file cannot be nil")
@@ -90,13 +90,21 @@ If the job fails, the output commit will not be populated with data.`,
 			}
 			if raw {
 				return cmdutil.Encoder(output, os.Stdout).EncodeProto(jobInfo)
-			} else if output != "" {
-				return errors.New("cannot set --output (-o) without --raw")
+			} else if output != "" && output != "json" {
+				return cmdutil.ErrOutputRequiresRaw
 			}
 			pji := &pretty.PrintableJobInfo{
 				JobInfo:        jobInfo,
 				FullTimestamps: fullTimestamps,
 			}
+			if output == "json" {
+				result, err := pretty.MarshalJSONJobInfo(pji)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(result))
+				return nil
+			}
 			return pretty.PrintDetailedJobInfo(os.Stdout, pji)
 		}),
 	}
@@ -743,13 +751,21 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			}
 			if raw {
 				return cmdutil.Encoder(output, os.Stdout).EncodeProto(pipelineInfo)
-			} else if output != "" {
-				return errors.New("cannot set --output (-o) without --raw")
+			} else if output != "" && output != "json" {
+				return cmdutil.ErrOutputRequiresRaw
 			}
 			pi := &pretty.PrintablePipelineInfo{
 				PipelineInfo:   pipelineInfo,
 				FullTimestamps: fullTimestamps,
 			}
+			if output == "json" {
+				result, err := pretty.MarshalJSONPipelineInfo(pi)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(result))
+				return nil
+			}
 			return pretty.PrintDetailedPipelineInfo(os.Stdout, pi)
 		}),
 	}
@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
 	"text/template"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/juju/ansiterm"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -150,7 +153,7 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, fullTi
 
 // PrintWorkerStatusHeader pretty prints a worker status header.
 func PrintWorkerStatusHeader(w io.Writer) {
-	fmt.Fprint(w, "WORKER\tJOB\tDATUM\tSTARTED\t\n")
+	fmt.Fprint(w, "WORKER\tJOB\tDATUM\tSTARTED\tQUEUED\tDATUMS/S\t\n")
 }
 
 // PrintWorkerStatus pretty prints a worker status.
@@ -168,7 +171,11 @@ func PrintWorkerStatus(w io.Writer, workerStatus *ppsclient.WorkerStatus, fullTi
 		} else {
 			fmt.Fprintf(w, "%s\t", pretty.Ago(datumStatus.Started))
 		}
+	} else {
+		fmt.Fprintf(w, "\t\t")
 	}
+	fmt.Fprintf(w, "%d\t", workerStatus.QueueSize)
+	fmt.Fprintf(w, "%.2f\t", workerStatus.DatumsPerSecond)
 	fmt.Fprintln(w)
 }
 
@@ -293,6 +300,189 @@ Transform:
 	return nil
 }
 
+// jobInfoJSON is the structured form of the output of PrintDetailedJobInfo.
+type jobInfoJSON struct {
+	ID                    string       `json:"id"`
+	Pipeline              string       `json:"pipeline"`
+	Started               string       `json:"started,omitempty"`
+	Duration              string       `json:"duration,omitempty"`
+	State                 string       `json:"state"`
+	Reason                string       `json:"reason,omitempty"`
+	Processed             int64        `json:"processed"`
+	Failed                int64        `json:"failed"`
+	Skipped               int64        `json:"skipped"`
+	Recovered             int64        `json:"recovered"`
+	Total                 int64        `json:"total"`
+	DataDownloaded        string       `json:"data_downloaded"`
+	DataUploaded          string       `json:"data_uploaded"`
+	DownloadTime          string       `json:"download_time"`
+	ProcessTime           string       `json:"process_time"`
+	UploadTime            string       `json:"upload_time"`
+	DatumTimeout          string       `json:"datum_timeout,omitempty"`
+	JobTimeout            string       `json:"job_timeout,omitempty"`
+	WorkerStatus          []*protoJSON `json:"worker_status,omitempty"`
+	Restarts              uint64       `json:"restarts"`
+	ParallelismSpec       *protoJSON   `json:"parallelism_spec,omitempty"`
+	ResourceRequests      *protoJSON   `json:"resource_requests,omitempty"`
+	ResourceLimits        *protoJSON   `json:"resource_limits,omitempty"`
+	SidecarResourceLimits *protoJSON   `json:"sidecar_resource_limits,omitempty"`
+	Service               *protoJSON   `json:"service,omitempty"`
+	Input                 *protoJSON   `json:"input,omitempty"`
+	Transform             *protoJSON   `json:"transform,omitempty"`
+	OutputCommit          string       `json:"output_commit,omitempty"`
+	Egress                string       `json:"egress,omitempty"`
+}
+
+// MarshalJSONJobInfo renders the same fields as PrintDetailedJobInfo as
+// indented JSON, for tools that need to parse the output.
+func MarshalJSONJobInfo(jobInfo *PrintableJobInfo) ([]byte, error) {
+	ji := jobInfoJSON{
+		ID:        jobInfo.Job.ID,
+		Pipeline:  jobInfo.Job.Pipeline.Name,
+		Started:   pretty.Timestamp(jobInfo.Started, jobInfo.FullTimestamps),
+		State:     stateName(jobInfo.State.String(), "JOB_"),
+		Reason:    jobInfo.Reason,
+		Processed: jobInfo.DataProcessed,
+		Failed:    jobInfo.DataFailed,
+		Skipped:   jobInfo.DataSkipped,
+		Recovered: jobInfo.DataRecovered,
+		Total:     jobInfo.DataTotal,
+		Restarts:  jobInfo.Restart,
+	}
+	if jobInfo.Finished != nil {
+		ji.Duration = pretty.TimeDifference(jobInfo.Started, jobInfo.Finished)
+	}
+	if jobInfo.Stats != nil {
+		ji.DataDownloaded = pretty.Size(jobInfo.Stats.DownloadBytes)
+		ji.DataUploaded = pretty.Size(jobInfo.Stats.UploadBytes)
+		ji.DownloadTime = pretty.Duration(jobInfo.Stats.DownloadTime)
+		ji.ProcessTime = pretty.Duration(jobInfo.Stats.ProcessTime)
+		ji.UploadTime = pretty.Duration(jobInfo.Stats.UploadTime)
+	}
+	if jobInfo.OutputCommit != nil {
+		ji.OutputCommit = jobInfo.OutputCommit.ID
+	}
+	if details := jobInfo.Details; details != nil {
+		ji.DatumTimeout = optionalDuration(details.DatumTimeout)
+		ji.JobTimeout = optionalDuration(details.JobTimeout)
+		for _, status := range details.WorkerStatus {
+			ji.WorkerStatus = append(ji.WorkerStatus, newProtoJSON(status))
+		}
+		ji.ParallelismSpec = newProtoJSON(details.ParallelismSpec)
+		ji.ResourceRequests = newProtoJSON(details.ResourceRequests)
+		ji.ResourceLimits = newProtoJSON(details.ResourceLimits)
+		ji.SidecarResourceLimits = newProtoJSON(details.SidecarResourceLimits)
+		ji.Service = newProtoJSON(details.Service)
+		ji.Input = newProtoJSON(details.Input)
+		ji.Transform = newProtoJSON(details.Transform)
+		if details.Egress != nil {
+			ji.Egress = details.Egress.URL
+		}
+	}
+	return marshalJSON(ji)
+}
+
+// pipelineInfoJSON is the structured form of the output of
+// PrintDetailedPipelineInfo.
+type pipelineInfoJSON struct {
+	Name             string     `json:"name"`
+	Description      string     `json:"description,omitempty"`
+	Created          string     `json:"created,omitempty"`
+	State            string     `json:"state"`
+	Reason           string     `json:"reason,omitempty"`
+	WorkersAvailable int64      `json:"workers_available"`
+	WorkersRequested int64      `json:"workers_requested"`
+	Stopped          bool       `json:"stopped"`
+	ParallelismSpec  *protoJSON `json:"parallelism_spec,omitempty"`
+	ResourceRequests *protoJSON `json:"resource_requests,omitempty"`
+	ResourceLimits   *protoJSON `json:"resource_limits,omitempty"`
+	DatumTimeout     string     `json:"datum_timeout,omitempty"`
+	JobTimeout       string     `json:"job_timeout,omitempty"`
+	Input            *protoJSON `json:"input,omitempty"`
+	OutputBranch     string     `json:"output_branch,omitempty"`
+	Transform        *protoJSON `json:"transform,omitempty"`
+	Egress           string     `json:"egress,omitempty"`
+	RecentError      string     `json:"recent_error,omitempty"`
+}
+
+// MarshalJSONPipelineInfo renders the same fields as PrintDetailedPipelineInfo
+// as indented JSON, for tools that need to parse the output.
+func MarshalJSONPipelineInfo(pipelineInfo *PrintablePipelineInfo) ([]byte, error) {
+	pi := pipelineInfoJSON{
+		Name:    pipelineInfo.Pipeline.Name,
+		State:   stateName(pipelineInfo.State.String(), "PIPELINE_"),
+		Reason:  pipelineInfo.Reason,
+		Stopped: pipelineInfo.Stopped,
+	}
+	if details := pipelineInfo.Details; details != nil {
+		pi.Description = details.Description
+		pi.Created = pretty.Timestamp(details.CreatedAt, pipelineInfo.FullTimestamps)
+		pi.WorkersAvailable = details.WorkersAvailable
+		pi.WorkersRequested = details.WorkersRequested
+		pi.ParallelismSpec = newProtoJSON(details.ParallelismSpec)
+		pi.ResourceRequests = newProtoJSON(details.ResourceRequests)
+		pi.ResourceLimits = newProtoJSON(details.ResourceLimits)
+		pi.DatumTimeout = optionalDuration(details.DatumTimeout)
+		pi.JobTimeout = optionalDuration(details.JobTimeout)
+		pi.Input = newProtoJSON(details.Input)
+		pi.OutputBranch = details.OutputBranch
+		pi.Transform = newProtoJSON(details.Transform)
+		pi.RecentError = details.RecentError
+		if details.Egress != nil {
+			pi.Egress = details.Egress.URL
+		}
+	}
+	return marshalJSON(pi)
+}
+
+// stateName returns the uncolored name the detailed printers use for a job or
+// pipeline state, e.g. "running" for JOB_RUNNING.
+func stateName(state, prefix string) string {
+	name := strings.ToLower(strings.TrimPrefix(state, prefix))
+	if name == "" || strings.Contains(name, "_") {
+		return "-"
+	}
+	return name
+}
+
+func optionalDuration(d *types.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return pretty.Duration(d)
+}
+
+// protoJSON marshals the proto it wraps with jsonpb, so that proto fields are
+// rendered the same way as with --raw.
+type protoJSON struct {
+	m proto.Message
+}
+
+func newProtoJSON(m proto.Message) *protoJSON {
+	if v := reflect.ValueOf(m); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	return &protoJSON{m: m}
+}
+
+// MarshalJSON implements json.Marshaler
+func (p *protoJSON) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	m := jsonpb.Marshaler{OrigName: true}
+	if err := m.Marshal(&buf, p.m); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalJSON(v interface{}) ([]byte, error) {
+	result, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return []byte(pretty.UnescapeHTML(string(result))), nil
+}
+
 // PrintDatumInfo pretty-prints file info.
 // If recurse is false and directory size is 0, display "-" instead
 // If fast is true and file size is 0, display "-" instead
@@ -396,6 +586,8 @@ func datumState(datumState ppsclient.DatumState) string {
 		return color.New(color.FgYellow).SprintFunc()("recovered")
 	case ppsclient.DatumState_SUCCESS:
 		return color.New(color.FgGreen).SprintFunc()("success")
+	case ppsclient.DatumState_QUARANTINED:
+		return color.New(color.FgRed).SprintFunc()("quarantined")
 	case ppsclient.DatumState_UNKNOWN:
 		return color.New(color.FgGreen).SprintFunc()("-")
 	}
@@ -500,7 +692,11 @@ func ShorthandInput(input *ppsclient.Input) string {
 	case input == nil:
 		return "none"
 	case input.Pfs != nil:
-		return fmt.Sprintf("%s:%s", input.Pfs.Repo, input.Pfs.Glob)
+		globs := input.Pfs.Globs
+		if input.Pfs.Glob != "" {
+			globs = append([]string{input.Pfs.Glob}, globs...)
+		}
+		return fmt.Sprintf("%s:%s", input.Pfs.Repo, strings.Join(globs, ","))
 	case input.Cross != nil:
 		var subInput []string
 		for _, input := range input.Cross {
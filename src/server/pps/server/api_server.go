@@ -18,6 +18,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/itchyny/gojq"
 	opentracing "github.com/opentracing/opentracing-go"
+	globlib "github.com/pachyderm/ohmyglob"
 	"github.com/robfig/cron"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -36,6 +37,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
@@ -100,6 +102,7 @@ type apiServer struct {
 	port                  uint16
 	peerPort              uint16
 	gcPercent             int
+	nodePools             map[string]map[string]string
 	// collections
 	pipelines col.PostgresCollection
 	jobs      col.PostgresCollection
@@ -182,9 +185,9 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 					"job output")
 			case input.Pfs.Branch == "":
 				return errors.Errorf("input must specify a branch")
-			case !input.Pfs.S3 && len(input.Pfs.Glob) == 0:
+			case !input.Pfs.S3 && len(input.Pfs.Glob) == 0 && len(input.Pfs.Globs) == 0:
 				return errors.Errorf("input must specify a glob")
-			case input.Pfs.S3 && input.Pfs.Glob != "/":
+			case input.Pfs.S3 && (input.Pfs.Glob != "/" || len(input.Pfs.Globs) > 0):
 				return errors.Errorf("inputs that set 's3' to 'true' must also set " +
 					"'glob', to \"/\", as the S3 gateway is only able to expose data " +
 					"at the commit level")
@@ -196,6 +199,24 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Errorf("input cannot specify both 's3' and " +
 					"'empty_files', as 's3' requires input data to be accessed via " +
 					"Pachyderm's S3 gateway rather than the file system")
+			case input.Pfs.Lazy && input.Pfs.EmptyFiles:
+				return errors.Errorf("input cannot specify both 'lazy' and " +
+					"'empty_files', as 'lazy' streams each file's content while " +
+					"'empty_files' provides no content")
+			case input.Pfs.LeafDirs && (input.Pfs.JoinOn != "" || input.Pfs.GroupBy != ""):
+				return errors.Errorf("input cannot specify 'leaf_dirs' with 'join_on' " +
+					"or 'group_by', as leaf directories need not match its glob")
+			case input.Pfs.LeafDirs && input.Pfs.S3:
+				return errors.Errorf("input cannot specify both 's3' and " +
+					"'leaf_dirs', as the S3 gateway exposes the whole commit")
+			}
+			for _, pattern := range append([]string{input.Pfs.Glob}, input.Pfs.Globs...) {
+				if pattern == "" {
+					continue
+				}
+				if _, err := globlib.Compile(pattern, '/'); err != nil {
+					return errors.Wrapf(err, "invalid glob %q", pattern)
+				}
 			}
 		}
 		if input.Cross != nil {
@@ -249,14 +270,61 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 			if _, err := cron.ParseStandard(input.Cron.Spec); err != nil {
 				return errors.Wrapf(err, "error parsing cron-spec")
 			}
+			if err := validateCronFormat(input.Cron.Format); err != nil {
+				return err
+			}
+			if input.Cron.KeepDuration != nil {
+				keep, err := types.DurationFromProto(input.Cron.KeepDuration)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				if keep <= 0 {
+					return errors.Errorf("cron keep_duration must be positive")
+				}
+			}
 		}
 		if !set {
 			return errors.Errorf("no input set")
 		}
+		if input.Dedup && input.Union == nil {
+			return errors.Errorf("dedup is only supported on union inputs")
+		}
+		return nil
+	})
+}
+
+// validateOutputAncestryInputs checks that any input on the pipeline's own
+// output repo reads an ancestor of its output commits (e.g. "master^"), as the
+// output commit itself is never finished while the job runs.
+func validateOutputAncestryInputs(pipelineInfo *pps.PipelineInfo) error {
+	return pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+		if input.Pfs == nil || input.Pfs.Repo != pipelineInfo.Pipeline.Name || (input.Pfs.RepoType != "" && input.Pfs.RepoType != pfs.UserRepoType) {
+			return nil
+		}
+		if ppsutil.OutputAncestry(pipelineInfo, input.Pfs) == 0 {
+			return errors.Errorf("input %q reads the pipeline's own output, so its branch must refer to an ancestor of %q (e.g. \"%s^\"), not %q",
+				input.Pfs.Name, pipelineInfo.Details.OutputBranch, pipelineInfo.Details.OutputBranch, input.Pfs.Branch)
+		}
+		if input.Pfs.Trigger != nil {
+			return errors.Errorf("input %q reads the pipeline's own output and cannot have a trigger", input.Pfs.Name)
+		}
 		return nil
 	})
 }
 
+// validateCronFormat checks that a cron input's format is either one of the
+// named formats or a Go time layout that actually includes the time.
+func validateCronFormat(format string) error {
+	switch format {
+	case "", client.CronFormatRFC3339, client.CronFormatUnix:
+		return nil
+	}
+	if time.Unix(0, 0).UTC().Format(format) == format {
+		return errors.Errorf("cron format %q must be %q, %q, or a Go time layout", format, client.CronFormatRFC3339, client.CronFormatUnix)
+	}
+	return nil
+}
+
 func validateTransform(transform *pps.Transform) error {
 	if transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
@@ -264,6 +332,58 @@ func validateTransform(transform *pps.Transform) error {
 	if transform.Image == "" {
 		return errors.Errorf("pipeline transform must contain an image")
 	}
+	if transform.StdinFromInput != "" && len(transform.Stdin) > 0 {
+		return errors.Errorf("pipeline transform cannot set both stdin and stdin_from_input")
+	}
+	if len(transform.PreflightStdin) > 0 && len(transform.PreflightCmd) == 0 {
+		return errors.Errorf("pipeline transform cannot set preflight_stdin without preflight_cmd")
+	}
+	return nil
+}
+
+// validateConfigMaps checks that the ConfigMaps mounted by a pipeline exist,
+// along with any keys that are loaded into environment variables.
+func (a *apiServer) validateConfigMaps(configMaps []*pps.ConfigMapMount) error {
+	for _, configMap := range configMaps {
+		if configMap.Name == "" {
+			return errors.Errorf("config map must specify a name")
+		}
+		if configMap.MountPath == "" && configMap.EnvVar == "" {
+			return errors.Errorf("config map %q must specify a mount path or an env var", configMap.Name)
+		}
+		if configMap.EnvVar != "" && configMap.Key == "" {
+			return errors.Errorf("config map %q must specify the key to load into env var %q", configMap.Name, configMap.EnvVar)
+		}
+		cm, err := a.env.GetKubeClient().CoreV1().ConfigMaps(a.namespace).Get(configMap.Name, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "could not get config map %q", configMap.Name)
+		}
+		if configMap.EnvVar != "" {
+			if _, ok := cm.Data[configMap.Key]; !ok {
+				return errors.Errorf("config map %q has no key %q", configMap.Name, configMap.Key)
+			}
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) validateEgress(egress *pps.Egress) error {
+	url, err := obj.ParseURL(egress.URL)
+	if err != nil {
+		return err
+	}
+	if egress.Secret == nil {
+		return nil
+	}
+	if url.Scheme != "s3" {
+		return errors.Errorf("egress credentials are only supported for s3 URLs (got %q)", egress.URL)
+	}
+	if egress.Secret.Name == "" {
+		return errors.Errorf("egress secret must specify a name")
+	}
+	if _, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(egress.Secret.Name, metav1.GetOptions{}); err != nil {
+		return errors.Wrapf(err, "could not get egress secret %q", egress.Secret.Name)
+	}
 	return nil
 }
 
@@ -382,9 +502,10 @@ func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txncontext.Transact
 		done := make(map[string]struct{}) // don't double-authorize repos
 		if err := pps.VisitInput(input, func(in *pps.Input) error {
 			var repo string
-			if in.Pfs != nil {
+			if in.Pfs != nil && in.Pfs.Repo != output {
 				repo = in.Pfs.Repo
 			} else {
+				// The output repo is authorized below
 				return nil
 			}
 
@@ -564,6 +685,33 @@ func (a *apiServer) InspectJobSet(request *pps.InspectJobSetRequest, server pps.
 	return nil
 }
 
+// InspectJobSetInfo implements the protobuf pps.InspectJobSetInfo RPC
+func (a *apiServer) InspectJobSetInfo(ctx context.Context, request *pps.InspectJobSetRequest) (response *pps.JobSetInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+
+	var jobInfos []*pps.JobInfo
+	cb := func(ji *pps.JobInfo) error {
+		jobInfos = append(jobInfos, ji)
+		return nil
+	}
+	var err error
+	if request.Wait {
+		err = pachClient.WaitJobSet(request.JobSet.ID, request.Details, cb)
+	} else {
+		jobInfos, err = pachClient.InspectJobSet(request.JobSet.ID, request.Details)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pps.JobSetInfo{
+		JobSet: client.NewJobSet(request.JobSet.ID),
+		Jobs:   jobInfos,
+		State:  pps.JobSetState(jobInfos),
+	}, nil
+}
+
 func forEachCommitInJob(pachClient *client.APIClient, jobID string, wait bool, cb func(*pfs.CommitInfo) error) error {
 	if wait {
 		// Note that while this will return jobs in the same topological sort as the
@@ -616,6 +764,7 @@ func (a *apiServer) ListJobSet(request *pps.ListJobSetRequest, serv pps.API_List
 		return serv.Send(&pps.JobSetInfo{
 			JobSet: client.NewJobSet(jobInfo.Job.ID),
 			Jobs:   jobInfos,
+			State:  pps.JobSetState(jobInfos),
 		})
 	})
 }
@@ -845,6 +994,20 @@ func (a *apiServer) getJobDetails(ctx context.Context, jobInfo *pps.JobInfo) err
 		}
 	}
 
+	// Once the job has finished, its meta commit's total file set holds the
+	// datums it saw, and lives as long as the commit does.
+	if pps.IsTerminal(jobInfo.State) && !pipelineInfo.Details.NoMeta {
+		pachClient := a.env.GetPachClient(ctx)
+		resp, err := pachClient.PfsAPIClient.GetFileSet(pachClient.Ctx(), &pfs.GetFileSetRequest{
+			Commit: ppsutil.MetaCommit(jobInfo.OutputCommit),
+		})
+		if err != nil {
+			logrus.Errorf("failed to get datum file set for job %s: %v", jobInfo.Job.ID, err)
+		} else {
+			details.DatumFilesetId = resp.FileSetId
+		}
+	}
+
 	jobInfo.Details = details
 	return nil
 }
@@ -880,20 +1043,24 @@ func (a *apiServer) SubscribeJob(request *pps.SubscribeJobRequest, stream pps.AP
 		return err
 	}
 
-	// keep track of the jobs that have been sent
-	seen := map[string]struct{}{}
+	// keep track of the jobs that have been sent, and the state they were in
+	seen := map[string]pps.JobState{}
 
-	return a.jobs.ReadOnly(ctx).WatchByIndexF(ppsdb.JobsTerminalIndex, ppsdb.JobTerminalKey(request.Pipeline, false), func(ev *watch.Event) error {
+	index, indexVal := ppsdb.JobsTerminalIndex, ppsdb.JobTerminalKey(request.Pipeline, false)
+	if request.Transitions {
+		index, indexVal = ppsdb.JobsPipelineIndex, request.Pipeline.Name
+	}
+	return a.jobs.ReadOnly(ctx).WatchByIndexF(index, indexVal, func(ev *watch.Event) error {
 		var key string
 		jobInfo := &pps.JobInfo{}
 		if err := ev.Unmarshal(&key, jobInfo); err != nil {
 			return errors.Wrapf(err, "unmarshal")
 		}
 
-		if _, ok := seen[key]; ok {
+		if state, ok := seen[key]; ok && (!request.Transitions || state == jobInfo.State) {
 			return nil
 		}
-		seen[key] = struct{}{}
+		seen[key] = jobInfo.State
 
 		if request.Details {
 			if err := a.getJobDetails(ctx, jobInfo); err != nil {
@@ -1041,6 +1208,15 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
+	if request.DatumFilesetId != "" {
+		if request.Job != nil || request.Input != nil {
+			return errors.Errorf("only one of job, input and datum_fileset_id can be set")
+		}
+		fsi := datum.NewFileSetIterator(a.env.GetPachClient(server.Context()), request.DatumFilesetId)
+		return fsi.Iterate(func(meta *datum.Meta) error {
+			return server.Send(convertDatumMetaToInfo(meta, meta.Job))
+		})
+	}
 	if request.Input != nil {
 		return a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
 			di := convertDatumMetaToInfo(meta, nil)
@@ -1053,15 +1229,46 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 	})
 }
 
+// GetDatumCount implements the protobuf pps.GetDatumCount RPC
+func (a *apiServer) GetDatumCount(ctx context.Context, request *pps.GetDatumCountRequest) (response *pps.GetDatumCountResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	input := request.Input
+	if input == nil {
+		if request.Pipeline == nil {
+			return nil, errors.New("must specify a pipeline or an input")
+		}
+		pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name, true)
+		if err != nil {
+			return nil, err
+		}
+		input = proto.Clone(pipelineInfo.Details.Input).(*pps.Input)
+	}
+	var count int64
+	if err := a.listDatumInput(ctx, input, func(_ *datum.Meta) error {
+		count++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pps.GetDatumCountResponse{Count: count}, nil
+}
+
 func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
 	setInputDefaults("", input)
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
 		if input.Pfs != nil {
 			pachClient := a.env.GetPachClient(ctx)
-			ci, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, "")
+			branch, id := input.Pfs.Branch, ""
+			if _, ancestors, err := ancestry.Parse(branch); err == nil && ancestors != 0 {
+				// Resolve ancestry references such as "master^" as a commit ID
+				branch, id = "", input.Pfs.Branch
+			}
+			ci, err := pachClient.InspectCommit(input.Pfs.Repo, branch, id)
 			if err != nil {
 				return err
 			}
+			input.Pfs.Branch = ci.Commit.Branch.Name
 			input.Pfs.Commit = ci.Commit.ID
 		}
 		if input.Cron != nil {
@@ -1094,11 +1301,34 @@ func convertDatumMetaToInfo(meta *datum.Meta, sourceJob *pps.Job) *pps.DatumInfo
 		di.Data = append(di.Data, input.FileInfo)
 	}
 	if meta.Job != nil && !proto.Equal(meta.Job, sourceJob) {
-		di.State = pps.DatumState_SKIPPED
+		if meta.State != datum.State_QUARANTINED {
+			di.State = pps.DatumState_SKIPPED
+		}
+		// The stats belong to the job that processed the datum, this job spent
+		// no time on it
+		di.Stats = &pps.ProcessStats{}
+	} else {
+		di.WorkerID = meta.WorkerID
 	}
+	di.Stats = withZeroDurations(di.Stats)
 	return di
 }
 
+// withZeroDurations returns a copy of 'stats' where unset durations are
+// replaced with zero durations.
+func withZeroDurations(stats *pps.ProcessStats) *pps.ProcessStats {
+	result := &pps.ProcessStats{}
+	if stats != nil {
+		result = proto.Clone(stats).(*pps.ProcessStats)
+	}
+	for _, d := range []**types.Duration{&result.DownloadTime, &result.ProcessTime, &result.UploadTime} {
+		if *d == nil {
+			*d = types.DurationProto(0)
+		}
+	}
+	return result
+}
+
 // TODO: this is a bit wonky, but it is necessary based on the dependency graph.
 func convertDatumState(state datum.State) pps.DatumState {
 	switch state {
@@ -1106,6 +1336,8 @@ func convertDatumState(state datum.State) pps.DatumState {
 		return pps.DatumState_FAILED
 	case datum.State_RECOVERED:
 		return pps.DatumState_RECOVERED
+	case datum.State_QUARANTINED:
+		return pps.DatumState_QUARANTINED
 	default:
 		return pps.DatumState_SUCCESS
 	}
@@ -1118,6 +1350,17 @@ func (a *apiServer) collectDatums(ctx context.Context, job *pps.Job, cb func(*da
 	if err != nil {
 		return err
 	}
+	// The datums of a job are only recorded in its meta commit
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := a.pipelines.ReadOnly(ctx).GetUniqueByIndex(
+		ppsdb.PipelinesVersionIndex,
+		ppsdb.VersionKey(jobInfo.Job.Pipeline.Name, jobInfo.PipelineVersion),
+		pipelineInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if pipelineInfo.Details.NoMeta {
+		return errors.Errorf("the datums of job %s are not recorded, as pipeline %q has no_meta set", job.ID, job.Pipeline.Name)
+	}
 	pachClient := a.env.GetPachClient(ctx)
 	metaCommit := ppsutil.MetaCommit(jobInfo.OutputCommit)
 	fsi := datum.NewCommitIterator(pachClient, metaCommit)
@@ -1288,6 +1531,9 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 							continue
 						}
 					}
+					if request.UserOnly && !msg.User {
+						continue
+					}
 					msg.Message = strings.TrimSuffix(msg.Message, "\n")
 
 					// Log message passes all filters -- return it
@@ -1316,6 +1562,30 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 	return egErr
 }
 
+// InspectEnterpriseFeatures implements the protobuf pps.InspectEnterpriseFeatures RPC
+func (a *apiServer) InspectEnterpriseFeatures(ctx context.Context, request *pps.InspectEnterpriseFeaturesRequest) (response *pps.InspectEnterpriseFeaturesResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	resp, err := pachClient.Enterprise.GetState(pachClient.Ctx(),
+		&enterpriseclient.GetStateRequest{})
+	if err != nil {
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get enterprise status")
+	}
+	enterprise := resp.State == enterpriseclient.State_ACTIVE
+	return &pps.InspectEnterpriseFeaturesResponse{
+		Enterprise: enterprise,
+		LokiLogs:   ppsutil.LokiLogsEnabled(a.env, enterprise),
+	}, nil
+}
+
+// InspectClusterLimits implements the protobuf pps.InspectClusterLimits RPC
+func (a *apiServer) InspectClusterLimits(ctx context.Context, request *pps.InspectClusterLimitsRequest) (response *pps.ClusterLimits, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.clusterLimits(ctx)
+}
+
 func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
@@ -1324,7 +1594,7 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	// (based on pipeline and job filters)
 	loki, err := a.env.GetLokiClient()
 	if err != nil {
-		return err
+		return errors.Wrapf(err, "Loki logs are not enabled on this cluster")
 	}
 	since, err := types.DurationFromProto(request.Since)
 	if err != nil {
@@ -1412,6 +1682,9 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 		if !common.MatchDatum(request.DataFilters, msg.Data) {
 			return nil
 		}
+		if request.UserOnly && !msg.User {
+			return nil
+		}
 		msg.Message = strings.TrimSuffix(msg.Message, "\n")
 		return apiGetLogsServer.Send(msg)
 	})
@@ -1472,12 +1745,156 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 		return errors.Errorf("invalid pipeline spec: ReprocessSpec must be one of '%s' or '%s'",
 			client.ReprocessSpecUntilSuccess, client.ReprocessSpecEveryJob)
 	}
+	if request.ReprocessGlob != "" {
+		if !request.Update {
+			return errors.Errorf("reprocess_glob can only be set when updating a pipeline")
+		}
+		if request.Reprocess {
+			return errors.Errorf("reprocess and reprocess_glob cannot both be set")
+		}
+		if _, err := globlib.Compile(request.ReprocessGlob, '/'); err != nil {
+			return errors.Wrapf(err, "invalid reprocess_glob")
+		}
+	}
 	if request.Spout != nil && request.Autoscaling {
 		return errors.Errorf("autoscaling can't be used with spouts (spouts aren't triggered externally)")
 	}
+	if request.FailureReport && request.OutputBranch == client.FailureReportBranch {
+		return errors.Errorf("the output branch of a pipeline with a failure report can't be %q", client.FailureReportBranch)
+	}
+	if request.DedupAgainst != "" && (request.S3Out || request.Spout != nil || request.Service != nil) {
+		return errors.Errorf("dedup_against is not supported with s3 output, spouts or services")
+	}
+	if request.Passthrough && (request.S3Out || request.Spout != nil || request.Service != nil) {
+		return errors.Errorf("passthrough is not supported with s3 output, spouts or services")
+	}
+	if request.Spout != nil && request.Spout.CommitMarker != "" {
+		if strings.Contains(request.Spout.CommitMarker, "/") {
+			return errors.Errorf("spout commit_marker must be a file name, not a path (got %q)", request.Spout.CommitMarker)
+		}
+	}
+	if request.PreviousOutput != "" {
+		if request.Spout != nil {
+			return errors.Errorf("previous_output is not supported with spouts")
+		}
+		if request.PreviousOutput == "out" {
+			return errors.Errorf("previous_output cannot be named \"out\", as pachyderm " +
+				"already creates /pfs/out to collect job output")
+		}
+		names := make(map[string]bool)
+		if err := validateNames(names, request.Input); err != nil {
+			return err
+		}
+		if names[request.PreviousOutput] {
+			return errors.Errorf("previous_output %q is also the name of an input", request.PreviousOutput)
+		}
+	}
+	if request.ShardByKey && !containsKeyedInput(request.Input) {
+		return errors.Errorf("shard_by_key requires a pfs input with join_on or group_by set")
+	}
+	if request.JobHistoryLimit < 0 {
+		return errors.Errorf("job_history_limit must be non-negative (got %d)", request.JobHistoryLimit)
+	}
+	if request.MaxInFlightJobs < 0 {
+		return errors.Errorf("max_in_flight_jobs must be non-negative (got %d)", request.MaxInFlightJobs)
+	}
+	if request.QuarantineAfter < 0 {
+		return errors.Errorf("quarantine_after must be non-negative (got %d)", request.QuarantineAfter)
+	}
+	if request.QuarantineAfter > 0 && (request.Spout != nil || request.Service != nil) {
+		return errors.Errorf("quarantine_after is not supported with spouts or services")
+	}
+	if request.NoMeta && request.QuarantineAfter > 0 {
+		return errors.Errorf("quarantine_after is not supported with no_meta")
+	}
+	if request.NoMeta && request.FailureReport {
+		return errors.Errorf("failure_report is not supported with no_meta")
+	}
+	if request.Transform != nil && len(request.Transform.PreflightCmd) > 0 && (request.Spout != nil || request.Service != nil) {
+		return errors.Errorf("preflight_cmd is not supported with spouts or services")
+	}
+	if request.Transform != nil && request.Transform.ScriptFromInput != "" {
+		if err := validateScriptFromInput(request.Transform.ScriptFromInput, request.Input); err != nil {
+			return err
+		}
+	}
+	if request.DatumMemoryScaling != nil {
+		if request.Spout != nil || request.Service != nil {
+			return errors.Errorf("datum_memory_scaling is not supported with spouts or services")
+		}
+		if _, err := ppsutil.DatumMemoryLimit(request.DatumMemoryScaling, 0); err != nil {
+			return errors.Wrapf(err, "invalid datum_memory_scaling")
+		}
+	}
+	if request.CrashBackoff != nil {
+		if _, err := crashBackOff(request.CrashBackoff); err != nil {
+			return errors.Wrapf(err, "invalid crash_backoff")
+		}
+	}
+	if request.MaintenanceSchedule != nil {
+		if _, _, err := maintenanceWindow(request.MaintenanceSchedule, time.Now()); err != nil {
+			return errors.Wrapf(err, "invalid maintenance_schedule")
+		}
+	}
+	if request.Heartbeat != nil {
+		interval, err := types.DurationFromProto(request.Heartbeat.Interval)
+		if err != nil {
+			return errors.Wrapf(err, "invalid heartbeat interval")
+		}
+		if interval <= 0 {
+			return errors.Errorf("heartbeat interval must be positive (got %v)", interval)
+		}
+		timeout, err := ppsutil.HeartbeatTimeout(request.Heartbeat)
+		if err != nil {
+			return errors.Wrapf(err, "invalid heartbeat timeout")
+		}
+		if timeout <= interval {
+			return errors.Errorf("heartbeat timeout (%v) must be longer than its interval (%v)", timeout, interval)
+		}
+	}
+	if request.NodePool != "" {
+		if _, ok := a.nodePools[request.NodePool]; !ok {
+			return errors.Errorf("node pool %q is not configured in this cluster", request.NodePool)
+		}
+	}
+	return nil
+}
+
+// validateScriptFromInput checks that a transform's script_from_input names a
+// file in one of the pipeline's PFS inputs.
+func validateScriptFromInput(script string, input *pps.Input) error {
+	name, scriptPath := ppsutil.SplitScriptFromInput(script)
+	if scriptPath == "" {
+		return errors.Errorf("script_from_input %q must be of the form <input name>/<path>", script)
+	}
+	var found bool
+	pps.VisitInput(input, func(in *pps.Input) error {
+		if in.Pfs != nil && in.Pfs.Name == name {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	})
+	if !found {
+		return errors.Errorf("script_from_input %q doesn't refer to a pfs input", script)
+	}
 	return nil
 }
 
+// containsKeyedInput returns true if 'in' is or contains any PFS inputs with
+// join_on or group_by set.
+func containsKeyedInput(in *pps.Input) bool {
+	var found bool
+	pps.VisitInput(in, func(in *pps.Input) error {
+		if in.Pfs != nil && (in.Pfs.JoinOn != "" || in.Pfs.GroupBy != "") {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	})
+	return found
+}
+
 func (a *apiServer) validateEnterpriseChecks(ctx context.Context, req *pps.CreatePipelineRequest) error {
 	if _, err := a.inspectPipeline(ctx, req.Pipeline.Name, false); err == nil {
 		// Pipeline already exists so we allow people to update it even if
@@ -1486,13 +1903,11 @@ func (a *apiServer) validateEnterpriseChecks(ctx context.Context, req *pps.Creat
 	} else if !errutil.IsNotFoundError(err) {
 		return err
 	}
-	pachClient := a.env.GetPachClient(ctx)
-	resp, err := pachClient.Enterprise.GetState(pachClient.Ctx(),
-		&enterpriseclient.GetStateRequest{})
+	limits, err := a.clusterLimits(ctx)
 	if err != nil {
-		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get enterprise status")
+		return err
 	}
-	if resp.State == enterpriseclient.State_ACTIVE {
+	if limits.MaxPipelines == 0 && limits.MaxParallelism == 0 {
 		// Enterprise is enabled so anything goes.
 		return nil
 	}
@@ -1504,15 +1919,53 @@ func (a *apiServer) validateEnterpriseChecks(ctx context.Context, req *pps.Creat
 	}); err != nil {
 		return err
 	}
-	if len(seen) >= enterpriselimits.Pipelines {
+	if int64(len(seen)) >= limits.MaxPipelines {
 		enterprisemetrics.IncEnterpriseFailures()
 		return errors.Errorf("%s requires an activation key to create more than %d total pipelines (you have %d). %s\n\n%s",
-			enterprisetext.OpenSourceProduct, enterpriselimits.Pipelines, len(seen), enterprisetext.ActivateCTA, enterprisetext.RegisterCTA)
+			enterprisetext.OpenSourceProduct, limits.MaxPipelines, len(seen), enterprisetext.ActivateCTA, enterprisetext.RegisterCTA)
 	}
-	if req.ParallelismSpec != nil && req.ParallelismSpec.Constant > enterpriselimits.Parallelism {
+	if req.ParallelismSpec != nil && (int64(req.ParallelismSpec.Constant) > limits.MaxParallelism || int64(req.ParallelismSpec.Max) > limits.MaxParallelism) {
 		enterprisemetrics.IncEnterpriseFailures()
 		return errors.Errorf("%s requires an activation key to create pipelines with parallelism more than %d. %s\n\n%s",
-			enterprisetext.OpenSourceProduct, enterpriselimits.Parallelism, enterprisetext.ActivateCTA, enterprisetext.RegisterCTA)
+			enterprisetext.OpenSourceProduct, limits.MaxParallelism, enterprisetext.ActivateCTA, enterprisetext.RegisterCTA)
+	}
+	return nil
+}
+
+// clusterLimits returns the limits enforced by validateEnterpriseChecks. They
+// only apply when the cluster doesn't have an active enterprise license.
+func (a *apiServer) clusterLimits(ctx context.Context) (*pps.ClusterLimits, error) {
+	pachClient := a.env.GetPachClient(ctx)
+	resp, err := pachClient.Enterprise.GetState(pachClient.Ctx(),
+		&enterpriseclient.GetStateRequest{})
+	if err != nil {
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get enterprise status")
+	}
+	if resp.State == enterpriseclient.State_ACTIVE {
+		return &pps.ClusterLimits{}, nil
+	}
+	return &pps.ClusterLimits{
+		MaxPipelines:   enterpriselimits.Pipelines,
+		MaxParallelism: enterpriselimits.Parallelism,
+	}, nil
+}
+
+func validateDatumsPerWorker(pipelineInfo *pps.PipelineInfo) error {
+	pspec := pipelineInfo.Details.ParallelismSpec
+	switch {
+	case pspec.DatumsPerWorker < 0:
+		return errors.Errorf("datums_per_worker must be non-negative (got %d)", pspec.DatumsPerWorker)
+	case pspec.DatumsPerWorker == 0:
+		if pspec.Max != 0 {
+			return errors.New("parallelism max can only be set with datums_per_worker")
+		}
+		return nil
+	case pspec.Constant != 0:
+		return errors.New("parallelism constant and datums_per_worker can't both be set")
+	case pspec.Max == 0:
+		return errors.New("datums_per_worker requires a parallelism max")
+	case pipelineInfo.Details.Spout != nil:
+		return errors.New("datums_per_worker is not supported with spouts")
 	}
 	return nil
 }
@@ -1538,13 +1991,27 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	if err := validateTransform(pipelineInfo.Details.Transform); err != nil {
 		return errors.Wrapf(err, "invalid transform")
 	}
+	if err := a.validateConfigMaps(pipelineInfo.Details.Transform.ConfigMaps); err != nil {
+		return errors.Wrapf(err, "invalid transform")
+	}
+	if pipelineInfo.Details.Egress != nil {
+		if err := a.validateEgress(pipelineInfo.Details.Egress); err != nil {
+			return errors.Wrapf(err, "invalid egress")
+		}
+	}
 	if err := a.validateInput(pipelineInfo.Pipeline.Name, pipelineInfo.Details.Input); err != nil {
 		return err
 	}
+	if err := validateOutputAncestryInputs(pipelineInfo); err != nil {
+		return err
+	}
 	if pipelineInfo.Details.ParallelismSpec != nil {
 		if pipelineInfo.Details.Service != nil && pipelineInfo.Details.ParallelismSpec.Constant != 1 {
 			return errors.New("services can only be run with a constant parallelism of 1")
 		}
+		if err := validateDatumsPerWorker(pipelineInfo); err != nil {
+			return err
+		}
 	}
 	if pipelineInfo.Details.OutputBranch == "" {
 		return errors.New("pipeline needs to specify an output branch")
@@ -1586,10 +2053,12 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	return nil
 }
 
-func branchProvenance(input *pps.Input) []*pfs.Branch {
+func branchProvenance(pipelineInfo *pps.PipelineInfo) []*pfs.Branch {
 	var result []*pfs.Branch
-	pps.VisitInput(input, func(input *pps.Input) error {
-		if input.Pfs != nil {
+	pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+		// Inputs on ancestors of the pipeline's own output commits are excluded,
+		// as the output branch can't be provenant on itself.
+		if input.Pfs != nil && ppsutil.OutputAncestry(pipelineInfo, input.Pfs) == 0 {
 			result = append(result, client.NewBranch(input.Pfs.Repo, input.Pfs.Branch))
 		}
 		if input.Cron != nil {
@@ -1739,6 +2208,12 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if request.Pipeline == nil {
 		return nil, errors.New("request.Pipeline cannot be nil")
 	}
+	if request.DryRun {
+		if _, err := a.createPipelineDryRun(ctx, request); err != nil {
+			return nil, err
+		}
+		return &types.Empty{}, nil
+	}
 
 	// Annotate current span with pipeline & persist any extended trace to etcd
 	span := opentracing.SpanFromContext(ctx)
@@ -1760,6 +2235,38 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	return &types.Empty{}, nil
 }
 
+// CreatePipelineDryRun implements the protobuf pps.CreatePipelineDryRun RPC
+func (a *apiServer) CreatePipelineDryRun(ctx context.Context, request *pps.CreatePipelineRequest) (response *pps.PipelineInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	return a.createPipelineDryRun(ctx, request)
+}
+
+// createPipelineDryRun runs all of CreatePipeline's validation and returns the
+// PipelineInfo that it would create, discarding every write it makes.
+func (a *apiServer) createPipelineDryRun(ctx context.Context, request *pps.CreatePipelineRequest) (*pps.PipelineInfo, error) {
+	if err := a.validateEnterpriseChecks(ctx, request); err != nil {
+		return nil, err
+	}
+	request = proto.Clone(request).(*pps.CreatePipelineRequest)
+	var pipelineInfo *pps.PipelineInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		pipelineInfo, err = a.createPipelineInTransaction(txnCtx, request)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	// The spec commit and auth token were discarded with the transaction
+	pipelineInfo.SpecCommit = nil
+	pipelineInfo.AuthToken = ""
+	return pipelineInfo, nil
+}
+
 func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, oldPipelineInfo *pps.PipelineInfo) (*pps.PipelineInfo, error) {
 	if err := a.validatePipelineRequest(request); err != nil {
 		return nil, err
@@ -1799,6 +2306,21 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 			Metadata:              request.Metadata,
 			ReprocessSpec:         request.ReprocessSpec,
 			Autoscaling:           request.Autoscaling,
+			DedupAgainst:          request.DedupAgainst,
+			FailureReport:         request.FailureReport,
+			NodePool:              request.NodePool,
+			Passthrough:           request.Passthrough,
+			PreviousOutput:        request.PreviousOutput,
+			ShardByKey:            request.ShardByKey,
+			DatumMemoryScaling:    request.DatumMemoryScaling,
+			QuarantineAfter:       request.QuarantineAfter,
+			Heartbeat:             request.Heartbeat,
+			JobHistoryLimit:       request.JobHistoryLimit,
+			CrashBackoff:          request.CrashBackoff,
+			MaxInFlightJobs:       request.MaxInFlightJobs,
+			NoMeta:                request.NoMeta,
+			MaintenanceSchedule:   request.MaintenanceSchedule,
+			ReprocessGlob:         request.ReprocessGlob,
 		},
 	}
 
@@ -1831,24 +2353,38 @@ func (a *apiServer) CreatePipelineInTransaction(
 	txnCtx *txncontext.TransactionContext,
 	request *pps.CreatePipelineRequest,
 ) error {
+	if request.DryRun {
+		return errors.New("dry_run is not supported in transactions")
+	}
+	_, err := a.createPipelineInTransaction(txnCtx, request)
+	return err
+}
+
+func (a *apiServer) createPipelineInTransaction(
+	txnCtx *txncontext.TransactionContext,
+	request *pps.CreatePipelineRequest,
+) (*pps.PipelineInfo, error) {
 	pipelineName := request.Pipeline.Name
 	oldPipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, pipelineName)
 	if err != nil && !errutil.IsNotFoundError(err) {
 		// silently ignore pipeline not found, old info will be nil
-		return err
+		return nil, err
 	}
 
 	if oldPipelineInfo != nil && !request.Update {
-		return errors.Errorf("pipeline %q already exists", pipelineName)
+		return nil, errors.Errorf("pipeline %q already exists", pipelineName)
+	}
+	if oldPipelineInfo != nil && oldPipelineInfo.Details.NoMeta != request.NoMeta {
+		return nil, errors.Errorf("no_meta cannot be changed when updating pipeline %q", pipelineName)
 	}
 
 	newPipelineInfo, err := a.initializePipelineInfo(request, oldPipelineInfo)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// Verify that all input repos exist (create cron and git repos if necessary)
 	if visitErr := pps.VisitInput(newPipelineInfo.Details.Input, func(input *pps.Input) error {
-		if input.Pfs != nil {
+		if input.Pfs != nil && ppsutil.OutputAncestry(newPipelineInfo, input.Pfs) == 0 {
 			if _, err := a.env.PfsServer().InspectRepoInTransaction(txnCtx,
 				&pfs.InspectRepoRequest{
 					Repo: client.NewSystemRepo(input.Pfs.Repo, input.Pfs.RepoType),
@@ -1869,7 +2405,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 		}
 		return nil
 	}); visitErr != nil {
-		return visitErr
+		return nil, visitErr
 	}
 
 	// Authorize pipeline creation
@@ -1878,13 +2414,13 @@ func (a *apiServer) CreatePipelineInTransaction(
 		operation = pipelineOpUpdate
 	}
 	if err := a.authorizePipelineOpInTransaction(txnCtx, operation, newPipelineInfo.Details.Input, newPipelineInfo.Pipeline.Name); err != nil {
-		return err
+		return nil, err
 	}
 	update := request.Update && oldPipelineInfo != nil
 
 	var (
 		// provenance for the pipeline's output branch (includes the spec branch)
-		provenance = append(branchProvenance(newPipelineInfo.Details.Input),
+		provenance = append(branchProvenance(newPipelineInfo),
 			client.NewSystemRepo(pipelineName, pfs.SpecRepoType).NewBranch("master"))
 		outputBranch = client.NewBranch(pipelineName, newPipelineInfo.Details.OutputBranch)
 		metaBranch   = client.NewSystemRepo(pipelineName, pfs.MetaRepoType).NewBranch(newPipelineInfo.Details.OutputBranch)
@@ -1892,7 +2428,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 
 	// Get the expected number of workers for this pipeline
 	if parallelism, err := getExpectedNumWorkers(newPipelineInfo); err != nil {
-		return err
+		return nil, err
 	} else {
 		newPipelineInfo.Parallelism = uint64(parallelism)
 	}
@@ -1907,9 +2443,9 @@ func (a *apiServer) CreatePipelineInTransaction(
 				Repo:        client.NewRepo(pipelineName),
 				Description: fmt.Sprintf("Output repo for pipeline %s.", request.Pipeline.Name),
 			}); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrapf(err, "error creating output repo for %s", pipelineName)
+			return nil, errors.Wrapf(err, "error creating output repo for %s", pipelineName)
 		} else if errutil.IsAlreadyExistError(err) {
-			return errors.Errorf("pipeline %q cannot be created because a repo with the same name already exists", pipelineName)
+			return nil, errors.Errorf("pipeline %q cannot be created because a repo with the same name already exists", pipelineName)
 		}
 		if err := a.env.PfsServer().CreateRepoInTransaction(txnCtx,
 			&pfs.CreateRepoRequest{
@@ -1917,7 +2453,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 				Description: fmt.Sprintf("Spec repo for pipeline %s.", request.Pipeline.Name),
 				Update:      true,
 			}); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrapf(err, "error creating spec repo for %s", pipelineName)
+			return nil, errors.Wrapf(err, "error creating spec repo for %s", pipelineName)
 		}
 	}
 
@@ -1925,14 +2461,14 @@ func (a *apiServer) CreatePipelineInTransaction(
 		if update {
 			// request.SpecCommit indicates we're restoring from an extracted cluster
 			// state and should not do any updates
-			return errors.New("Cannot update a pipeline and provide a spec commit at the same time")
+			return nil, errors.New("Cannot update a pipeline and provide a spec commit at the same time")
 		}
 		// Check if there is an existing spec commit
 		commitInfo, err := a.env.PfsServer().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
 			Commit: request.SpecCommit,
 		})
 		if err != nil {
-			return errors.Wrap(err, "error inspecting spec commit")
+			return nil, errors.Wrap(err, "error inspecting spec commit")
 		}
 		// There is, so we use that as the spec commit, rather than making a new one
 		newPipelineInfo.SpecCommit = commitInfo.Commit
@@ -1942,12 +2478,12 @@ func (a *apiServer) CreatePipelineInTransaction(
 			Branch: client.NewSystemRepo(pipelineName, pfs.SpecRepoType).NewBranch("master"),
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := a.env.PfsServer().FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{
 			Commit: newPipelineInfo.SpecCommit,
 		}); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -1964,17 +2500,17 @@ func (a *apiServer) CreatePipelineInTransaction(
 
 		return nil
 	}(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// store the new PipelineInfo in the collection
 	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Create(newPipelineInfo.SpecCommit, newPipelineInfo); err != nil {
-		return err
+		return nil, err
 	}
 
 	if newPipelineInfo.AuthToken != "" {
 		if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, newPipelineInfo, oldPipelineInfo); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -1982,7 +2518,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 		// Kill all unfinished jobs (as those are for the previous version and will
 		// no longer be completed)
 		if err := a.stopAllJobsInPipeline(txnCtx, request.Pipeline); err != nil {
-			return err
+			return nil, err
 		}
 
 		if newPipelineInfo.AuthToken != "" {
@@ -1990,11 +2526,11 @@ func (a *apiServer) CreatePipelineInTransaction(
 			// refetch because inspect clears it
 			var oldWithAuth pps.PipelineInfo
 			if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(oldPipelineInfo.SpecCommit, &oldWithAuth); err != nil {
-				return err
+				return nil, err
 			}
 			if _, err := a.env.AuthServer().RevokeAuthTokenInTransaction(txnCtx,
 				&auth.RevokeAuthTokenRequest{Token: oldWithAuth.AuthToken}); err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
@@ -2011,7 +2547,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 		Branch:     outputBranch,
 		Provenance: provenance,
 	}); err != nil {
-		return errors.Wrapf(err, "could not create/update output branch")
+		return nil, errors.Wrapf(err, "could not create/update output branch")
 	}
 
 	if visitErr := pps.VisitInput(request.Input, func(input *pps.Input) error {
@@ -2035,24 +2571,24 @@ func (a *apiServer) CreatePipelineInTransaction(
 		}
 		return nil
 	}); visitErr != nil {
-		return errors.Wrapf(visitErr, "could not create/update trigger branch")
+		return nil, errors.Wrapf(visitErr, "could not create/update trigger branch")
 	}
 
-	if request.Service == nil && request.Spout == nil {
+	if request.Service == nil && request.Spout == nil && !request.NoMeta {
 		if err := a.env.PfsServer().CreateRepoInTransaction(txnCtx, &pfs.CreateRepoRequest{
 			Repo:        metaBranch.Repo,
 			Description: fmt.Sprint("Meta repo for pipeline ", pipelineName),
 		}); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrap(err, "could not create meta repo")
+			return nil, errors.Wrap(err, "could not create meta repo")
 		}
 		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
 			Branch:     metaBranch,
 			Provenance: provenance, // same provenance as output branch
 		}); err != nil {
-			return errors.Wrapf(err, "could not create/update meta branch")
+			return nil, errors.Wrapf(err, "could not create/update meta branch")
 		}
 	}
-	return nil
+	return newPipelineInfo, nil
 }
 
 func pipelineTypeFromInfo(pipelineInfo *pps.PipelineInfo) pps.PipelineInfo_PipelineType {
@@ -2194,7 +2730,16 @@ func (a *apiServer) updatePipeline(
 func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	return a.inspectPipeline(ctx, request.Pipeline.Name, request.Details)
+	info, err := a.inspectPipeline(ctx, request.Pipeline.Name, request.Details)
+	if err != nil {
+		return nil, err
+	}
+	if request.RecentJobs > 0 {
+		if err := a.getRecentJobs(ctx, info, request.RecentJobs); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
 }
 
 // inspectPipeline contains the functional implementation of InspectPipeline.
@@ -2246,6 +2791,22 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 			return nil, err
 		}
 		info.Details.UnclaimedTasks = tasks - claims
+		if err := a.getJobsInFlight(ctx, info); err != nil {
+			return nil, err
+		}
+		if spec := info.Details.MaintenanceSchedule; spec != nil {
+			start, end, err := maintenanceWindow(spec, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			info.Details.MaintenanceWindow = &pps.MaintenanceWindow{}
+			if info.Details.MaintenanceWindow.Start, err = types.TimestampProto(start); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			if info.Details.MaintenanceWindow.End, err = types.TimestampProto(end); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
 	}
 
 	return info, nil
@@ -2257,10 +2818,6 @@ func (a *apiServer) InspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 		return nil, err
 	}
 
-	if ancestors < 0 {
-		return nil, errors.New("cannot inspect future pipelines")
-	}
-
 	key, err := a.findPipelineSpecCommitInTransaction(txnCtx, name, "")
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't find up to date spec for pipeline %q", name)
@@ -2281,6 +2838,19 @@ func (a *apiServer) InspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 		if err := a.pipelines.ReadWrite(txnCtx.SqlTx).GetUniqueByIndex(ppsdb.PipelinesVersionIndex, ppsdb.VersionKey(name, uint64(targetVersion)), pipelineInfo); err != nil {
 			return nil, err
 		}
+	} else if ancestors < 0 {
+		// "pipeline.N" refers to version N of the pipeline, like "branch.N"
+		// refers to the Nth commit on a branch
+		targetVersion := uint64(-ancestors)
+		if targetVersion > pipelineInfo.Version {
+			return nil, errors.Errorf("pipeline %q has only %d versions, not enough to find version %d", name, pipelineInfo.Version, targetVersion)
+		}
+		if err := a.pipelines.ReadWrite(txnCtx.SqlTx).GetUniqueByIndex(ppsdb.PipelinesVersionIndex, ppsdb.VersionKey(name, targetVersion), pipelineInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, errors.Errorf("version %d of pipeline %q not found, it may have been deleted", targetVersion, name)
+			}
+			return nil, err
+		}
 	}
 
 	// Erase any AuthToken - this shouldn't be returned to anyone (the workers
@@ -2313,6 +2883,50 @@ func (a *apiServer) getLatestJobState(ctx context.Context, info *pps.PipelineInf
 		})
 }
 
+// maxRecentJobs caps the number of jobs summarized by InspectPipeline
+const maxRecentJobs = 10
+
+// getRecentJobs fills in summaries of the pipeline's 'n' most recently created
+// jobs.
+func (a *apiServer) getRecentJobs(ctx context.Context, info *pps.PipelineInfo, n int64) error {
+	if n > maxRecentJobs {
+		n = maxRecentJobs
+	}
+	opts := col.DefaultOptions()
+	opts.Limit = int(n)
+	var job pps.JobInfo
+	return a.jobs.ReadOnly(ctx).GetByIndex(
+		ppsdb.JobsPipelineIndex,
+		info.Pipeline.Name,
+		&job,
+		opts, func(_ string) error {
+			info.RecentJobs = append(info.RecentJobs, &pps.JobSummary{
+				Job:      job.Job,
+				State:    job.State,
+				Finished: job.Finished,
+			})
+			return nil
+		})
+}
+
+// getJobsInFlight counts the pipeline's jobs that have started processing but
+// not yet finished.
+func (a *apiServer) getJobsInFlight(ctx context.Context, info *pps.PipelineInfo) error {
+	var job pps.JobInfo
+	info.Details.JobsInFlight = 0
+	return a.jobs.ReadOnly(ctx).GetByIndex(
+		ppsdb.JobsTerminalIndex,
+		ppsdb.JobTerminalKey(info.Pipeline, false),
+		&job,
+		col.DefaultOptions(), func(_ string) error {
+			switch job.State {
+			case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.JobState_JOB_EGRESSING:
+				info.Details.JobsInFlight++
+			}
+			return nil
+		})
+}
+
 func (a *apiServer) listPipeline(ctx context.Context, request *pps.ListPipelineRequest, f func(*pps.PipelineInfo) error) error {
 	var jqCode *gojq.Code
 	var enc serde.Encoder
@@ -2446,6 +3060,84 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 	return &types.Empty{}, nil
 }
 
+// DeletePipelines implements the protobuf pps.DeletePipelines RPC
+func (a *apiServer) DeletePipelines(ctx context.Context, request *pps.DeletePipelinesRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	toDelete := make(map[string]bool)
+	for _, pipeline := range request.Pipelines {
+		if _, err := a.inspectPipeline(ctx, pipeline.Name, false); err != nil {
+			return nil, err
+		}
+		toDelete[pipeline.Name] = true
+	}
+
+	// consumers maps each pipeline being deleted to the pipelines that read
+	// from its output repo
+	consumers := make(map[string][]string)
+	if err := a.listPipelineInfo(ctx, nil, 0, func(pipelineInfo *pps.PipelineInfo) error {
+		name := pipelineInfo.Pipeline.Name
+		return pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+			if input.Pfs != nil && toDelete[input.Pfs.Repo] && input.Pfs.Repo != name {
+				consumers[input.Pfs.Repo] = append(consumers[input.Pfs.Repo], name)
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if !request.Force {
+		var outside []string
+		for _, names := range consumers {
+			for _, name := range names {
+				if !toDelete[name] {
+					outside = append(outside, name)
+				}
+			}
+		}
+		if len(outside) > 0 {
+			sort.Strings(outside)
+			return nil, errors.Errorf("cannot delete pipelines, they have downstream pipelines that are not being deleted: %s", strings.Join(outside, ", "))
+		}
+	}
+
+	// Delete pipelines once all of the pipelines consuming them (within the set)
+	// have been deleted, so they are removed downstream first
+	deleted := make(map[string]bool)
+	for len(deleted) < len(toDelete) {
+		progress := false
+		for _, pipeline := range request.Pipelines {
+			if deleted[pipeline.Name] {
+				continue
+			}
+			ready := true
+			for _, consumer := range consumers[pipeline.Name] {
+				if toDelete[consumer] && !deleted[consumer] {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+			if err := a.deletePipeline(ctx, &pps.DeletePipelineRequest{
+				Pipeline: pipeline,
+				Force:    request.Force,
+				KeepRepo: request.KeepRepo,
+			}); err != nil {
+				return nil, err
+			}
+			deleted[pipeline.Name] = true
+			progress = true
+		}
+		if !progress {
+			return nil, errors.Errorf("cannot find a deletion order for pipelines, they contain a cycle")
+		}
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) error {
 	pipelineName := request.Pipeline.Name
 
@@ -2616,101 +3308,144 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+	pipelines, err := requestPipelines(request.Pipeline, request.Pipelines)
+	if err != nil {
+		return nil, err
 	}
-
 	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err != nil {
-			return err
+		for _, pipeline := range pipelines {
+			if err := a.startPipelineInTransaction(txnCtx, pipeline); err != nil {
+				if len(pipelines) > 1 {
+					return errors.Wrapf(err, "could not start pipeline %q", pipeline.Name)
+				}
+				return err
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
 
-		// check if the caller is authorized to update this pipeline
-		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return err
+// requestPipelines returns the pipelines named by a StartPipeline or
+// StopPipeline request.
+func requestPipelines(pipeline *pps.Pipeline, pipelines []*pps.Pipeline) ([]*pps.Pipeline, error) {
+	if pipeline != nil {
+		pipelines = append([]*pps.Pipeline{pipeline}, pipelines...)
+	}
+	if len(pipelines) == 0 {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	for _, pipeline := range pipelines {
+		if pipeline == nil {
+			return nil, errors.New("request.Pipelines cannot contain nil")
 		}
+	}
+	return pipelines, nil
+}
 
-		// Restore branch provenance, which may create a new output commit/job
-		provenance := append(branchProvenance(pipelineInfo.Details.Input),
-			client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
-		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return err
-		}
-		// restore same provenance to meta repo
+func (a *apiServer) startPipelineInTransaction(txnCtx *txncontext.TransactionContext, pipeline *pps.Pipeline) error {
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, pipeline.Name)
+	if err != nil {
+		return err
+	}
+
+	// check if the caller is authorized to update this pipeline
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+
+	// Restore branch provenance, which may create a new output commit/job
+	provenance := append(branchProvenance(pipelineInfo),
+		client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
+	if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
+	}); err != nil {
+		return err
+	}
+	// restore same provenance to meta repo
+	if !pipelineInfo.Details.NoMeta {
 		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
 			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
 			Provenance: provenance,
 		}); err != nil {
 			return err
 		}
-
-		newPipelineInfo := &pps.PipelineInfo{}
-		return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-			newPipelineInfo.Stopped = false
-			return nil
-		})
-	}); err != nil {
-		return nil, err
 	}
-	return &types.Empty{}, nil
+
+	newPipelineInfo := &pps.PipelineInfo{}
+	return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+		newPipelineInfo.Stopped = false
+		return nil
+	})
 }
 
 // StopPipeline implements the protobuf pps.StopPipeline RPC
 func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+	pipelines, err := requestPipelines(request.Pipeline, request.Pipelines)
+	if err != nil {
+		return nil, err
 	}
-
 	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err == nil {
-			// check if the caller is authorized to update this pipeline
-			// don't pass in the input - stopping the pipeline means they won't be read anymore,
-			// so we don't need to check any permissions
-			if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		for _, pipeline := range pipelines {
+			if err := a.stopPipelineInTransaction(txnCtx, pipeline); err != nil {
+				if len(pipelines) > 1 {
+					return errors.Wrapf(err, "could not stop pipeline %q", pipeline.Name)
+				}
 				return err
 			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
 
-			// Remove branch provenance to prevent new output and meta commits from being created
-			if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-				Provenance: nil,
-			}); err != nil {
-				return err
-			}
-			if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-				Provenance: nil,
-			}); err != nil && !errutil.IsNotFoundError(err) {
-				// don't error if we're stopping a spout or service pipeline
-				return err
-			}
+func (a *apiServer) stopPipelineInTransaction(txnCtx *txncontext.TransactionContext, pipeline *pps.Pipeline) error {
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, pipeline.Name)
+	if err == nil {
+		// check if the caller is authorized to update this pipeline
+		// don't pass in the input - stopping the pipeline means they won't be read anymore,
+		// so we don't need to check any permissions
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
 
-			newPipelineInfo := &pps.PipelineInfo{}
-			if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-				newPipelineInfo.Stopped = true
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else if !errutil.IsNotFoundError(err) {
+		// Remove branch provenance to prevent new output and meta commits from being created
+		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil {
+			return err
+		}
+		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil && !errutil.IsNotFoundError(err) {
+			// don't error if we're stopping a spout or service pipeline
 			return err
 		}
 
-		// Kill any remaining jobs
-		// if the pipeline output repo doesn't exist, we technically run this without authorization,
-		// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
-		return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
-	}); err != nil {
-		return nil, err
+		newPipelineInfo := &pps.PipelineInfo{}
+		if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+			newPipelineInfo.Stopped = true
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if !errutil.IsNotFoundError(err) {
+		return err
 	}
-	return &types.Empty{}, nil
+
+	// Kill any remaining jobs
+	// if the pipeline output repo doesn't exist, we technically run this without authorization,
+	// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
+	return a.stopAllJobsInPipeline(txnCtx, pipeline)
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -2759,7 +3494,143 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	return &types.Empty{}, nil
 }
 
-func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext) error {
+// ReprocessPipeline implements the protobuf pps.ReprocessPipeline RPC
+func (a *apiServer) ReprocessPipeline(ctx context.Context, request *pps.ReprocessPipelineRequest) (response *pps.Job, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.ReprocessSpec != "" &&
+		request.ReprocessSpec != client.ReprocessSpecUntilSuccess &&
+		request.ReprocessSpec != client.ReprocessSpecEveryJob {
+		return nil, errors.Errorf("reprocess spec must be one of '%s' or '%s'",
+			client.ReprocessSpecUntilSuccess, client.ReprocessSpecEveryJob)
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.Type == pps.PipelineInfo_PIPELINE_TYPE_SPOUT {
+			return errors.Errorf("cannot reprocess spout pipeline %q", request.Pipeline.Name)
+		}
+		if pipelineInfo.Stopped {
+			return errors.Errorf("cannot reprocess stopped pipeline %q", request.Pipeline.Name)
+		}
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, pipelineInfo.Details.Input, request.Pipeline.Name); err != nil {
+			return err
+		}
+		if request.Job != nil {
+			if err := a.checkReprocessJob(txnCtx, pipelineInfo, request.Job); err != nil {
+				return err
+			}
+		}
+		// Alias the head of the spec branch into a new commitset, which
+		// propagates new output commits (and therefore a new job) without
+		// creating a new version of the pipeline
+		specBranch := client.NewSystemRepo(request.Pipeline.Name, pfs.SpecRepoType).NewBranch("master")
+		branchInfo, err := a.env.PfsServer().InspectBranchInTransaction(txnCtx, &pfs.InspectBranchRequest{
+			Branch: specBranch,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := a.env.PfsServer().AliasCommitInTransaction(txnCtx, branchInfo.Head, specBranch); err != nil {
+			return err
+		}
+		// Unless the pipeline is reprocessed 'until_success', its job
+		// processes every datum
+		if request.ReprocessSpec != client.ReprocessSpecUntilSuccess {
+			propagater, ok := txnCtx.PpsPropagater.(*Propagater)
+			if !ok {
+				return errors.Errorf("cannot reprocess pipeline %q in this transaction", request.Pipeline.Name)
+			}
+			propagater.Reprocess(request.Pipeline.Name)
+		}
+		response = client.NewJob(request.Pipeline.Name, txnCtx.CommitSetID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// checkReprocessJob checks that job is the most recent job of the pipeline and
+// has finished, so that a new job reprocesses the datums of that job. The new
+// job's output commit is a child of the most recent job's, so the datums of an
+// older job can't be reprocessed without mixing in the later jobs' outputs.
+func (a *apiServer) checkReprocessJob(txnCtx *txncontext.TransactionContext, pipelineInfo *pps.PipelineInfo, job *pps.Job) error {
+	if job.Pipeline == nil || job.Pipeline.Name != pipelineInfo.Pipeline.Name {
+		return errors.Errorf("job %s is not a job of pipeline %q", job.ID, pipelineInfo.Pipeline.Name)
+	}
+	branchInfo, err := a.env.PfsServer().InspectBranchInTransaction(txnCtx, &pfs.InspectBranchRequest{
+		Branch: client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+	})
+	if err != nil {
+		return err
+	}
+	if branchInfo.Head == nil || branchInfo.Head.ID != job.ID {
+		return errors.Errorf("job %s is not the most recent job of pipeline %q, only the most recent job's datums can be reprocessed", job.ID, pipelineInfo.Pipeline.Name)
+	}
+	jobInfo := &pps.JobInfo{}
+	if err := a.jobs.ReadWrite(txnCtx.SqlTx).Get(ppsdb.JobKey(job), jobInfo); err != nil {
+		return err
+	}
+	if !pps.IsTerminal(jobInfo.State) {
+		return errors.Errorf("job %s has not finished", job.ID)
+	}
+	return nil
+}
+
+// ReleaseQuarantine implements the protobuf pps.ReleaseQuarantine RPC
+func (a *apiServer) ReleaseQuarantine(ctx context.Context, request *pps.ReleaseQuarantineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if request.DatumID == "" {
+		return nil, errors.New("must specify the datum to release")
+	}
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name, false)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineInfo.Details.QuarantineAfter == 0 {
+		return nil, errors.Errorf("pipeline %q doesn't quarantine datums", request.Pipeline.Name)
+	}
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, pipelineInfo.Details.Input, request.Pipeline.Name)
+	}); err != nil {
+		return nil, err
+	}
+	// The pipeline's workers consume the release when they next start a job
+	key := ppsutil.QuarantineReleaseKey(a.etcdPrefix, request.Pipeline.Name, request.DatumID)
+	if _, err := a.env.GetEtcdClient().Put(ctx, key, ""); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectWorkerHeartbeat implements the protobuf pps.InspectWorkerHeartbeat RPC
+func (a *apiServer) InspectWorkerHeartbeat(ctx context.Context, request *pps.InspectWorkerHeartbeatRequest) (response *pps.WorkerHeartbeats, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name, false)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineInfo.Details.Heartbeat == nil {
+		return nil, errors.Errorf("pipeline %q doesn't record heartbeats", request.Pipeline.Name)
+	}
+	heartbeats, err := ppsutil.WorkerHeartbeats(ctx, a.env.GetEtcdClient(), a.etcdPrefix, request.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pps.WorkerHeartbeats{Heartbeats: heartbeats}, nil
+}
+
+// propagateJobs creates the jobs for the output commits in the transaction's
+// commitset. The jobs of the pipelines in reprocess process every datum.
+func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext, reprocess map[string]bool) error {
 	commitInfos, err := a.env.PfsServer().InspectCommitSetInTransaction(txnCtx, client.NewCommitSet(txnCtx.CommitSetID))
 	if err != nil {
 		return err
@@ -2807,6 +3678,7 @@ func (a *apiServer) propagateJobs(txnCtx *txncontext.TransactionContext) error {
 			OutputCommit:    commitInfo.Commit,
 			Stats:           &pps.ProcessStats{},
 			Created:         types.TimestampNow(),
+			Reprocess:       reprocess[pipelineInfo.Pipeline.Name],
 		}
 		if err := ppsutil.UpdateJobState(pipelines, jobs, jobPtr, pps.JobState_JOB_CREATED, ""); err != nil {
 			return err
@@ -77,9 +77,10 @@ type ppsMaster struct {
 	masterCtx context.Context
 
 	// fields for monitorPipeline goros, monitorCrashingPipeline goros, etc.
-	monitorCancelsMu       sync.Mutex
-	monitorCancels         map[string]func() // protected by monitorCancelsMu
-	crashingMonitorCancels map[string]func() // also protected by monitorCancelsMu
+	monitorCancelsMu          sync.Mutex
+	monitorCancels            map[string]func() // protected by monitorCancelsMu
+	crashingMonitorCancels    map[string]func() // also protected by monitorCancelsMu
+	maintenanceMonitorCancels map[string]func() // also protected by monitorCancelsMu
 
 	// fields for the pollPipelines, pollPipelinePods, and watchPipelines goros
 	pollPipelinesMu sync.Mutex
@@ -95,9 +96,10 @@ type ppsMaster struct {
 // pipelines are created/removed.
 func (a *apiServer) master() {
 	m := &ppsMaster{
-		a:                      a,
-		monitorCancels:         make(map[string]func()),
-		crashingMonitorCancels: make(map[string]func()),
+		a:                         a,
+		monitorCancels:            make(map[string]func()),
+		crashingMonitorCancels:    make(map[string]func()),
+		maintenanceMonitorCancels: make(map[string]func()),
 	}
 
 	masterLock := dlock.NewDLock(a.env.GetEtcdClient(), path.Join(a.etcdPrefix, masterLockPath))
@@ -223,6 +225,8 @@ func (m *ppsMaster) deletePipelineResources(pipelineName string) (retErr error)
 	m.cancelMonitor(pipelineName)
 	// Same for cancelCrashingMonitor
 	m.cancelCrashingMonitor(pipelineName)
+	// And cancelMaintenanceMonitor
+	m.cancelMaintenanceMonitor(pipelineName)
 
 	kubeClient := m.a.env.GetKubeClient()
 	namespace := m.a.namespace
@@ -257,6 +261,11 @@ func (m *ppsMaster) deletePipelineResources(pipelineName string) (retErr error)
 		}
 	}
 
+	// Delete the heartbeats of op.pipeline's workers
+	if err := ppsutil.DeleteWorkerHeartbeats(ctx, m.a.env.GetEtcdClient(), m.a.etcdPrefix, pipelineName); err != nil {
+		return errors.Wrapf(err, "could not delete heartbeats")
+	}
+
 	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
 	// polling it.
 	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
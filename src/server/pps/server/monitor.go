@@ -19,10 +19,15 @@ package server
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"path"
+	"strconv"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
@@ -31,19 +36,30 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	workerserver "github.com/pachyderm/pachyderm/v2/src/server/worker/server"
 )
 
 const crashingBackoff = time.Second * 15
+
+// defaultCrashBackoffMax is the longest a CRASHING pipeline with a
+// crash_backoff waits between checks, if the crash_backoff doesn't set max.
+const defaultCrashBackoffMax = 5 * time.Minute
 const scaleUpInterval = time.Second * 30
+const datumScalingInterval = time.Second * 10
+const jobHistoryInterval = time.Second * 10
 
 //////////////////////////////////////////////////////////////////////////////
 //                     Locking Functions                                    //
@@ -120,6 +136,36 @@ func (m *ppsMaster) cancelCrashingMonitor(pipeline string) {
 	}
 }
 
+// startMaintenanceMonitor starts a new goroutine running monitorMaintenance
+// for 'pipelineInfo.Pipeline'
+//
+// Every pipeline with a maintenance schedule has a corresponding goro running
+// monitorMaintenance that wakes the pipeline controller when one of the
+// pipeline's maintenance windows starts or ends.
+func (m *ppsMaster) startMaintenanceMonitor(pipelineInfo *pps.PipelineInfo) {
+	pipeline := pipelineInfo.Pipeline.Name
+	m.monitorCancelsMu.Lock()
+	defer m.monitorCancelsMu.Unlock()
+	if _, ok := m.maintenanceMonitorCancels[pipeline]; !ok {
+		m.maintenanceMonitorCancels[pipeline] = m.startMonitorThread(
+			"monitorMaintenance for "+pipeline,
+			func(ctx context.Context) {
+				m.monitorMaintenance(ctx, pipelineInfo)
+			})
+	}
+}
+
+// cancelMaintenanceMonitor cancels the monitorMaintenance goroutine for
+// 'pipeline'. See m.startMaintenanceMonitor().
+func (m *ppsMaster) cancelMaintenanceMonitor(pipeline string) {
+	m.monitorCancelsMu.Lock()
+	defer m.monitorCancelsMu.Unlock()
+	if cancel, ok := m.maintenanceMonitorCancels[pipeline]; ok {
+		cancel()
+		delete(m.maintenanceMonitorCancels, pipeline)
+	}
+}
+
 // cancelAllMonitorsAndCrashingMonitors overlaps with cancelMonitor and
 // cancelCrashingMonitor, but also iterates over the existing members of
 // m.{crashingM,m}onitorCancels in the critical section, so that all monitors
@@ -132,7 +178,7 @@ func (m *ppsMaster) cancelCrashingMonitor(pipeline string) {
 func (m *ppsMaster) cancelAllMonitorsAndCrashingMonitors() {
 	m.monitorCancelsMu.Lock()
 	defer m.monitorCancelsMu.Unlock()
-	for _, monitorMap := range []map[string]func(){m.monitorCancels, m.crashingMonitorCancels} {
+	for _, monitorMap := range []map[string]func(){m.monitorCancels, m.crashingMonitorCancels, m.maintenanceMonitorCancels} {
 		for p := range monitorMap {
 			cancel := monitorMap[p]
 			cancel()
@@ -192,6 +238,30 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 		}
 		return nil
 	})
+	if pspec := pipelineInfo.Details.ParallelismSpec; pspec != nil && pspec.DatumsPerWorker > 0 {
+		eg.Go(func() error {
+			return backoff.RetryUntilCancel(ctx, func() error {
+				return m.scaleByDatums(ctx, pipelineInfo)
+			}, backoff.NewInfiniteBackOff(),
+				backoff.NotifyCtx(ctx, "scaleByDatums for "+pipeline))
+		})
+	}
+	if pipelineInfo.Details.JobHistoryLimit > 0 {
+		eg.Go(func() error {
+			return backoff.RetryUntilCancel(ctx, func() error {
+				return m.pruneJobHistory(ctx, pipelineInfo)
+			}, backoff.NewInfiniteBackOff(),
+				backoff.NotifyCtx(ctx, "pruneJobHistory for "+pipeline))
+		})
+	}
+	if pipelineInfo.Details.Heartbeat != nil {
+		eg.Go(func() error {
+			return backoff.RetryUntilCancel(ctx, func() error {
+				return m.monitorHeartbeats(ctx, pipelineInfo)
+			}, backoff.NewInfiniteBackOff(),
+				backoff.NotifyCtx(ctx, "monitorHeartbeats for "+pipeline))
+		})
+	}
 	if pipelineInfo.Details.Autoscaling {
 		// Capacity 1 gives us a bit of buffer so we don't needlessly go into
 		// standby when SubscribeCommit takes too long to return.
@@ -393,22 +463,77 @@ func (m *ppsMaster) monitorCrashingPipeline(ctx context.Context, pipelineInfo *p
 		parallelism = 1
 	}
 	pipelineRCName := ppsutil.PipelineRcName(pipeline, pipelineInfo.Version)
+	var b backoff.BackOff = backoff.NewConstantBackOff(crashingBackoff)
+	crashSpec := pipelineInfo.Details.CrashBackoff
+	if crashSpec != nil {
+		var err error
+		if b, err = crashBackOff(crashSpec); err != nil {
+			log.Errorf("invalid crash_backoff for %q, using the default: %v", pipeline, err)
+			b, crashSpec = backoff.NewConstantBackOff(crashingBackoff), nil
+		}
+	}
+	var retries int64
 	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
+		if pspec := pipelineInfo.Details.ParallelismSpec; pspec != nil && pspec.DatumsPerWorker > 0 {
+			// the expected number of workers changes with the pending datums
+			current := &pps.PipelineInfo{}
+			if err := m.a.pipelines.ReadOnly(ctx).Get(pipelineInfo.SpecCommit, current); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if parallelism = current.Parallelism; parallelism == 0 {
+				parallelism = 1
+			}
+		}
 		workerStatus, err := workerserver.Status(ctx, pipelineRCName,
 			m.a.env.GetEtcdClient(), m.a.etcdPrefix, m.a.workerGrpcPort)
 		if err != nil {
 			return errors.Wrap(err, "could not check if all workers are up")
 		}
 		if int(parallelism) == len(workerStatus) {
+			current := &pps.PipelineInfo{}
+			if err := m.a.pipelines.ReadOnly(ctx).Get(pipelineInfo.SpecCommit, current); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if ppsutil.IsEgressFailure(current) {
+				// the workers are up, but the pipeline stays CRASHING until the
+				// worker's egress succeeds
+				return nil
+			}
+			if pipelineInfo.Details.Heartbeat != nil {
+				// don't leave CRASHING until the workers' heartbeats resume
+				stalled, err := m.heartbeatsStalled(ctx, pipelineInfo, time.Time{})
+				if err != nil {
+					return err
+				}
+				if stalled > 0 {
+					return nil
+				}
+			}
 			if err := m.a.transitionPipelineState(ctx, pipelineInfo.SpecCommit,
 				[]pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING},
 				pps.PipelineState_PIPELINE_RUNNING, ""); err != nil {
 				return errors.Wrap(err, "could not transition pipeline to RUNNING")
 			}
 			cancelInner() // done--pipeline is out of CRASHING
+			return nil
+		}
+		if crashSpec == nil {
+			return nil // loop again to check for new workers
+		}
+		retries++
+		if crashSpec.MaxRetries > 0 && retries > crashSpec.MaxRetries {
+			if err := m.a.transitionPipelineState(ctx, pipelineInfo.SpecCommit,
+				[]pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING},
+				pps.PipelineState_PIPELINE_FAILURE,
+				fmt.Sprintf("pipeline is still crashing after %d retries", crashSpec.MaxRetries)); err != nil {
+				return errors.Wrap(err, "could not transition pipeline to FAILURE")
+			}
+			cancelInner() // done--pipeline has failed
+			return nil
 		}
-		return nil // loop again to check for new workers
-	}), backoff.NewConstantBackOff(crashingBackoff),
+		// returning an error (rather than nil) lets the backoff grow
+		return errors.Errorf("%d of %d workers are up (retry %d)", len(workerStatus), parallelism, retries)
+	}), b,
 		backoff.NotifyContinue("monitorCrashingPipeline for "+pipeline),
 	); err != nil && ctx.Err() == nil {
 		// retryUntilCancel should exit iff 'ctx' is cancelled, so this should be
@@ -417,7 +542,316 @@ func (m *ppsMaster) monitorCrashingPipeline(ctx context.Context, pipelineInfo *p
 	}
 }
 
+// monitorMaintenance sends an event for 'pipelineInfo's pipeline to the
+// pipeline controller whenever one of its maintenance windows starts or ends,
+// so that the pipeline is paused and resumed promptly rather than when it's
+// next polled.
+func (m *ppsMaster) monitorMaintenance(ctx context.Context, pipelineInfo *pps.PipelineInfo) {
+	pipeline := pipelineInfo.Pipeline.Name
+	for {
+		now := time.Now()
+		start, end, err := maintenanceWindow(pipelineInfo.Details.MaintenanceSchedule, now)
+		if err != nil {
+			// The schedule is validated when the pipeline is created
+			log.Errorf("PPS master: invalid maintenance schedule for pipeline %q: %v", pipeline, err)
+			return
+		}
+		next := start
+		if !start.After(now) {
+			next = end
+		}
+		select {
+		case <-time.After(next.Sub(now)):
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case m.eventCh <- &pipelineEvent{eventType: writeEv, pipeline: pipeline}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// maintenanceWindow returns the window of 'spec' that contains 'now', or if
+// 'now' isn't in a window, the next one.
+func maintenanceWindow(spec *pps.MaintenanceSchedule, now time.Time) (start, end time.Time, _ error) {
+	schedule, err := cron.ParseStandard(spec.Cron)
+	if err != nil {
+		return start, end, errors.Wrapf(err, "invalid cron expression")
+	}
+	if _, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return start, end, errors.Errorf("cron must be a cron expression, not an @every interval (got %q)", spec.Cron)
+	}
+	duration, err := types.DurationFromProto(spec.Duration)
+	if err != nil {
+		return start, end, errors.Wrapf(err, "invalid duration")
+	}
+	if duration <= 0 {
+		return start, end, errors.Errorf("duration must be positive (got %v)", duration)
+	}
+	// The earliest window that starts after now-duration is the only one that
+	// may contain now
+	start = schedule.Next(now.Add(-duration))
+	return start, start.Add(duration), nil
+}
+
+// crashBackOff returns the backoff described by a pipeline's crash_backoff.
+func crashBackOff(spec *pps.CrashBackoff) (*backoff.ExponentialBackOff, error) {
+	if spec.MaxRetries < 0 {
+		return nil, errors.Errorf("max_retries must be non-negative (got %d)", spec.MaxRetries)
+	}
+	if spec.Multiplier != 0 && spec.Multiplier < 1 {
+		return nil, errors.Errorf("multiplier must be at least 1 (got %v)", spec.Multiplier)
+	}
+	initial, err := types.DurationFromProto(spec.Initial)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid initial interval")
+	}
+	if initial <= 0 {
+		return nil, errors.Errorf("initial interval must be positive (got %v)", initial)
+	}
+	b := backoff.NewInfiniteBackOff()
+	b.InitialInterval = initial
+	b.MaxInterval = defaultCrashBackoffMax
+	if b.MaxInterval < initial {
+		b.MaxInterval = initial
+	}
+	b.RandomizationFactor = 0
+	if spec.Multiplier != 0 {
+		b.Multiplier = spec.Multiplier
+	}
+	if spec.Max != nil {
+		if b.MaxInterval, err = types.DurationFromProto(spec.Max); err != nil {
+			return nil, errors.Wrapf(err, "invalid max interval")
+		}
+		if b.MaxInterval < initial {
+			return nil, errors.Errorf("max interval (%v) must not be less than the initial interval (%v)", b.MaxInterval, initial)
+		}
+	}
+	return b, nil
+}
+
+// scaleByDatums scales the workers of 'pipelineInfo', whose ParallelismSpec
+// sets DatumsPerWorker, with the number of datums its unfinished jobs have yet
+// to process, and records the new target in PipelineInfo.Parallelism.
+func (m *ppsMaster) scaleByDatums(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	pspec := pipelineInfo.Details.ParallelismSpec
+	ticker := time.NewTicker(datumScalingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+		current := &pps.PipelineInfo{}
+		if err := m.a.pipelines.ReadOnly(ctx).Get(pipelineInfo.SpecCommit, current); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if current.State != pps.PipelineState_PIPELINE_RUNNING {
+			continue // standby and crashing pipelines are scaled by the controller
+		}
+		var pending int64
+		jobInfo := &pps.JobInfo{}
+		if err := m.a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipelineInfo.Pipeline.Name, jobInfo, col.DefaultOptions(), func(string) error {
+			if !pps.IsTerminal(jobInfo.State) && jobInfo.DataPending > 0 {
+				pending += jobInfo.DataPending
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		target := datumsParallelism(pspec, pending)
+		rc := m.a.env.GetKubeClient().CoreV1().ReplicationControllers(m.a.namespace)
+		scale, err := rc.GetScale(current.Details.WorkerRc, metav1.GetOptions{})
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if uint64(scale.Spec.Replicas) != target {
+			log.Infof("PPS master: scaling %q to %d workers for %d pending datums", pipelineInfo.Pipeline.Name, target, pending)
+			scale.Spec.Replicas = int32(target)
+			if _, err := rc.UpdateScale(current.Details.WorkerRc, scale); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if current.Parallelism != target {
+			if err := dbutil.WithTx(ctx, m.a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+				return errors.EnsureStack(m.a.pipelines.ReadWrite(sqlTx).Update(pipelineInfo.SpecCommit, current, func() error {
+					current.Parallelism = target
+					return nil
+				}))
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pruneJobHistory periodically deletes the finished jobs of 'pipelineInfo'
+// beyond the most recent JobHistoryLimit jobs, squashing their meta commits
+// into the meta commits of later jobs.
+func (m *ppsMaster) pruneJobHistory(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	ticker := time.NewTicker(jobHistoryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+		// jobs are listed newest first
+		var seen int64
+		var prune []*pps.JobInfo
+		jobInfo := &pps.JobInfo{}
+		if err := m.a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipelineInfo.Pipeline.Name, jobInfo, col.DefaultOptions(), func(string) error {
+			seen++
+			if seen > pipelineInfo.Details.JobHistoryLimit && pps.IsTerminal(jobInfo.State) {
+				prune = append(prune, proto.Clone(jobInfo).(*pps.JobInfo))
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(prune) == 0 {
+			continue
+		}
+		if err := m.a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			jobs := m.a.jobs.ReadWrite(txnCtx.SqlTx)
+			for _, ji := range prune {
+				if err := jobs.Delete(ppsdb.JobKey(ji.Job)); err != nil && !col.IsErrNotFound(err) {
+					return errors.EnsureStack(err)
+				}
+				if pipelineInfo.Details.NoMeta || ji.OutputCommit == nil {
+					continue
+				}
+				if err := m.a.env.PfsServer().SquashCommitInTransaction(txnCtx, ppsutil.MetaCommit(ji.OutputCommit)); err != nil && !pfsServer.IsCommitNotFoundErr(err) {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		log.Infof("PPS master: pruned %d old jobs of %q", len(prune), pipelineInfo.Pipeline.Name)
+	}
+}
+
+// datumsParallelism returns the number of workers 'pspec' calls for when
+// 'pending' datums have yet to be processed.
+func datumsParallelism(pspec *pps.ParallelismSpec, pending int64) uint64 {
+	target := uint64((pending + pspec.DatumsPerWorker - 1) / pspec.DatumsPerWorker)
+	if target < 1 {
+		target = 1
+	}
+	if target > pspec.Max {
+		target = pspec.Max
+	}
+	return target
+}
+
+// monitorHeartbeats marks 'pipelineInfo' CRASHING whenever it's RUNNING but
+// none of its workers has recorded a heartbeat within the pipeline's heartbeat
+// timeout.
+func (m *ppsMaster) monitorHeartbeats(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	interval, err := types.DurationFromProto(pipelineInfo.Details.Heartbeat.Interval)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	// Workers that are just starting (e.g. coming out of standby) haven't had
+	// a chance to record a heartbeat yet, so measure from when the pipeline
+	// was last seen entering RUNNING.
+	running := time.Now()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+		current := &pps.PipelineInfo{}
+		if err := m.a.pipelines.ReadOnly(ctx).Get(pipelineInfo.SpecCommit, current); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if current.State != pps.PipelineState_PIPELINE_RUNNING {
+			running = time.Now()
+			continue
+		}
+		stalled, err := m.heartbeatsStalled(ctx, pipelineInfo, running)
+		if err != nil {
+			return err
+		}
+		if stalled == 0 {
+			continue
+		}
+		if err := m.a.transitionPipelineState(ctx, pipelineInfo.SpecCommit,
+			[]pps.PipelineState{pps.PipelineState_PIPELINE_RUNNING},
+			pps.PipelineState_PIPELINE_CRASHING,
+			fmt.Sprintf("worker heartbeats have stalled for %v", stalled.Round(time.Second))); err != nil {
+			pte := &ppsutil.PipelineTransitionError{}
+			if !errors.As(err, &pte) {
+				return err
+			}
+			// the pipeline left RUNNING on its own
+		}
+		running = time.Now()
+	}
+}
+
+// heartbeatsStalled returns how long it has been since the newest heartbeat
+// of 'pipelineInfo's workers (or 'since', if that's later), if that's longer
+// than the pipeline's heartbeat timeout, and 0 otherwise.
+func (m *ppsMaster) heartbeatsStalled(ctx context.Context, pipelineInfo *pps.PipelineInfo, since time.Time) (time.Duration, error) {
+	timeout, err := ppsutil.HeartbeatTimeout(pipelineInfo.Details.Heartbeat)
+	if err != nil {
+		return 0, err
+	}
+	heartbeats, err := ppsutil.WorkerHeartbeats(ctx, m.a.env.GetEtcdClient(), m.a.etcdPrefix, pipelineInfo.Pipeline.Name)
+	if err != nil {
+		return 0, err
+	}
+	latest := since
+	for _, hb := range heartbeats {
+		t, err := types.TimestampFromProto(hb.Timestamp)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	if stalled := time.Since(latest); stalled > timeout {
+		return stalled, nil
+	}
+	return 0, nil
+}
+
 func cronTick(pachClient *client.APIClient, now time.Time, cron *pps.CronInput) error {
+	var expired []string
+	if !cron.Overwrite && hasCronRetention(cron) {
+		files, err := pachClient.ListFileAll(client.NewCommit(cron.Repo, "master", ""), "")
+		if err != nil {
+			return err
+		}
+		var names []string
+		var ticks []time.Time
+		for _, fi := range files {
+			tick, err := time.Parse(time.RFC3339, path.Base(fi.File.Path))
+			if err != nil {
+				continue // not a tick file
+			}
+			names = append(names, fi.File.Path)
+			ticks = append(ticks, tick)
+		}
+		// the new tick counts towards the retained ticks
+		ticks = append(ticks, now)
+		n, err := expiredCronTicks(ticks, now, cron)
+		if err != nil {
+			return err
+		}
+		expired = names[:n]
+	}
 	return pachClient.WithModifyFileClient(
 		client.NewRepo(cron.Repo).NewCommit("master", ""),
 		func(m client.ModifyFile) error {
@@ -426,10 +860,110 @@ func cronTick(pachClient *client.APIClient, now time.Time, cron *pps.CronInput)
 					return err
 				}
 			}
-			return m.PutFile(now.Format(time.RFC3339), bytes.NewReader(nil))
+			for _, file := range expired {
+				if err := m.DeleteFile(file); err != nil {
+					return err
+				}
+			}
+			return m.PutFile(now.Format(time.RFC3339), bytes.NewReader(cronTickContents(now, cron)))
 		})
 }
 
+func hasCronRetention(cron *pps.CronInput) bool {
+	return cron.KeepTicks > 0 || cron.KeepDuration != nil
+}
+
+// expiredCronTicks returns how many of 'ticks', which are sorted from oldest
+// to newest, fall outside of the cron input's retention limits at time 'now'.
+// The newest tick is never expired.
+func expiredCronTicks(ticks []time.Time, now time.Time, cron *pps.CronInput) (int, error) {
+	var n int
+	if cron.KeepTicks > 0 && uint64(len(ticks)) > cron.KeepTicks {
+		n = len(ticks) - int(cron.KeepTicks)
+	}
+	if cron.KeepDuration != nil {
+		keep, err := types.DurationFromProto(cron.KeepDuration)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		cutoff := now.Add(-keep)
+		for n < len(ticks) && ticks[n].Before(cutoff) {
+			n++
+		}
+	}
+	if n >= len(ticks) {
+		n = len(ticks) - 1
+	}
+	if n < 0 {
+		n = 0
+	}
+	return n, nil
+}
+
+// squashExpiredCronCommits squashes the commit sets of the tick commits in a
+// cron input's repo that fall outside of its retention limits. Commit sets
+// that can't be squashed yet (e.g. because a downstream job is still running)
+// are left for a later tick.
+func squashExpiredCronCommits(pachClient *client.APIClient, now time.Time, cron *pps.CronInput) error {
+	var commitInfos []*pfs.CommitInfo
+	// ListCommit can't list the ancestors of a commit in reverse, so the
+	// commits are listed newest first, and reversed below
+	if err := pachClient.ListCommitF(client.NewRepo(cron.Repo), client.NewCommit(cron.Repo, "master", ""), nil, 0, false, func(ci *pfs.CommitInfo) error {
+		if ci.Origin.Kind != pfs.OriginKind_USER || ci.Started == nil {
+			return nil // only tick commits are squashed
+		}
+		commitInfos = append(commitInfos, ci)
+		return nil
+	}); err != nil {
+		return err
+	}
+	ticks := make([]time.Time, len(commitInfos))
+	for i, j := 0, len(commitInfos)-1; i < j; i, j = i+1, j-1 {
+		commitInfos[i], commitInfos[j] = commitInfos[j], commitInfos[i]
+	}
+	for i, ci := range commitInfos {
+		started, err := types.TimestampFromProto(ci.Started)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		ticks[i] = started
+	}
+	n, err := expiredCronTicks(ticks, now, cron)
+	if err != nil {
+		return err
+	}
+	for _, ci := range commitInfos[:n] {
+		if err := pachClient.SquashCommitSet(ci.Commit.ID); err != nil {
+			return errors.Wrapf(err, "could not squash commit set %s", ci.Commit.ID)
+		}
+	}
+	return nil
+}
+
+// cronTickContents returns the contents of the file written for a cron tick,
+// formatted according to the cron input's format.
+func cronTickContents(now time.Time, cron *pps.CronInput) []byte {
+	if cron.Format == "" && !cron.Json {
+		return nil
+	}
+	var tick string
+	switch cron.Format {
+	case "", client.CronFormatRFC3339:
+		tick = now.Format(time.RFC3339)
+	case client.CronFormatUnix:
+		tick = strconv.FormatInt(now.Unix(), 10)
+	default:
+		tick = now.Format(cron.Format)
+	}
+	if !cron.Json {
+		return []byte(tick + "\n")
+	}
+	contents, _ := json.Marshal(struct {
+		Tick string `json:"tick"`
+	}{Tick: tick})
+	return append(contents, '\n')
+}
+
 // makeCronCommits makes commits to a single cron input's repo. It's
 // a helper function called by monitorPipeline.
 func (m *ppsMaster) makeCronCommits(ctx context.Context, in *pps.Input) error {
@@ -455,6 +989,11 @@ func (m *ppsMaster) makeCronCommits(ctx context.Context, in *pps.Input) error {
 		if err := cronTick(pachClient, next, in.Cron); err != nil {
 			return err
 		}
+		if hasCronRetention(in.Cron) {
+			if err := squashExpiredCronCommits(pachClient, next, in.Cron); err != nil {
+				log.Errorf("could not enforce retention for cron input %q: %v", in.Cron.Name, err)
+			}
+		}
 		// set latestTime to the next time
 		latestTime = next
 	}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	// A window from 02:00 to 03:30 every day
+	spec := &pps.MaintenanceSchedule{
+		Cron:     "0 2 * * *",
+		Duration: types.DurationProto(90 * time.Minute),
+	}
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.Local)
+	windowStart := day.Add(2 * time.Hour)
+	windowEnd := windowStart.Add(90 * time.Minute)
+
+	// Before the window, the next window is returned
+	start, end, err := maintenanceWindow(spec, day.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, windowStart, start)
+	require.Equal(t, windowEnd, end)
+
+	// During the window, the current window is returned
+	for _, now := range []time.Time{windowStart, windowStart.Add(time.Hour), windowEnd.Add(-time.Second)} {
+		start, end, err = maintenanceWindow(spec, now)
+		require.NoError(t, err)
+		require.Equal(t, windowStart, start)
+		require.Equal(t, windowEnd, end)
+	}
+
+	// Once the window ends, the next day's window is returned
+	start, end, err = maintenanceWindow(spec, windowEnd)
+	require.NoError(t, err)
+	require.Equal(t, windowStart.AddDate(0, 0, 1), start)
+	require.Equal(t, windowEnd.AddDate(0, 0, 1), end)
+
+	// Invalid schedules are rejected
+	_, _, err = maintenanceWindow(&pps.MaintenanceSchedule{Cron: "@every 1h", Duration: spec.Duration}, day)
+	require.YesError(t, err)
+	_, _, err = maintenanceWindow(&pps.MaintenanceSchedule{Cron: "not a cron", Duration: spec.Duration}, day)
+	require.YesError(t, err)
+	_, _, err = maintenanceWindow(&pps.MaintenanceSchedule{Cron: spec.Cron}, day)
+	require.YesError(t, err)
+	_, _, err = maintenanceWindow(&pps.MaintenanceSchedule{Cron: spec.Cron, Duration: types.DurationProto(0)}, day)
+	require.YesError(t, err)
+}
+
+func TestExpiredCronTicks(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	var ticks []time.Time
+	for i := 4; i >= 0; i-- {
+		ticks = append(ticks, now.Add(-time.Duration(i)*time.Hour))
+	}
+
+	// Without limits, nothing expires
+	n, err := expiredCronTicks(ticks, now, &pps.CronInput{})
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// keep_ticks keeps the most recent ticks
+	n, err = expiredCronTicks(ticks, now, &pps.CronInput{KeepTicks: 2})
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	n, err = expiredCronTicks(ticks, now, &pps.CronInput{KeepTicks: 10})
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// keep_duration keeps the ticks within the duration
+	n, err = expiredCronTicks(ticks, now, &pps.CronInput{KeepDuration: types.DurationProto(150 * time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// With both set, a tick outside either limit expires
+	n, err = expiredCronTicks(ticks, now, &pps.CronInput{KeepTicks: 4, KeepDuration: types.DurationProto(150 * time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// The most recent tick is never expired
+	n, err = expiredCronTicks(ticks, now.Add(24*time.Hour), &pps.CronInput{KeepDuration: types.DurationProto(time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
+
+func TestCronRetention(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	repo := "cron"
+	require.NoError(t, env.PachClient.CreateRepo(repo))
+	require.NoError(t, env.PachClient.CreateBranch(repo, "master", "", "", nil))
+	cron := &pps.CronInput{Name: "time", Repo: repo, KeepTicks: 2}
+
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		now := start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, cronTick(env.PachClient, now, cron))
+		require.NoError(t, squashExpiredCronCommits(env.PachClient, now, cron))
+	}
+
+	// Only the last two ticks are left, both as files and as commits
+	files, err := env.PachClient.ListFileAll(client.NewCommit(repo, "master", ""), "")
+	require.NoError(t, err)
+	var paths []string
+	for _, fi := range files {
+		paths = append(paths, fi.File.Path)
+	}
+	require.Equal(t, []string{
+		"/" + start.Add(2*time.Minute).Format(time.RFC3339),
+		"/" + start.Add(3*time.Minute).Format(time.RFC3339),
+	}, paths)
+	commitInfos, err := env.PachClient.ListCommit(client.NewRepo(repo), client.NewCommit(repo, "master", ""), nil, 0)
+	require.NoError(t, err)
+	var ticks int
+	for _, ci := range commitInfos {
+		if ci.Origin.Kind == pfs.OriginKind_USER {
+			ticks++
+		}
+	}
+	require.Equal(t, 2, ticks)
+}
@@ -44,3 +44,30 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, workers)
 }
+
+func TestDatumsParallelism(t *testing.T) {
+	pspec := &pps.ParallelismSpec{DatumsPerWorker: 10, Max: 4}
+	// At least one worker runs, even with nothing to process
+	require.Equal(t, uint64(1), datumsParallelism(pspec, 0))
+	require.Equal(t, uint64(1), datumsParallelism(pspec, 10))
+	// Partial batches of datums get a worker
+	require.Equal(t, uint64(2), datumsParallelism(pspec, 11))
+	require.Equal(t, uint64(3), datumsParallelism(pspec, 30))
+	// The number of workers is capped at max
+	require.Equal(t, uint64(4), datumsParallelism(pspec, 200))
+}
+
+func TestValidateDatumsPerWorker(t *testing.T) {
+	require.NoError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{Constant: 3})))
+	require.NoError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{DatumsPerWorker: 10, Max: 4})))
+	require.YesError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{DatumsPerWorker: -1})))
+	require.YesError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{DatumsPerWorker: 10})))
+	require.YesError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{DatumsPerWorker: 10, Max: 4, Constant: 2})))
+	require.YesError(t, validateDatumsPerWorker(wrap(t,
+		&pps.ParallelismSpec{Max: 4})))
+}
@@ -145,6 +145,17 @@ func (m *ppsMaster) newPipelineOp(ctx context.Context, pipeline string) (*pipeli
 }
 
 func (op *pipelineOp) run() error {
+	// A pipeline update always passes through STARTING, so restart the
+	// maintenance monitor there in case the schedule changed
+	switch op.pipelineInfo.State {
+	case pps.PipelineState_PIPELINE_STARTING, pps.PipelineState_PIPELINE_FAILURE:
+		op.stopMaintenanceMonitor()
+	}
+	if op.pipelineInfo.Details.MaintenanceSchedule == nil {
+		op.stopMaintenanceMonitor()
+	} else if op.pipelineInfo.State != pps.PipelineState_PIPELINE_FAILURE {
+		op.startMaintenanceMonitor()
+	}
 	// Bring 'pipeline' into the correct state by taking appropriate action
 	switch op.pipelineInfo.State {
 	case pps.PipelineState_PIPELINE_STARTING, pps.PipelineState_PIPELINE_RESTARTING:
@@ -157,8 +168,8 @@ func (op *pipelineOp) run() error {
 				return err
 			}
 		}
-		if op.pipelineInfo.Stopped {
-			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		if paused, reason := op.paused(); paused {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, reason)
 		}
 		op.stopCrashingPipelineMonitor()
 		// trigger another event
@@ -172,8 +183,8 @@ func (op *pipelineOp) run() error {
 		if !op.rcIsFresh() {
 			return op.restartPipeline("stale RC") // step() will be called again after collection write
 		}
-		if op.pipelineInfo.Stopped {
-			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		if paused, reason := op.paused(); paused {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, reason)
 		}
 
 		op.stopCrashingPipelineMonitor()
@@ -185,8 +196,8 @@ func (op *pipelineOp) run() error {
 		if !op.rcIsFresh() {
 			return op.restartPipeline("stale RC") // step() will be called again after collection write
 		}
-		if op.pipelineInfo.Stopped {
-			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		if paused, reason := op.paused(); paused {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, reason)
 		}
 
 		op.stopCrashingPipelineMonitor()
@@ -198,9 +209,10 @@ func (op *pipelineOp) run() error {
 		if !op.rcIsFresh() {
 			return op.restartPipeline("stale RC") // step() will be called again after collection write
 		}
-		if !op.pipelineInfo.Stopped {
-			// StartPipeline has been called (so spec commit is updated), but new spec
-			// commit hasn't been propagated to PipelineInfo or RC yet
+		if paused, _ := op.paused(); !paused {
+			// StartPipeline has been called (so spec commit is updated), or the
+			// pipeline's maintenance window has ended, but the change hasn't been
+			// propagated to PipelineInfo or RC yet
 			target := pps.PipelineState_PIPELINE_RUNNING
 			if op.pipelineInfo.Details.Autoscaling {
 				target = pps.PipelineState_PIPELINE_STANDBY
@@ -232,8 +244,8 @@ func (op *pipelineOp) run() error {
 		if !op.rcIsFresh() {
 			return op.restartPipeline("stale RC") // step() will be called again after collection write
 		}
-		if op.pipelineInfo.Stopped {
-			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		if paused, reason := op.paused(); paused {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, reason)
 		}
 		// start a monitor to poll k8s and update us when it goes into a running state
 		op.startPipelineMonitor()
@@ -431,6 +443,38 @@ func (op *pipelineOp) stopCrashingPipelineMonitor() {
 	op.m.cancelCrashingMonitor(op.pipelineInfo.Pipeline.Name)
 }
 
+func (op *pipelineOp) startMaintenanceMonitor() {
+	op.m.startMaintenanceMonitor(op.pipelineInfo)
+}
+
+func (op *pipelineOp) stopMaintenanceMonitor() {
+	op.m.cancelMaintenanceMonitor(op.pipelineInfo.Pipeline.Name)
+}
+
+// paused returns true if the pipeline should be PAUSED, because it was stopped
+// or because it's in one of its maintenance windows. In the latter case, it
+// also returns the reason to record on the pipeline.
+func (op *pipelineOp) paused() (bool, string) {
+	if op.pipelineInfo.Stopped {
+		return true, ""
+	}
+	spec := op.pipelineInfo.Details.MaintenanceSchedule
+	if spec == nil {
+		return false, ""
+	}
+	now := time.Now()
+	start, end, err := maintenanceWindow(spec, now)
+	if err != nil {
+		// The schedule is validated when the pipeline is created
+		log.Errorf("PPS master: invalid maintenance schedule for pipeline %q: %v", op.pipelineInfo.Pipeline.Name, err)
+		return false, ""
+	}
+	if start.After(now) {
+		return false, ""
+	}
+	return true, fmt.Sprintf("in maintenance window until %v", end.Format(time.RFC3339))
+}
+
 // finishPipelineOutputCommits finishes any output commits of
 // 'pipelineInfo.Pipeline' with an empty tree.
 // TODO(msteffen) Note that if the pipeline has any jobs (which can happen if
@@ -514,8 +558,12 @@ func (op *pipelineOp) scaleUpPipeline() (retErr error) {
 
 	// compute target pipeline parallelism
 	parallelism := uint64(1)
-	if op.pipelineInfo.Details.ParallelismSpec != nil {
-		parallelism = op.pipelineInfo.Details.ParallelismSpec.Constant
+	if pspec := op.pipelineInfo.Details.ParallelismSpec; pspec != nil {
+		parallelism = pspec.Constant
+		if pspec.DatumsPerWorker > 0 {
+			// resume at the target last chosen by monitorPipeline
+			parallelism = op.pipelineInfo.Parallelism
+		}
 	}
 
 	// update pipeline RC
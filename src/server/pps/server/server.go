@@ -18,6 +18,10 @@ func NewAPIServer(
 	reporter *metrics.Reporter,
 ) (ppsiface.APIServer, error) {
 	etcdPrefix := path.Join(env.Config().EtcdPrefix, env.Config().PPSEtcdPrefix)
+	nodePools, err := parseNodePools(env.Config().WorkerNodePools)
+	if err != nil {
+		return nil, err
+	}
 	apiServer := &apiServer{
 		Logger:                log.NewLogger("pps.API", env.Logger()),
 		env:                   env,
@@ -39,6 +43,7 @@ func NewAPIServer(
 		port:                  env.Config().Port,
 		peerPort:              env.Config().PeerPort,
 		gcPercent:             env.Config().GCPercent,
+		nodePools:             nodePools,
 	}
 	apiServer.validateKube()
 	go apiServer.master()
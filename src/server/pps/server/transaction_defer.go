@@ -17,12 +17,16 @@ type Propagater struct {
 	a        *apiServer
 	txnCtx   *txncontext.TransactionContext
 	notified bool
+	// reprocess holds the pipelines reprocessed in this transaction whose jobs
+	// process every datum
+	reprocess map[string]bool
 }
 
 func (a *apiServer) NewPropagater(txnCtx *txncontext.TransactionContext) txncontext.PpsPropagater {
 	return &Propagater{
-		a:      a,
-		txnCtx: txnCtx,
+		a:         a,
+		txnCtx:    txnCtx,
+		reprocess: make(map[string]bool),
 	}
 }
 
@@ -33,10 +37,17 @@ func (t *Propagater) PropagateJobs() {
 	t.notified = true
 }
 
+// Reprocess records that the job created for pipeline at the end of the
+// transaction should process every datum, rather than skipping the datums that
+// succeeded in the pipeline's previous job.
+func (t *Propagater) Reprocess(pipeline string) {
+	t.reprocess[pipeline] = true
+}
+
 // Run creates any jobs for the modified CommitSets
 func (t *Propagater) Run() error {
 	if t.notified {
-		return t.a.propagateJobs(t.txnCtx)
+		return t.a.propagateJobs(t.txnCtx, t.reprocess)
 	}
 	return nil
 }
@@ -54,6 +54,7 @@ type workerOptions struct {
 	volumes               []v1.Volume         // Volumes that we expose to the user container
 	volumeMounts          []v1.VolumeMount    // Paths where we mount each volume in 'volumes'
 	schedulingSpec        *pps.SchedulingSpec // the SchedulingSpec for the pipeline
+	nodeSelector          map[string]string   // the node selector of the pipeline's workers
 	podSpec               string
 	podPatch              string
 
@@ -126,6 +127,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	}, {
 		Name:  client.PPSPipelineNameEnv,
 		Value: pipelineInfo.Pipeline.Name,
+	}, {
+		Name:  "MAX_CONCURRENT_JOBS",
+		Value: strconv.Itoa(a.env.Config().MaxConcurrentJobs),
 	},
 		// These are set explicitly below to prevent kubernetes from setting them to the service host and port.
 		{
@@ -371,8 +375,8 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		TerminationGracePeriodSeconds: &zeroVal,
 		SecurityContext:               securityContext,
 	}
+	podSpec.NodeSelector = options.nodeSelector
 	if options.schedulingSpec != nil {
-		podSpec.NodeSelector = options.schedulingSpec.NodeSelector
 		podSpec.PriorityClassName = options.schedulingSpec.PriorityClassName
 	}
 
@@ -542,6 +546,55 @@ func (a *apiServer) getWorkerOptions(pipelineInfo *pps.PipelineInfo) (*workerOpt
 		}
 	}
 
+	for _, configMap := range transform.ConfigMaps {
+		if configMap.MountPath != "" {
+			// Prefix the volume name so that it can't collide with a secret's
+			volumeName := "configmap-" + configMap.Name
+			volumes = append(volumes, v1.Volume{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: v1.LocalObjectReference{
+							Name: configMap.Name,
+						},
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, v1.VolumeMount{
+				Name:      volumeName,
+				MountPath: configMap.MountPath,
+			})
+		}
+		if configMap.EnvVar != "" {
+			workerEnv = append(workerEnv, v1.EnvVar{
+				Name: configMap.EnvVar,
+				ValueFrom: &v1.EnvVarSource{
+					ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{
+							Name: configMap.Name,
+						},
+						Key: configMap.Key,
+					},
+				},
+			})
+		}
+	}
+
+	if egress := pipelineInfo.Details.Egress; egress != nil && egress.Secret != nil {
+		volumes = append(volumes, v1.Volume{
+			Name: "egress-secret",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: egress.Secret.Name,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      "egress-secret",
+			MountPath: ppsutil.EgressSecretPath(egress),
+		})
+	}
+
 	volumes = append(volumes, v1.Volume{
 		Name: "pach-bin",
 		VolumeSource: v1.VolumeSource{
@@ -607,6 +660,10 @@ func (a *apiServer) getWorkerOptions(pipelineInfo *pps.PipelineInfo) (*workerOpt
 	} else {
 		service = pipelineInfo.Details.Service
 	}
+	nodeSelector, err := getNodeSelector(a.nodePools, pipelineInfo.Details)
+	if err != nil {
+		return nil, err
+	}
 	var s3GatewayPort int32
 	if ppsutil.ContainsS3Inputs(pipelineInfo.Details.Input) || pipelineInfo.Details.S3Out {
 		s3GatewayPort = int32(a.env.Config().S3GatewayPort)
@@ -630,11 +687,52 @@ func (a *apiServer) getWorkerOptions(pipelineInfo *pps.PipelineInfo) (*workerOpt
 		imagePullSecrets:      imagePullSecrets,
 		service:               service,
 		schedulingSpec:        pipelineInfo.Details.SchedulingSpec,
+		nodeSelector:          nodeSelector,
 		podSpec:               pipelineInfo.Details.PodSpec,
 		podPatch:              pipelineInfo.Details.PodPatch,
 	}, nil
 }
 
+// parseNodePools parses the cluster's node pool configuration, a JSON object
+// mapping each node pool name to its node selector labels.
+func parseNodePools(config string) (map[string]map[string]string, error) {
+	nodePools := make(map[string]map[string]string)
+	if config == "" {
+		return nodePools, nil
+	}
+	if err := json.Unmarshal([]byte(config), &nodePools); err != nil {
+		return nil, errors.Wrapf(err, "could not parse node pool configuration")
+	}
+	return nodePools, nil
+}
+
+// getNodeSelector returns the node selector of a pipeline's workers, which is
+// made up of the labels of the pipeline's node pool and the node selector in
+// its scheduling spec. The scheduling spec takes precedence if both set the
+// same label.
+func getNodeSelector(nodePools map[string]map[string]string, details *pps.PipelineInfo_Details) (map[string]string, error) {
+	var nodeSelector map[string]string
+	if details.NodePool != "" {
+		labels, ok := nodePools[details.NodePool]
+		if !ok {
+			return nil, errors.Errorf("node pool %q is not configured in this cluster", details.NodePool)
+		}
+		nodeSelector = make(map[string]string)
+		for k, v := range labels {
+			nodeSelector[k] = v
+		}
+	}
+	if details.SchedulingSpec != nil && len(details.SchedulingSpec.NodeSelector) > 0 {
+		if nodeSelector == nil {
+			return details.SchedulingSpec.NodeSelector, nil
+		}
+		for k, v := range details.SchedulingSpec.NodeSelector {
+			nodeSelector[k] = v
+		}
+	}
+	return nodeSelector, nil
+}
+
 func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
 	var cfg config.Config
 	err := cfg.InitV2()
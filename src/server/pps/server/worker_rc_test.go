@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/enterprise"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestGetNodeSelector(t *testing.T) {
+	nodePools, err := parseNodePools(`{"gpu": {"pool": "gpu", "accelerator": "nvidia"}}`)
+	require.NoError(t, err)
+	_, err = parseNodePools(`["gpu"]`)
+	require.YesError(t, err)
+
+	// A pipeline in a node pool gets the pool's node selector
+	nodeSelector, err := getNodeSelector(nodePools, &pps.PipelineInfo_Details{
+		NodePool: "gpu",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pool": "gpu", "accelerator": "nvidia"}, nodeSelector)
+
+	// The scheduling spec's node selector takes precedence over the pool's
+	nodeSelector, err = getNodeSelector(nodePools, &pps.PipelineInfo_Details{
+		NodePool: "gpu",
+		SchedulingSpec: &pps.SchedulingSpec{
+			NodeSelector: map[string]string{"accelerator": "amd", "zone": "a"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pool": "gpu", "accelerator": "amd", "zone": "a"}, nodeSelector)
+	require.Equal(t, "nvidia", nodePools["gpu"]["accelerator"])
+
+	// Pipelines outside of a node pool only use the scheduling spec
+	nodeSelector, err = getNodeSelector(nodePools, &pps.PipelineInfo_Details{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(nodeSelector))
+
+	// Unknown node pools are an error
+	_, err = getNodeSelector(nodePools, &pps.PipelineInfo_Details{
+		NodePool: "tpu",
+	})
+	require.YesError(t, err)
+}
+
+func TestWorkerPodSpecNodeSelector(t *testing.T) {
+	nodePools, err := parseNodePools(`{"gpu": {"pool": "gpu"}}`)
+	require.NoError(t, err)
+	mockEnv := testpachd.NewMockEnv(t)
+	mockEnv.MockPachd.Enterprise.GetState.Use(func(context.Context, *enterprise.GetStateRequest) (*enterprise.GetStateResponse, error) {
+		return &enterprise.GetStateResponse{State: enterprise.State_NONE}, nil
+	})
+	ready := make(chan interface{})
+	close(ready)
+	a := &apiServer{
+		env: &serviceenv.TestServiceEnv{
+			Configuration: serviceenv.NewConfiguration(&serviceenv.PachdFullConfiguration{}),
+			PachClient:    mockEnv.PachClient,
+			Ready:         ready,
+		},
+		nodePools: nodePools,
+	}
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline("pipeline"),
+		Version:    1,
+		SpecCommit: client.NewSystemRepo("pipeline", pfs.SpecRepoType).NewCommit("master", "spec"),
+		Details: &pps.PipelineInfo_Details{
+			Transform: &pps.Transform{Cmd: []string{"true"}},
+			NodePool:  "gpu",
+			SchedulingSpec: &pps.SchedulingSpec{
+				NodeSelector:      map[string]string{"zone": "a"},
+				PriorityClassName: "high",
+			},
+		},
+	}
+	options, err := a.getWorkerOptions(pipelineInfo)
+	require.NoError(t, err)
+	podSpec, err := a.workerPodSpec(options, pipelineInfo)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pool": "gpu", "zone": "a"}, podSpec.NodeSelector)
+	require.Equal(t, "high", podSpec.PriorityClassName)
+}
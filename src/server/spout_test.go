@@ -550,3 +550,59 @@ func testSpout(t *testing.T, usePachctl bool) {
 		require.NoError(t, c.DeleteAll())
 	})
 }
+
+func TestSpoutCommitMarker(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	pipeline := tu.UniqueString("TestSpoutCommitMarker")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Cmd: []string{"/bin/sh"},
+			// Write a batch of two files, then wait for the worker to commit
+			// them and remove the marker before writing the next batch
+			Stdin: []string{
+				"i=0",
+				"while [ : ]",
+				"do",
+				"i=$((i+1))",
+				"echo $i > /pfs/out/a",
+				"echo $i > /pfs/out/b",
+				"touch /pfs/out/DONE",
+				"while [ -e /pfs/out/DONE ]; do sleep 0.1; done",
+				"done"},
+		},
+		Spout: &pps.Spout{CommitMarker: "out/DONE"},
+	}
+	_, err := c.PpsAPIClient.CreatePipeline(c.Ctx(), request)
+	require.YesError(t, err)
+	require.Matches(t, "must be a file name", err.Error())
+	request.Spout.CommitMarker = "DONE"
+	_, err = c.PpsAPIClient.CreatePipeline(c.Ctx(), request)
+	require.NoError(t, err)
+
+	// Each batch lands in its own commit, without the marker
+	countBreakFunc := newCountBreakFunc(4)
+	count := 0
+	require.NoError(t, c.SubscribeCommit(client.NewRepo(pipeline), "master", "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+		return countBreakFunc(func() error {
+			count++
+			if count == 1 {
+				return nil // Empty head commit
+			}
+			files, err := c.ListFileAll(ci.Commit, "")
+			require.NoError(t, err)
+			require.Equal(t, 2, len(files))
+			var a, b bytes.Buffer
+			require.NoError(t, c.GetFile(ci.Commit, "a", &a))
+			require.NoError(t, c.GetFile(ci.Commit, "b", &b))
+			require.Equal(t, a.String(), b.String())
+			return nil
+		})
+	}))
+}
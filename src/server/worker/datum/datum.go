@@ -5,10 +5,12 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	io "io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -47,10 +49,16 @@ const (
 type SetSpec struct {
 	Number    int64
 	SizeBytes int64
+	// ShardByKey orders the datums by key and keeps datums with the same key in
+	// the same set.
+	ShardByKey bool
 }
 
 // CreateSets creates datum sets from the passed in datum iterator.
 func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(func(client.ModifyFile) error) error) error {
+	if setSpec.ShardByKey {
+		return createShardedSets(dit, storageRoot, setSpec, upload)
+	}
 	var metas []*Meta
 	shouldCreateSet := shouldCreateSetFunc(setSpec)
 	if err := dit.Iterate(func(meta *Meta) error {
@@ -71,6 +79,58 @@ func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(
 	return nil
 }
 
+// TODO: Improve the scalability (in-memory operation for now).
+func createShardedSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(func(client.ModifyFile) error) error) error {
+	var metas []*Meta
+	if err := dit.Iterate(func(meta *Meta) error {
+		metas = append(metas, meta)
+		return nil
+	}); err != nil {
+		return err
+	}
+	// The datums are iterated in datum ID order, so the stable sort results
+	// in the same order every time.
+	sort.SliceStable(metas, func(i, j int) bool {
+		return shardKey(metas[i]) < shardKey(metas[j])
+	})
+	shouldCreateSet := shouldCreateSetFunc(setSpec)
+	var set []*Meta
+	var full bool
+	for _, meta := range metas {
+		// A full set is only created once the key changes.
+		if full && shardKey(meta) != shardKey(set[len(set)-1]) {
+			if err := createSet(set, storageRoot, upload); err != nil {
+				return err
+			}
+			set = nil
+			full = false
+		}
+		set = append(set, meta)
+		if shouldCreateSet(meta) {
+			full = true
+		}
+	}
+	if len(set) > 0 {
+		return createSet(set, storageRoot, upload)
+	}
+	return nil
+}
+
+// shardKey returns the join or group key of a datum.
+func shardKey(meta *Meta) string {
+	for _, input := range meta.Inputs {
+		if input.JoinOn != "" {
+			return input.JoinOn
+		}
+	}
+	for _, input := range meta.Inputs {
+		if input.GroupBy != "" {
+			return input.GroupBy
+		}
+	}
+	return ""
+}
+
 func shouldCreateSetFunc(setSpec *SetSpec) func(*Meta) bool {
 	switch {
 	case setSpec.Number > 0:
@@ -121,6 +181,10 @@ type Set struct {
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
 	stats                             *Stats
+	dedupAgainst                      *pfs.Commit
+	passthrough                       bool
+	previousOutputName                string
+	previousOutput                    *pfs.Commit
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -154,9 +218,11 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
+	d.meta.WorkerID = os.Getenv(client.PPSPodNameEnv)
 
 	var err error
 	for i := 0; i <= d.numRetries; i++ {
+		d.meta.Retries = int64(i)
 		err = d.withData(func() (retErr error) {
 			defer func() {
 				if retErr == nil || i == d.numRetries {
@@ -274,8 +340,8 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 	d.meta.Stats.DownloadBytes = 0
 	var mu sync.Mutex
 	for _, input := range d.meta.Inputs {
-		// TODO: Need some validation to catch lazy & empty since they are incompatible.
-		// Probably should catch this at the input validation during pipeline creation?
+		// Lazy and EmptyFiles are set independently on each input (and can't
+		// both be set on one input, see validateInput)
 		opts := []pfssync.DownloadOption{
 			pfssync.WithHeaderCallback(func(hdr *tar.Header) error {
 				mu.Lock()
@@ -294,7 +360,21 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 			return err
 		}
 	}
-	return nil
+	return d.downloadPreviousOutput(downloader)
+}
+
+func (d *Datum) downloadPreviousOutput(downloader pfssync.Downloader) error {
+	if d.set.previousOutputName == "" {
+		return nil
+	}
+	storageRoot := path.Join(d.PFSStorageRoot(), d.set.previousOutputName)
+	if err := os.MkdirAll(storageRoot, 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if d.set.previousOutput == nil {
+		return nil
+	}
+	return downloader.Download(storageRoot, d.set.previousOutput.NewFile("/"))
 }
 
 // Run provides a scoped environment for the processing of a datum.
@@ -359,12 +439,48 @@ func (d *Datum) uploadOutput() error {
 	if d.set.pfsOutputClient != nil {
 		start := time.Now()
 		d.meta.Stats.UploadBytes = 0
-		if err := d.upload(d.set.pfsOutputClient, path.Join(d.PFSStorageRoot(), OutputPrefix), func(hdr *tar.Header) error {
-			d.meta.Stats.UploadBytes += hdr.Size
-			return nil
-		}); err != nil {
+		opts := []tarutil.ExportOption{
+			tarutil.WithHeaderCallback(func(hdr *tar.Header) error {
+				d.meta.Stats.UploadBytes += hdr.Size
+				return nil
+			}),
+		}
+		outputDir := path.Join(d.PFSStorageRoot(), OutputPrefix)
+		var written map[string]bool
+		if d.set.passthrough {
+			var err error
+			written, err = writtenFiles(outputDir)
+			if err != nil {
+				return err
+			}
+		}
+		var dedupPaths []string
+		if d.set.dedupAgainst != nil {
+			opts = append(opts, tarutil.WithSkipCallback(func(hdr *tar.Header, file string) (bool, error) {
+				same, err := d.sameAsDedupFile(hdr, file)
+				if err != nil {
+					return false, err
+				}
+				if same {
+					dedupPaths = append(dedupPaths, hdr.Name)
+				}
+				return same, nil
+			}))
+		}
+		if err := d.upload(d.set.pfsOutputClient, outputDir, opts...); err != nil {
 			return err
 		}
+		// Files identical to the dedup commit are copied from it rather than uploaded.
+		for _, p := range dedupPaths {
+			if err := d.set.pfsOutputClient.CopyFile(p, d.set.dedupAgainst.NewFile(p), client.WithDatumCopyFile(d.ID)); err != nil {
+				return err
+			}
+		}
+		if d.set.passthrough {
+			if err := d.copyInputs(written); err != nil {
+				return err
+			}
+		}
 		// TODO: stats should probably include meta upload as well
 		duration := time.Since(start)
 		d.meta.Stats.UploadTime = types.DurationProto(duration)
@@ -377,7 +493,7 @@ func (d *Datum) uploadOutput() error {
 	return d.uploadMetaOutput()
 }
 
-func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar.Header) error) (retErr error) {
+func (d *Datum) upload(mf client.ModifyFile, storageRoot string, opts ...tarutil.ExportOption) (retErr error) {
 	if err := miscutil.WithPipe(func(w io.Writer) (retErr error) {
 		bufW := bufio.NewWriterSize(w, grpcutil.MaxMsgPayloadSize)
 		defer func() {
@@ -385,10 +501,6 @@ func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar
 				retErr = err
 			}
 		}()
-		var opts []tarutil.ExportOption
-		if len(cb) > 0 {
-			opts = append(opts, tarutil.WithHeaderCallback(cb[0]))
-		}
 		return tarutil.Export(storageRoot, bufW, opts...)
 	}, func(r io.Reader) error {
 		return mf.PutFileTAR(r, client.WithAppendPutFile(), client.WithDatumPutFile(d.ID))
@@ -398,6 +510,90 @@ func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar
 	return d.handleSymlinks(mf, storageRoot)
 }
 
+// sameAsDedupFile returns true if the local output file has the same content
+// as the file at the same path in the dedup commit.
+func (d *Datum) sameAsDedupFile(hdr *tar.Header, file string) (bool, error) {
+	fi, err := d.set.pachClient.InspectFile(d.set.dedupAgainst, hdr.Name)
+	if err != nil {
+		if pfsserver.IsFileNotFoundErr(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if fi.FileType != pfs.FileType_FILE || fi.SizeBytes != hdr.Size {
+		return false, nil
+	}
+	localHash, err := hashLocalFile(file)
+	if err != nil {
+		return false, err
+	}
+	h := sha256.New()
+	if err := d.set.pachClient.GetFile(d.set.dedupAgainst, hdr.Name, h); err != nil {
+		return false, err
+	}
+	return bytes.Equal(localHash, h.Sum(nil)), nil
+}
+
+// writtenFiles returns the paths of the files written to the output
+// directory, relative to it.
+func writtenFiles(outputDir string) (map[string]bool, error) {
+	written := make(map[string]bool)
+	if err := filepath.Walk(outputDir, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(outputDir, file)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		written[path.Join("/", rel)] = true
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return written, nil
+}
+
+// copyInputs copies the input files of the datum to the output, skipping the
+// paths that the datum wrote itself.
+func (d *Datum) copyInputs(written map[string]bool) error {
+	for _, input := range d.meta.Inputs {
+		if input.S3 || input.FileInfo == nil {
+			continue
+		}
+		file := input.FileInfo.File
+		if err := d.set.pachClient.WalkFile(file.Commit, file.Path, func(fi *pfs.FileInfo) error {
+			if fi.FileType != pfs.FileType_FILE || written[path.Clean(fi.File.Path)] {
+				return nil
+			}
+			return d.set.pfsOutputClient.CopyFile(fi.File.Path, fi.File, client.WithDatumCopyFile(d.ID))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hashLocalFile(file string) (_ []byte, retErr error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return h.Sum(nil), nil
+}
+
 func (d *Datum) handleSymlinks(mf client.ModifyFile, storageRoot string) error {
 	return filepath.Walk(storageRoot, func(file string, fi os.FileInfo, err error) (retErr error) {
 		if err != nil {
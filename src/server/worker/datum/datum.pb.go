@@ -28,21 +28,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type State int32
 
 const (
-	State_PROCESSED State = 0
-	State_FAILED    State = 1
-	State_RECOVERED State = 2
+	State_PROCESSED   State = 0
+	State_FAILED      State = 1
+	State_RECOVERED   State = 2
+	State_QUARANTINED State = 3
 )
 
 var State_name = map[int32]string{
 	0: "PROCESSED",
 	1: "FAILED",
 	2: "RECOVERED",
+	3: "QUARANTINED",
 }
 
 var State_value = map[string]int32{
-	"PROCESSED": 0,
-	"FAILED":    1,
-	"RECOVERED": 2,
+	"PROCESSED":   0,
+	"FAILED":      1,
+	"RECOVERED":   2,
+	"QUARANTINED": 3,
 }
 
 func (x State) String() string {
@@ -61,6 +64,8 @@ type Meta struct {
 	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	Stats                *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
 	Index                int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	WorkerID             string            `protobuf:"bytes,8,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Retries              int64             `protobuf:"varint,9,opt,name=retries,proto3" json:"retries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -148,6 +153,20 @@ func (m *Meta) GetIndex() int64 {
 	return 0
 }
 
+func (m *Meta) GetWorkerID() string {
+	if m != nil {
+		return m.WorkerID
+	}
+	return ""
+}
+
+func (m *Meta) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
 type Stats struct {
 	ProcessStats         *pps.ProcessStats `protobuf:"bytes,1,opt,name=process_stats,json=processStats,proto3" json:"process_stats,omitempty"`
 	Processed            int64             `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
@@ -244,35 +263,38 @@ func init() {
 func init() { proto.RegisterFile("server/worker/datum/datum.proto", fileDescriptor_96ec7427544ac634) }
 
 var fileDescriptor_96ec7427544ac634 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0x9e, 0xe2, 0xd8, 0xad, 0x95, 0x64, 0x04, 0x11, 0x86, 0x28, 0x5b, 0xea, 0x05, 0x06, 0x5e,
-	0x0f, 0x36, 0xf3, 0x4e, 0x3d, 0xae, 0x8d, 0x3b, 0x32, 0x36, 0x5a, 0x14, 0xd8, 0x61, 0x97, 0xe0,
-	0x58, 0x6f, 0x89, 0xd7, 0x25, 0x12, 0x92, 0x93, 0x6d, 0xff, 0x70, 0xc7, 0xdd, 0x07, 0x65, 0xf8,
-	0x97, 0x0c, 0x49, 0x2e, 0xed, 0x60, 0xf4, 0x62, 0xbf, 0xef, 0xfb, 0xde, 0xfb, 0x78, 0x1f, 0x7a,
-	0xf8, 0x58, 0x83, 0xda, 0x83, 0x4a, 0xbf, 0x09, 0x75, 0x0d, 0x2a, 0xe5, 0x45, 0xbd, 0xdb, 0xb8,
-	0x6f, 0x22, 0x95, 0xa8, 0x05, 0xf1, 0x2d, 0x38, 0x1a, 0xad, 0xc4, 0x4a, 0x58, 0x26, 0x35, 0x95,
-	0x13, 0x8f, 0x06, 0x52, 0xea, 0x54, 0x4a, 0xdd, 0xc2, 0xe7, 0xff, 0x9a, 0x95, 0x62, 0xb3, 0x11,
-	0xdb, 0xf6, 0xe7, 0x5a, 0x26, 0x37, 0x08, 0x77, 0x3f, 0x40, 0x5d, 0x90, 0x67, 0xd8, 0xfb, 0x22,
-	0x96, 0x14, 0x45, 0x28, 0xee, 0x65, 0xbd, 0x44, 0x4a, 0xbd, 0xd8, 0x67, 0xc9, 0x3b, 0xb1, 0x64,
-	0x86, 0x27, 0x2f, 0x70, 0x50, 0x6d, 0xe5, 0xae, 0xd6, 0xb4, 0x13, 0x79, 0x71, 0x2f, 0x1b, 0x24,
-	0xad, 0xcd, 0xcc, 0xb0, 0xac, 0x15, 0x09, 0xc1, 0xdd, 0x75, 0xa1, 0xd7, 0xd4, 0x8b, 0x50, 0x1c,
-	0x32, 0x5b, 0x93, 0x09, 0xf6, 0x75, 0x5d, 0xd4, 0x40, 0xbb, 0x11, 0x8a, 0x1f, 0x67, 0xfd, 0xc4,
-	0xc5, 0x99, 0x1b, 0x8e, 0x39, 0x89, 0x3c, 0xc1, 0x81, 0x82, 0x42, 0x8b, 0x2d, 0xf5, 0xed, 0x64,
-	0x8b, 0xc8, 0x89, 0x9b, 0xd5, 0x34, 0xb0, 0x7b, 0x8d, 0x6e, 0xf7, 0xba, 0x52, 0xa2, 0x04, 0xad,
-	0x8d, 0x87, 0x76, 0x1e, 0x9a, 0x8c, 0xb0, 0x5f, 0x6d, 0x39, 0x7c, 0xa7, 0x07, 0x11, 0x8a, 0x3d,
-	0xe6, 0xc0, 0xe4, 0x37, 0xc2, 0xbe, 0x6d, 0x23, 0xa7, 0x78, 0x20, 0xdd, 0xd8, 0xc2, 0x79, 0xa2,
-	0x07, 0x3c, 0xfb, 0xf2, 0x1e, 0x22, 0x4f, 0x71, 0xd8, 0x62, 0xe0, 0xb4, 0x63, 0xed, 0xef, 0x08,
-	0x42, 0xf1, 0x81, 0xbe, 0xae, 0xa4, 0x04, 0x6e, 0x73, 0x7b, 0xec, 0x16, 0x9a, 0x58, 0x9f, 0x8b,
-	0xea, 0x2b, 0x70, 0x9b, 0xdd, 0x63, 0x2d, 0x32, 0x7e, 0x0a, 0x4a, 0xb1, 0x07, 0x05, 0xdc, 0x26,
-	0xf6, 0xd8, 0x1d, 0x41, 0x5e, 0xe2, 0xd0, 0xf5, 0x2d, 0x2a, 0x6e, 0x83, 0x87, 0x67, 0xfd, 0xe6,
-	0xe6, 0xf8, 0xf0, 0xc2, 0x92, 0xb3, 0x29, 0x3b, 0x74, 0xf2, 0x8c, 0x9f, 0xbc, 0x72, 0xe1, 0x80,
-	0x0c, 0x70, 0x78, 0xc5, 0x2e, 0xcf, 0xf3, 0xf9, 0x3c, 0x9f, 0x0e, 0x1f, 0x11, 0x8c, 0x83, 0x8b,
-	0x37, 0xb3, 0xf7, 0xf9, 0x74, 0x88, 0x8c, 0xc4, 0xf2, 0xf3, 0xcb, 0x8f, 0x39, 0xcb, 0xa7, 0xc3,
-	0xce, 0xd9, 0xdb, 0x9f, 0xcd, 0x18, 0xfd, 0x6a, 0xc6, 0xe8, 0x4f, 0x33, 0x46, 0x9f, 0x4e, 0x57,
-	0x55, 0xbd, 0xde, 0x2d, 0xcd, 0x6b, 0xa6, 0xb2, 0x28, 0xd7, 0x3f, 0x38, 0xa8, 0xfb, 0xd5, 0x3e,
-	0x4b, 0xb5, 0x2a, 0xd3, 0xff, 0x5c, 0xe5, 0x32, 0xb0, 0x17, 0xf4, 0xfa, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x91, 0xcf, 0x21, 0xf4, 0xb3, 0x02, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0xc5, 0x4d, 0x9b, 0x35, 0x6e, 0x0b, 0x95, 0x55, 0x21, 0x6b, 0x82, 0x2e, 0x54, 0x42, 0x0a,
+	0x7b, 0x48, 0xa4, 0xf0, 0xb4, 0xc7, 0x76, 0xc9, 0x50, 0x10, 0x6c, 0xc3, 0xe5, 0x43, 0xe2, 0xa5,
+	0x4a, 0x63, 0xd3, 0x86, 0xd1, 0xda, 0xb2, 0xd3, 0x02, 0xbf, 0x87, 0x3f, 0xc3, 0x23, 0xef, 0x48,
+	0x08, 0xf5, 0x97, 0x20, 0xdb, 0xa9, 0x3a, 0x24, 0xc4, 0x4b, 0xe2, 0x73, 0x8e, 0x7d, 0xee, 0x3d,
+	0x57, 0x17, 0x9e, 0x28, 0x26, 0xb7, 0x4c, 0x46, 0x9f, 0xb9, 0xbc, 0x61, 0x32, 0xa2, 0x79, 0xb5,
+	0x59, 0xd9, 0x6f, 0x28, 0x24, 0xaf, 0x38, 0x6a, 0x19, 0x70, 0x3c, 0x58, 0xf0, 0x05, 0x37, 0x4c,
+	0xa4, 0x4f, 0x56, 0x3c, 0xee, 0x09, 0xa1, 0x22, 0x21, 0x54, 0x0d, 0x1f, 0xfd, 0x6d, 0x56, 0xf0,
+	0xd5, 0x8a, 0xaf, 0xeb, 0x9f, 0xbd, 0x32, 0xfa, 0xd6, 0x80, 0xcd, 0x97, 0xac, 0xca, 0xd1, 0x43,
+	0xe8, 0x7c, 0xe4, 0x73, 0x0c, 0x7c, 0x10, 0x74, 0xe2, 0x4e, 0x28, 0x84, 0x9a, 0x6d, 0xe3, 0xf0,
+	0x39, 0x9f, 0x13, 0xcd, 0xa3, 0xc7, 0xd0, 0x2d, 0xd7, 0x62, 0x53, 0x29, 0xdc, 0xf0, 0x9d, 0xa0,
+	0x13, 0xf7, 0xc2, 0xda, 0x26, 0xd3, 0x2c, 0xa9, 0x45, 0x84, 0x60, 0x73, 0x99, 0xab, 0x25, 0x76,
+	0x7c, 0x10, 0x78, 0xc4, 0x9c, 0xd1, 0x08, 0xb6, 0x54, 0x95, 0x57, 0x0c, 0x37, 0x7d, 0x10, 0xdc,
+	0x8d, 0xbb, 0xa1, 0x8d, 0x33, 0xd5, 0x1c, 0xb1, 0x12, 0xba, 0x0f, 0x5d, 0xc9, 0x72, 0xc5, 0xd7,
+	0xb8, 0x65, 0x5e, 0xd6, 0x08, 0x9d, 0xda, 0xb7, 0x0a, 0xbb, 0xa6, 0xaf, 0xc1, 0xbe, 0xaf, 0x6b,
+	0xc9, 0x0b, 0xa6, 0x94, 0xf6, 0x50, 0xd6, 0x43, 0xa1, 0x01, 0x6c, 0x95, 0x6b, 0xca, 0xbe, 0xe0,
+	0x23, 0x1f, 0x04, 0x0e, 0xb1, 0x00, 0x3d, 0x81, 0x9e, 0x8d, 0x3f, 0x2b, 0x29, 0x6e, 0x6b, 0xf3,
+	0x49, 0x77, 0xf7, 0xeb, 0xa4, 0xfd, 0xce, 0x90, 0x59, 0x42, 0xda, 0x56, 0xce, 0x28, 0xc2, 0xf0,
+	0x48, 0xb2, 0x4a, 0x96, 0x4c, 0x61, 0xcf, 0x58, 0xec, 0xe1, 0xe8, 0x27, 0x80, 0x2d, 0x53, 0x0b,
+	0x9d, 0xc1, 0x9e, 0xb0, 0xb5, 0x67, 0xb6, 0x31, 0xf0, 0x9f, 0xc6, 0xba, 0xe2, 0x16, 0x42, 0x0f,
+	0xa0, 0x57, 0x63, 0x46, 0x71, 0xc3, 0x14, 0x38, 0x10, 0xba, 0xb8, 0xba, 0x29, 0x85, 0x60, 0xd4,
+	0x0c, 0xcf, 0x21, 0x7b, 0xa8, 0x67, 0xf3, 0x21, 0x2f, 0x3f, 0x31, 0x6a, 0x06, 0xe8, 0x90, 0x1a,
+	0x69, 0x3f, 0xc9, 0x0a, 0xbe, 0x65, 0x92, 0x51, 0x33, 0x36, 0x87, 0x1c, 0x08, 0x9d, 0xdb, 0xde,
+	0xd3, 0xb9, 0xdd, 0x43, 0xee, 0x0b, 0x43, 0xea, 0xdc, 0x56, 0xce, 0xe8, 0xe9, 0xc4, 0x86, 0x63,
+	0xa8, 0x07, 0xbd, 0x6b, 0x72, 0x75, 0x9e, 0x4e, 0xa7, 0x69, 0xd2, 0xbf, 0x83, 0x20, 0x74, 0x2f,
+	0xc6, 0xd9, 0x8b, 0x34, 0xe9, 0x03, 0x2d, 0x91, 0xf4, 0xfc, 0xea, 0x6d, 0x4a, 0xd2, 0xa4, 0xdf,
+	0x40, 0xf7, 0x60, 0xe7, 0xd5, 0x9b, 0x31, 0x19, 0x5f, 0xbe, 0xce, 0x2e, 0xd3, 0xa4, 0xef, 0x4c,
+	0x9e, 0x7d, 0xdf, 0x0d, 0xc1, 0x8f, 0xdd, 0x10, 0xfc, 0xde, 0x0d, 0xc1, 0xfb, 0xb3, 0x45, 0x59,
+	0x2d, 0x37, 0x73, 0xbd, 0x23, 0x91, 0xc8, 0x8b, 0xe5, 0x57, 0xca, 0xe4, 0xed, 0xd3, 0x36, 0x8e,
+	0x94, 0x2c, 0xa2, 0x7f, 0xec, 0xfa, 0xdc, 0x35, 0x7b, 0xf9, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x04, 0x70, 0xc1, 0x73, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (m *Meta) Marshal() (dAtA []byte, err error) {
@@ -299,6 +321,18 @@ func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Retries != 0 {
+		i = encodeVarintDatum(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.WorkerID) > 0 {
+		i -= len(m.WorkerID)
+		copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintDatum(dAtA, i, uint64(len(m.WorkerID)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Index != 0 {
 		i = encodeVarintDatum(dAtA, i, uint64(m.Index))
 		i--
@@ -475,6 +509,13 @@ func (m *Meta) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovDatum(uint64(m.Index))
 	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovDatum(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovDatum(uint64(m.Retries))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -756,6 +797,57 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDatum(dAtA[iNdEx:])
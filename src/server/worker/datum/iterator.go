@@ -50,37 +50,125 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	repo := pi.input.Repo
 	branch := pi.input.Branch
 	commit := pi.input.Commit
-	pattern := pi.input.Glob
-	return pi.pachClient.GlobFile(client.NewCommit(repo, branch, commit), pattern, func(fi *pfs.FileInfo) error {
-		g := glob.MustCompile(pi.input.Glob, '/')
-		// Remove the trailing slash to support glob replace on directory paths.
-		p := strings.TrimRight(fi.File.Path, "/")
-		joinOn := g.Replace(p, pi.input.JoinOn)
-		groupBy := g.Replace(p, pi.input.GroupBy)
-		return cb(&Meta{
-			Inputs: []*common.Input{
-				&common.Input{
-					FileInfo:   fi,
-					JoinOn:     joinOn,
-					OuterJoin:  pi.input.OuterJoin,
-					GroupBy:    groupBy,
-					Name:       pi.input.Name,
-					Lazy:       pi.input.Lazy,
-					Branch:     pi.input.Branch,
-					EmptyFiles: pi.input.EmptyFiles,
-					S3:         pi.input.S3,
+	var patterns []string
+	if pi.input.Glob != "" {
+		patterns = append(patterns, pi.input.Glob)
+	}
+	patterns = append(patterns, pi.input.Globs...)
+	// Files matched by more than one pattern are only passed to cb for the
+	// first of them. The directories matched by a leaf_dirs input may share
+	// leaf directories, even with a single pattern.
+	var seen map[string]bool
+	if len(patterns) > 1 || pi.input.LeafDirs {
+		seen = make(map[string]bool)
+	}
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return errors.Wrapf(err, "invalid glob %q", pattern)
+		}
+		globCb := func(fi *pfs.FileInfo) error {
+			// Remove the trailing slash to support glob replace on directory paths.
+			p := strings.TrimRight(fi.File.Path, "/")
+			if seen != nil {
+				if seen[p] {
+					return nil
+				}
+				seen[p] = true
+			}
+			joinOn := g.Replace(p, pi.input.JoinOn)
+			groupBy := g.Replace(p, pi.input.GroupBy)
+			return cb(&Meta{
+				Inputs: []*common.Input{
+					&common.Input{
+						FileInfo:   fi,
+						JoinOn:     joinOn,
+						OuterJoin:  pi.input.OuterJoin,
+						GroupBy:    groupBy,
+						Name:       pi.input.Name,
+						Lazy:       pi.input.Lazy,
+						Branch:     pi.input.Branch,
+						EmptyFiles: pi.input.EmptyFiles,
+						S3:         pi.input.S3,
+					},
 				},
-			},
-		})
-	})
+			})
+		}
+		if pi.input.LeafDirs {
+			globCb = leafDirs(pi.pachClient, client.NewCommit(repo, branch, commit), globCb)
+		}
+		if err := pi.pachClient.GlobFile(client.NewCommit(repo, branch, commit), pattern, globCb); err != nil {
+			if pfsserver.IsAncestorNotFoundErr(commit, err) {
+				// An input on an ancestor from before the start of the branch has
+				// no files.
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// leafDirs returns a GlobFile callback that passes the leaf directories
+// beneath each matched directory to cb: the directories that contain at least
+// one file and no subdirectories.
+func leafDirs(pachClient *client.APIClient, commit *pfs.Commit, cb func(*pfs.FileInfo) error) func(*pfs.FileInfo) error {
+	type dir struct {
+		fi                 *pfs.FileInfo
+		hasFile, hasSubdir bool
+	}
+	return func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_DIR {
+			return nil
+		}
+		// WalkFile visits a directory before its contents, so a directory is
+		// complete once the walk leaves it.
+		var stack []*dir
+		pop := func() error {
+			d := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if d.hasFile && !d.hasSubdir {
+				return cb(d.fi)
+			}
+			return nil
+		}
+		if err := pachClient.WalkFile(commit, fi.File.Path, func(fi *pfs.FileInfo) error {
+			for len(stack) > 0 && !strings.HasPrefix(fi.File.Path, stack[len(stack)-1].fi.File.Path) {
+				if err := pop(); err != nil {
+					return err
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				if fi.FileType == pfs.FileType_DIR {
+					parent.hasSubdir = true
+				} else {
+					parent.hasFile = true
+				}
+			}
+			if fi.FileType == pfs.FileType_DIR {
+				stack = append(stack, &dir{fi: fi})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for len(stack) > 0 {
+			if err := pop(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 }
 
 type unionIterator struct {
 	iterators []Iterator
+	dedup     bool
 }
 
-func newUnionIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterator, error) {
-	ui := &unionIterator{}
+func newUnionIterator(pachClient *client.APIClient, inputs []*pps.Input, dedup bool) (Iterator, error) {
+	ui := &unionIterator{dedup: dedup}
 	for _, input := range inputs {
 		di, err := NewIterator(pachClient, input)
 		if err != nil {
@@ -91,15 +179,39 @@ func newUnionIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterat
 	return ui, nil
 }
 
+// TODO: Improve the scalability of dedup (in-memory operation for now).
 func (ui *unionIterator) Iterate(cb func(*Meta) error) error {
+	var seen map[string]bool
+	if ui.dedup {
+		seen = make(map[string]bool)
+	}
 	for _, iterator := range ui.iterators {
-		if err := iterator.Iterate(cb); err != nil {
+		if err := iterator.Iterate(func(meta *Meta) error {
+			if seen != nil {
+				key := datumPathKey(meta.Inputs)
+				if seen[key] {
+					return nil
+				}
+				seen[key] = true
+			}
+			return cb(meta)
+		}); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// datumPathKey identifies a datum by the paths of its input files, regardless
+// of their repos.
+func datumPathKey(inputs []*common.Input) string {
+	var paths []string
+	for _, input := range inputs {
+		paths = append(paths, input.FileInfo.File.Path)
+	}
+	return strings.Join(paths, "\x00")
+}
+
 type crossIterator struct {
 	iterators []Iterator
 }
@@ -463,7 +575,7 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 	case input.Pfs != nil:
 		iterator = newPFSIterator(pachClient, input.Pfs)
 	case input.Union != nil:
-		iterator, err = newUnionIterator(pachClient, input.Union)
+		iterator, err = newUnionIterator(pachClient, input.Union, input.Dedup)
 		if err != nil {
 			return nil, err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 func TestIterators(t *testing.T) {
@@ -55,6 +56,27 @@ func TestIterators(t *testing.T) {
 		require.NoError(t, err)
 		validateDI(t, union1, "/foo11", "/foo12", "/foo2", "/foo21", "/foo22", "/foo31", "/foo32", "/foo41", "/foo42")
 	})
+	// PFS input with multiple globs, whose overlapping matches are collapsed.
+	inGlobs := client.NewPFSInput(dataRepo, "/foo?1")
+	inGlobs.Pfs.Commit = commit.ID
+	inGlobs.Pfs.Globs = []string{"/foo*2", "/foo1?"}
+	t.Run("MultipleGlobs", func(t *testing.T) {
+		globs, err := NewIterator(c, inGlobs)
+		require.NoError(t, err)
+		validateDI(t, globs,
+			"/foo10", "/foo11", "/foo12", "/foo13", "/foo14", "/foo15", "/foo16", "/foo17", "/foo18", "/foo19",
+			"/foo2", "/foo21", "/foo22", "/foo31", "/foo32", "/foo41", "/foo42",
+		)
+	})
+	// An invalid glob is an error rather than a panic.
+	t.Run("InvalidGlob", func(t *testing.T) {
+		inBad := client.NewPFSInput(dataRepo, "/foo?1")
+		inBad.Pfs.Commit = commit.ID
+		inBad.Pfs.Globs = []string{"/foo["}
+		bad, err := NewIterator(c, inBad)
+		require.NoError(t, err)
+		require.YesError(t, bad.Iterate(func(*Meta) error { return nil }))
+	})
 	// Cross input.
 	in4 := client.NewCrossInput(in1, in2)
 	t.Run("Cross", func(t *testing.T) {
@@ -108,6 +130,20 @@ func TestIterators(t *testing.T) {
 			"/foo43/foo34",
 			"/foo44/foo44")
 	})
+	// inJoinKey is joined with in[8-9], so only keys that all three inputs
+	// produce result in datums.
+	inJoinKey := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)1", "${1}1", "", false, false, nil)
+	inJoinKey.Pfs.Commit = commit.ID
+	inJoin3 := client.NewJoinInput(in8, in9, inJoinKey)
+	t.Run("ThreeWayJoin", func(t *testing.T) {
+		join2, err := NewIterator(c, inJoin3)
+		require.NoError(t, err)
+		validateDI(t, join2,
+			"/foo11/foo11/foo11",
+			"/foo21/foo12/foo21",
+			"/foo31/foo13/foo31",
+			"/foo41/foo14/foo41")
+	})
 
 	in11 := client.NewPFSInputOpts("", dataRepo, "", "/foo1(?)", "$1", "", true, false, nil)
 	in11.Pfs.Commit = commit.ID
@@ -279,6 +315,37 @@ func TestIterators(t *testing.T) {
 		require.NoError(t, err)
 		validateDI(t, di, "///")
 	})
+
+	// A cross and a union whose leaves each set lazy and empty_files
+	// differently keep those settings per input
+	inLazy := client.NewPFSInputOpts("lazy", dataRepo, "master", "/foo?1", "", "", false, true, nil)
+	inLazy.Pfs.Commit = commit.ID
+	inEmpty := client.NewPFSInput(dataRepo, "/foo*2")
+	inEmpty.Pfs.Name = "empty"
+	inEmpty.Pfs.Commit = commit.ID
+	inEmpty.Pfs.EmptyFiles = true
+	inPlain := client.NewPFSInputOpts("plain", dataRepo, "master", "/foo1?", "", "", false, false, nil)
+	inPlain.Pfs.Commit = commit.ID
+	for name, in := range map[string]*pps.Input{
+		"LazyEmptyMixedCross": client.NewCrossInput(inLazy, inEmpty, inPlain),
+		"LazyEmptyMixedUnion": client.NewUnionInput(inLazy, inEmpty, inPlain),
+	} {
+		in := in
+		t.Run(name, func(t *testing.T) {
+			di, err := NewIterator(c, in)
+			require.NoError(t, err)
+			var n int
+			require.NoError(t, di.Iterate(func(meta *Meta) error {
+				n++
+				for _, input := range meta.Inputs {
+					require.Equal(t, input.Name == "lazy", input.Lazy)
+					require.Equal(t, input.Name == "empty", input.EmptyFiles)
+				}
+				return nil
+			}))
+			require.True(t, n > 0)
+		})
+	}
 }
 
 // TestJoinOnTrailingSlash tests that the same glob pattern is used for
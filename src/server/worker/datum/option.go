@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 // SetOption configures a set.
@@ -33,6 +34,30 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithDedupAgainst sets the commit that output files are deduplicated against.
+func WithDedupAgainst(commit *pfs.Commit) SetOption {
+	return func(s *Set) {
+		s.dedupAgainst = commit
+	}
+}
+
+// WithPassthrough causes the input files of each datum to be copied to
+// the output, unless the datum writes an output file at the same path.
+func WithPassthrough() SetOption {
+	return func(s *Set) {
+		s.passthrough = true
+	}
+}
+
+// WithPreviousOutput sets the commit that is downloaded for each datum under
+// the given name. A nil commit results in an empty directory.
+func WithPreviousOutput(name string, commit *pfs.Commit) SetOption {
+	return func(s *Set) {
+		s.previousOutputName = name
+		s.previousOutput = commit
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -2,12 +2,15 @@ package driver
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/user"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -15,11 +18,13 @@ import (
 	"syscall"
 	"time"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
@@ -38,6 +43,10 @@ import (
 // In general, need to spend some time walking through the old driver
 // tests to see what can be reused.
 
+// jobSemaphorePrefix is the etcd prefix (under the PPS prefix) of the semaphore
+// that limits the number of jobs running across the cluster.
+const jobSemaphorePrefix = "job-slots"
+
 // WorkNamespace returns the namespace used by the work package for this
 // pipeline.
 func WorkNamespace(pipelineInfo *pps.PipelineInfo) string {
@@ -71,6 +80,17 @@ type Driver interface {
 	// Returns the number of workers to be used
 	ExpectedNumWorkers() (int64, error)
 
+	// Returns a handle to the semaphore limiting the number of jobs running
+	// across the cluster, or nil if there is no limit
+	NewJobSemaphore() dlock.Semaphore
+
+	// Returns the IDs of the pipeline's datums that have been released from
+	// quarantine since the releases were last cleared
+	QuarantineReleases() ([]string, error)
+
+	// Clears the given releases, once a job has processed them
+	ClearQuarantineReleases([]string) error
+
 	// WithContext clones the current driver and applies the context to its
 	// pachClient. The pachClient context will be used for other blocking
 	// operations as well.
@@ -89,11 +109,22 @@ type Driver interface {
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
+	// RunUserPreflightCode runs the transform's preflight command. If the
+	// command fails, the returned error includes its stderr.
+	RunUserPreflightCode(context.Context, logs.TaggedLogger, []string) error
+
 	// TODO: provide a more generic interface for modifying jobs, and
 	// some quality-of-life functions for common operations.
 	DeleteJob(*sqlx.Tx, *pps.JobInfo) error
 	UpdateJobState(*pps.Job, pps.JobState, string) error
 
+	// Moves the pipeline from RUNNING to CRASHING with the given reason
+	CrashPipeline(string) error
+
+	// Moves the pipeline from CRASHING back to RUNNING, if it's CRASHING
+	// because of a failed egress
+	ClearEgressFailure() error
+
 	// TODO: figure out how to not expose this - currently only used for a few
 	// operations in the map spawner
 	NewSQLTx(func(*sqlx.Tx) error) error
@@ -285,6 +316,36 @@ func (d *driver) ExpectedNumWorkers() (int64, error) {
 	return int64(numWorkers), nil
 }
 
+func (d *driver) NewJobSemaphore() dlock.Semaphore {
+	if d.env.Config().MaxConcurrentJobs <= 0 {
+		return nil
+	}
+	return dlock.NewSemaphore(d.env.GetEtcdClient(), path.Join(d.env.Config().PPSEtcdPrefix, jobSemaphorePrefix), d.env.Config().MaxConcurrentJobs)
+}
+
+func (d *driver) QuarantineReleases() ([]string, error) {
+	prefix := ppsutil.QuarantineReleaseKey(d.env.Config().PPSEtcdPrefix, d.pipelineInfo.Pipeline.Name, "") + "/"
+	resp, err := d.env.GetEtcdClient().Get(d.ctx, prefix, etcd.WithPrefix(), etcd.WithKeysOnly())
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var datumIDs []string
+	for _, kv := range resp.Kvs {
+		datumIDs = append(datumIDs, strings.TrimPrefix(string(kv.Key), prefix))
+	}
+	return datumIDs, nil
+}
+
+func (d *driver) ClearQuarantineReleases(datumIDs []string) error {
+	for _, datumID := range datumIDs {
+		key := ppsutil.QuarantineReleaseKey(d.env.Config().PPSEtcdPrefix, d.pipelineInfo.Pipeline.Name, datumID)
+		if _, err := d.env.GetEtcdClient().Delete(d.ctx, key); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
 func (d *driver) PipelineInfo() *pps.PipelineInfo {
 	return d.pipelineInfo
 }
@@ -323,10 +384,32 @@ func (d *driver) RunUserCode(
 	}
 
 	// Run user code
-	cmd := exec.CommandContext(ctx, d.pipelineInfo.Details.Transform.Cmd[0], d.pipelineInfo.Details.Transform.Cmd[1:]...)
+	args := d.pipelineInfo.Details.Transform.Cmd[1:]
+	if script := d.pipelineInfo.Details.Transform.ScriptFromInput; script != "" {
+		args = append(args[:len(args):len(args)], filepath.Join(d.InputDir(), filepath.Clean("/"+script)))
+	}
+	name := d.pipelineInfo.Details.Transform.Cmd[0]
+	if limit, ok := lookupEnv(environ, client.DatumMemoryLimitEnv); ok {
+		bytes, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if name, args, err = memoryLimitedCommand(bytes, name, args); err != nil {
+			return errors.Wrapf(err, "could not limit user code memory to %d bytes", bytes)
+		}
+	}
+	cmd := exec.CommandContext(ctx, name, args...)
 	if d.pipelineInfo.Details.Transform.Stdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Details.Transform.Stdin, "\n") + "\n")
 	}
+	if d.pipelineInfo.Details.Transform.StdinFromInput != "" {
+		f, err := os.Open(d.stdinFromInputPath(environ))
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer f.Close()
+		cmd.Stdin = f
+	}
 	cmd.Stdout = logger.WithUserCode()
 	cmd.Stderr = logger.WithUserCode()
 	cmd.Env = environ
@@ -382,6 +465,35 @@ func (d *driver) RunUserCode(
 	return nil
 }
 
+// stdinFromInputPath resolves the transform's StdinFromInput template against
+// the user code environment. Relative paths are resolved against the input
+// directory.
+func (d *driver) stdinFromInputPath(environ []string) string {
+	vars := make(map[string]string)
+	for _, kv := range environ {
+		if i := strings.Index(kv, "="); i >= 0 {
+			vars[kv[:i]] = kv[i+1:]
+		}
+	}
+	p := os.Expand(d.pipelineInfo.Details.Transform.StdinFromInput, func(name string) string {
+		return vars[name]
+	})
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(d.InputDir(), p)
+	}
+	return p
+}
+
+// lookupEnv returns the value of 'key' in 'environ', if it's set.
+func lookupEnv(environ []string, key string) (string, bool) {
+	for _, kv := range environ {
+		if strings.HasPrefix(kv, key+"=") {
+			return kv[len(key)+1:], true
+		}
+	}
+	return "", false
+}
+
 func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
@@ -449,6 +561,55 @@ func (d *driver) RunUserErrorHandlingCode(
 	return nil
 }
 
+func (d *driver) RunUserPreflightCode(
+	ctx context.Context,
+	logger logs.TaggedLogger,
+	environ []string,
+) (retErr error) {
+	logger.Logf("beginning to run user preflight code")
+	defer func(start time.Time) {
+		if retErr != nil {
+			logger.Logf("errored running user preflight code after %v: %v", time.Since(start), retErr)
+		} else {
+			logger.Logf("finished running user preflight code after %v", time.Since(start))
+		}
+	}(time.Now())
+
+	transform := d.pipelineInfo.Details.Transform
+	cmd := exec.CommandContext(ctx, transform.PreflightCmd[0], transform.PreflightCmd[1:]...)
+	if transform.PreflightStdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(transform.PreflightStdin, "\n") + "\n")
+	}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = logger.WithUserCode()
+	cmd.Stderr = io.MultiWriter(logger.WithUserCode(), stderr)
+	cmd.Env = environ
+	if d.uid != nil && d.gid != nil {
+		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
+	}
+	if transform.WorkingDir != "" || d.rootDir != "/" {
+		cmd.Dir = filepath.Join(d.rootDir, transform.WorkingDir)
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	state, err := cmd.Process.Wait()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if common.IsDone(ctx) {
+		if err = ctx.Err(); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	// See RunUserCode for why the IO is closed with this helper
+	err = cmd.WaitIO(state, err)
+	if err != nil && !strings.Contains(err.Error(), "broken pipe") {
+		return errors.Errorf("preflight command failed (%v): %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
 func (d *driver) UpdateJobState(job *pps.Job, state pps.JobState, reason string) error {
 	return d.NewSQLTx(func(sqlTx *sqlx.Tx) error {
 		jobInfo := &pps.JobInfo{}
@@ -459,6 +620,23 @@ func (d *driver) UpdateJobState(job *pps.Job, state pps.JobState, reason string)
 	})
 }
 
+func (d *driver) CrashPipeline(reason string) error {
+	return ppsutil.SetPipelineState(d.ctx, d.env.GetDBClient(), d.Pipelines(), d.pipelineInfo.SpecCommit,
+		[]pps.PipelineState{pps.PipelineState_PIPELINE_RUNNING}, pps.PipelineState_PIPELINE_CRASHING, reason)
+}
+
+func (d *driver) ClearEgressFailure() error {
+	pipelineInfo := &pps.PipelineInfo{}
+	if err := d.Pipelines().ReadOnly(d.ctx).Get(d.pipelineInfo.SpecCommit, pipelineInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if !ppsutil.IsEgressFailure(pipelineInfo) {
+		return nil
+	}
+	return ppsutil.SetPipelineState(d.ctx, d.env.GetDBClient(), d.Pipelines(), d.pipelineInfo.SpecCommit,
+		[]pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING}, pps.PipelineState_PIPELINE_RUNNING, "")
+}
+
 // DeleteJob is identical to updateJobState, except that jobInfo points to a job
 // that should be deleted rather than marked failed.  Jobs may be deleted if
 // their output commit is deleted.
@@ -494,6 +672,16 @@ func (d *driver) UserCodeEnv(
 		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.ID))
 	}
 	result = append(result, fmt.Sprintf("%s=%s", client.DatumIDEnv, common.DatumID(inputs)))
+	if scaling := d.PipelineInfo().Details.DatumMemoryScaling; scaling != nil {
+		var inputBytes int64
+		for _, input := range inputs {
+			inputBytes += input.FileInfo.SizeBytes
+		}
+		// The scaling is validated when the pipeline is created.
+		if limit, err := ppsutil.DatumMemoryLimit(scaling, inputBytes); err == nil {
+			result = append(result, fmt.Sprintf("%s=%d", client.DatumMemoryLimitEnv, limit))
+		}
+	}
 
 	if jobID != "" {
 		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
@@ -132,6 +132,12 @@ func (d *driver) linkData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	if name := d.PipelineInfo().Details.PreviousOutput; name != "" {
+		if err := os.Symlink(filepath.Join(dir, name), filepath.Join(d.InputDir(), name)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+
 	if !d.PipelineInfo().Details.S3Out {
 		if err := os.Symlink(filepath.Join(dir, "out"), filepath.Join(d.InputDir(), "out")); err != nil {
 			return errors.EnsureStack(err)
@@ -0,0 +1,66 @@
+package driver
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"golang.org/x/sync/errgroup"
+)
+
+func newJobSemaphoreTestDriver(etcdEnv *testetcd.Env, pipeline string, maxConcurrentJobs int) *driver {
+	config := serviceenv.NewConfiguration(&serviceenv.WorkerFullConfiguration{})
+	config.PPSEtcdPrefix = "pachyderm_pps"
+	config.MaxConcurrentJobs = maxConcurrentJobs
+	return &driver{
+		env: &serviceenv.TestServiceEnv{
+			Configuration: config,
+			EtcdClient:    etcdEnv.EtcdClient,
+		},
+		pipelineInfo: &pps.PipelineInfo{Pipeline: client.NewPipeline(pipeline)},
+	}
+}
+
+// TestNewJobSemaphore checks that the workers of every pipeline share the
+// MaxConcurrentJobs limit.
+func TestNewJobSemaphore(t *testing.T) {
+	etcdEnv := testetcd.NewEnv(t)
+	ctx := context.Background()
+	require.Nil(t, newJobSemaphoreTestDriver(etcdEnv, "pipeline", 0).NewJobSemaphore())
+
+	limit := 2
+	drivers := []*driver{
+		newJobSemaphoreTestDriver(etcdEnv, "pipeline1", limit),
+		newJobSemaphoreTestDriver(etcdEnv, "pipeline2", limit),
+		newJobSemaphoreTestDriver(etcdEnv, "pipeline3", limit),
+	}
+	var running, maxRunning int64
+	var eg errgroup.Group
+	for i := 0; i < 3*len(drivers); i++ {
+		d := drivers[i%len(drivers)]
+		eg.Go(func() error {
+			slot := d.NewJobSemaphore()
+			if err := slot.Acquire(ctx); err != nil {
+				return err
+			}
+			n := atomic.AddInt64(&running, 1)
+			for {
+				max := atomic.LoadInt64(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt64(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(100 * time.Millisecond)
+			atomic.AddInt64(&running, -1)
+			return slot.Release(ctx)
+		})
+	}
+	require.NoError(t, eg.Wait())
+	require.Equal(t, int64(limit), maxRunning)
+}
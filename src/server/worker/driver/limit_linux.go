@@ -0,0 +1,55 @@
+// +build linux
+
+package driver
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// memoryLimiterArg is the argument with which the worker binary is re-executed
+// to run user code under a memory limit.
+const memoryLimiterArg = "pach-limit-memory"
+
+// memoryLimitedCommand returns the command and arguments that run 'name' with
+// 'args' after capping its data segment (heap and anonymous mappings) at
+// 'bytes'. The limit is applied before the user code is exec'd, so it holds
+// from the first instruction and is inherited by any processes it spawns.
+func memoryLimitedCommand(bytes int64, name string, args []string) (string, []string, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return "", nil, err
+	}
+	return self, append([]string{memoryLimiterArg, strconv.FormatInt(bytes, 10), name}, args...), nil
+}
+
+// RunMemoryLimiter must be called at the start of the worker's main. If the
+// worker binary was executed by memoryLimitedCommand, it applies the memory
+// limit and execs the user code in place of the worker, and never returns.
+func RunMemoryLimiter() {
+	if len(os.Args) < 4 || os.Args[1] != memoryLimiterArg {
+		return
+	}
+	fail := func(err error) {
+		fmt.Fprintf(os.Stderr, "could not limit user code memory: %v\n", err)
+		os.Exit(1)
+	}
+	bytes, err := strconv.ParseInt(os.Args[2], 10, 64)
+	if err != nil {
+		fail(err)
+	}
+	// Look up the command before the limit applies to this process
+	name, err := exec.LookPath(os.Args[3])
+	if err != nil {
+		fail(err)
+	}
+	if err := unix.Setrlimit(unix.RLIMIT_DATA, &unix.Rlimit{Cur: uint64(bytes), Max: uint64(bytes)}); err != nil {
+		fail(err)
+	}
+	fail(syscall.Exec(name, os.Args[3:], os.Environ()))
+}
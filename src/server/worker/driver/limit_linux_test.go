@@ -0,0 +1,31 @@
+// +build linux
+
+package driver
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestMain(m *testing.M) {
+	// The test binary stands in for the worker binary in memoryLimitedCommand
+	RunMemoryLimiter()
+	os.Exit(m.Run())
+}
+
+func TestMemoryLimitedCommand(t *testing.T) {
+	name, args, err := memoryLimitedCommand(512*1024*1024, "sh", []string{"-c", "ulimit -d"})
+	require.NoError(t, err)
+	out, err := exec.Command(name, args...).Output()
+	require.NoError(t, err)
+	require.Equal(t, "524288", strings.TrimSpace(string(out)))
+
+	// The limiter fails, rather than running unlimited, if it can't exec the command
+	name, args, err = memoryLimitedCommand(512*1024*1024, "does-not-exist", nil)
+	require.NoError(t, err)
+	require.YesError(t, exec.Command(name, args...).Run())
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package driver
+
+import "github.com/pachyderm/pachyderm/v2/src/internal/errors"
+
+// memoryLimitedCommand is only supported on linux.
+func memoryLimitedCommand(bytes int64, name string, args []string) (string, []string, error) {
+	return "", nil, errors.New("limiting user code memory is only supported on linux")
+}
+
+// RunMemoryLimiter is a no-op except on linux.
+func RunMemoryLimiter() {}
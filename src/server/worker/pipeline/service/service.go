@@ -49,7 +49,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 		meta.Job = jobInfo.Job
 		defer func() {
 			if common.IsDone(ctx) {
-				retErr = ppsutil.FinishJob(pachClient, jobInfo, pps.JobState_JOB_FINISHING, "")
+				retErr = ppsutil.FinishJob(pachClient, driver.PipelineInfo(), jobInfo, pps.JobState_JOB_FINISHING, "", "")
 			}
 		}()
 		storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
@@ -1,12 +1,108 @@
 package spout
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 )
 
+// markerPollInterval is how often a spout with a commit marker checks /pfs/out
+// for the marker.
+const markerPollInterval = time.Second
+
 // Run will run a spout pipeline until the driver is canceled.
 func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	logger = logger.WithJob("spout")
+	if driver.PipelineInfo().Details.Spout.CommitMarker != "" {
+		return runWithCommitMarker(driver, logger)
+	}
 	return driver.RunUserCode(driver.PachClient().Ctx(), logger, nil)
 }
+
+// runWithCommitMarker runs the user code alongside a loop that commits the
+// contents of /pfs/out each time the user code writes the commit marker.
+func runWithCommitMarker(driver driver.Driver, logger logs.TaggedLogger) error {
+	outDir := filepath.Join(driver.InputDir(), datum.OutputPrefix)
+	if err := os.MkdirAll(outDir, 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Stop watching for the marker once the user code exits
+	ctx, cancel := context.WithCancel(driver.PachClient().Ctx())
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		defer cancel()
+		return driver.RunUserCode(ctx, logger, nil)
+	})
+	eg.Go(func() error {
+		marker := filepath.Join(outDir, driver.PipelineInfo().Details.Spout.CommitMarker)
+		ticker := time.NewTicker(markerPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+			if _, err := os.Stat(marker); err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return errors.EnsureStack(err)
+			}
+			if err := commitOutput(driver, logger, outDir, marker); err != nil {
+				return err
+			}
+		}
+	})
+	return errors.EnsureStack(eg.Wait())
+}
+
+// commitOutput writes every file in outDir other than the marker to a new
+// commit on the pipeline's output branch, then empties outDir.
+func commitOutput(driver driver.Driver, logger logs.TaggedLogger, outDir, marker string) error {
+	pipelineInfo := driver.PipelineInfo()
+	commit := client.NewCommit(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch, "")
+	var paths []string
+	if err := driver.PachClient().WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+		return filepath.Walk(outDir, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if info.IsDir() || p == marker {
+				return nil
+			}
+			rel, err := filepath.Rel(outDir, p)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			f, err := os.Open(p)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			defer f.Close()
+			paths = append(paths, p)
+			return mf.PutFile(filepath.ToSlash(rel), f)
+		})
+	}); err != nil {
+		return err
+	}
+	logger.Logf("committed %d files from %s", len(paths), outDir)
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	// Removing the marker last signals the user code that it can write the
+	// next batch
+	return errors.EnsureStack(os.Remove(marker))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
@@ -93,6 +94,15 @@ func (td *testDriver) PachClient() *client.APIClient {
 func (td *testDriver) ExpectedNumWorkers() (int64, error) {
 	return td.inner.ExpectedNumWorkers()
 }
+func (td *testDriver) NewJobSemaphore() dlock.Semaphore {
+	return td.inner.NewJobSemaphore()
+}
+func (td *testDriver) QuarantineReleases() ([]string, error) {
+	return td.inner.QuarantineReleases()
+}
+func (td *testDriver) ClearQuarantineReleases(datumIDs []string) error {
+	return td.inner.ClearQuarantineReleases(datumIDs)
+}
 func (td *testDriver) WithContext(ctx context.Context) driver.Driver {
 	return &testDriver{td.inner.WithContext(ctx)}
 }
@@ -108,12 +118,21 @@ func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger,
 func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserErrorHandlingCode(ctx, logger, env)
 }
+func (td *testDriver) RunUserPreflightCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
+	return td.inner.RunUserPreflightCode(ctx, logger, env)
+}
 func (td *testDriver) DeleteJob(sqlTx *sqlx.Tx, ji *pps.JobInfo) error {
 	return td.inner.DeleteJob(sqlTx, ji)
 }
 func (td *testDriver) UpdateJobState(job *pps.Job, state pps.JobState, reason string) error {
 	return td.inner.UpdateJobState(job, state, reason)
 }
+func (td *testDriver) CrashPipeline(reason string) error {
+	return td.inner.CrashPipeline(reason)
+}
+func (td *testDriver) ClearEgressFailure() error {
+	return td.inner.ClearEgressFailure()
+}
 func (td *testDriver) NewSQLTx(cb func(*sqlx.Tx) error) error {
 	return td.inner.NewSQLTx(cb)
 }
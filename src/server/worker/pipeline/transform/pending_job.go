@@ -2,17 +2,24 @@ package transform
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	glob "github.com/pachyderm/ohmyglob"
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
@@ -28,6 +35,18 @@ type pendingJob struct {
 	parentMetaCommit           *pfs.Commit
 	hasher                     datum.Hasher
 	noSkip                     bool
+	jobSlot                    dlock.Semaphore
+	// quarantined holds the hashes of the datums that failed in each of the
+	// pipeline's last QuarantineAfter jobs
+	quarantined map[string]bool
+	// released holds the IDs of the datums released from quarantine
+	released []string
+	// reprocessGlob, if set, matches the input paths of datums that must be
+	// reprocessed rather than skipped
+	reprocessGlob *glob.Glob
+	// description is the output commit description that the user code wrote
+	// to client.OutputDescriptionFile
+	description string
 }
 
 func (pj *pendingJob) writeJobInfo() error {
@@ -35,6 +54,18 @@ func (pj *pendingJob) writeJobInfo() error {
 	return ppsutil.WriteJobInfo(pj.driver.PachClient(), pj.ji)
 }
 
+// releaseJobSlot releases the job's slot in the cluster's job semaphore, if it
+// holds one.
+func (pj *pendingJob) releaseJobSlot(ctx context.Context) {
+	if pj.jobSlot == nil {
+		return
+	}
+	if err := pj.jobSlot.Release(ctx); err != nil {
+		pj.logger.Logf("error releasing job slot: %v", err)
+	}
+	pj.jobSlot = nil
+}
+
 // TODO: The job info should eventually just have a field with type *datum.Stats
 func (pj *pendingJob) saveJobStats(stats *datum.Stats) {
 	// TODO: Need to clean up the setup of process stats.
@@ -69,6 +100,33 @@ func (pj *pendingJob) load() error {
 		}); err != nil {
 		return err
 	}
+	// Pipelines without a meta repo have no parent datums to skip or delete,
+	// so every datum is processed.
+	var failedMetaCommits []*pfs.Commit
+	if !pj.driver.PipelineInfo().Details.NoMeta {
+		failedMetaCommits, err = pj.loadMeta()
+		if err != nil {
+			return err
+		}
+		if err := pj.loadReprocessGlob(); err != nil {
+			return err
+		}
+	}
+	// Load the job info.
+	pj.ji, err = pachClient.InspectJob(pj.ji.Job.Pipeline.Name, pj.ji.Job.ID, true)
+	if err != nil {
+		return err
+	}
+	pj.clearJobStats()
+	return pj.loadQuarantine(failedMetaCommits)
+}
+
+// loadMeta loads and clears the job's meta commit and finds its parent meta
+// commit. It returns the meta commits of the failed jobs since that parent
+// (newest first).
+func (pj *pendingJob) loadMeta() ([]*pfs.Commit, error) {
+	pachClient := pj.driver.PachClient()
+	var err error
 	// Load and clear the meta commit.
 	pj.metaCommitInfo, err = pachClient.PfsAPIClient.InspectCommit(
 		pachClient.Ctx(),
@@ -77,18 +135,19 @@ func (pj *pendingJob) load() error {
 			Wait:   pfs.CommitState_STARTED,
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if _, err := pachClient.PfsAPIClient.ClearCommit(
 		pachClient.Ctx(),
 		&pfs.ClearCommitRequest{
 			Commit: ppsutil.MetaCommit(pj.ji.OutputCommit),
 		}); err != nil {
-		return err
+		return nil, err
 	}
 	// Find the most recent successful ancestor commit to use as the
 	// base for this job.
 	// TODO: This should be an operation supported and exposed by PFS.
+	var failedMetaCommits []*pfs.Commit
 	pj.parentMetaCommit = pj.metaCommitInfo.ParentCommit
 	for pj.parentMetaCommit != nil {
 		ci, err := pachClient.PfsAPIClient.InspectCommit(
@@ -98,7 +157,7 @@ func (pj *pendingJob) load() error {
 				Wait:   pfs.CommitState_STARTED,
 			})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if ci.Error == "" {
 			if ci.Finishing != nil {
@@ -106,27 +165,152 @@ func (pj *pendingJob) load() error {
 			} else {
 				parentJi, err := pachClient.InspectJob(pj.ji.Job.Pipeline.Name, pj.parentMetaCommit.ID, true)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				dit, err := datum.NewIterator(pachClient, parentJi.Details.Input)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				pj.parentDit = datum.NewJobIterator(dit, parentJi.Job, pj.hasher)
 			}
 			break
 		}
+		failedMetaCommits = append(failedMetaCommits, ci.Commit)
 		pj.parentMetaCommit = ci.ParentCommit
 	}
-	// Load the job info.
-	pj.ji, err = pachClient.InspectJob(pj.ji.Job.Pipeline.Name, pj.ji.Job.ID, true)
+	return failedMetaCommits, nil
+}
+
+// loadReprocessGlob sets up the pipeline's reprocess_glob if the job is the
+// first job of its pipeline version to build on a job of an older version.
+// Later jobs skip datums as usual.
+func (pj *pendingJob) loadReprocessGlob() error {
+	reprocessGlob := pj.driver.PipelineInfo().Details.ReprocessGlob
+	if reprocessGlob == "" || pj.parentMetaCommit == nil {
+		return nil
+	}
+	parentJi, err := pj.driver.PachClient().InspectJob(pj.ji.Job.Pipeline.Name, pj.parentMetaCommit.ID, false)
 	if err != nil {
 		return err
 	}
-	pj.clearJobStats()
+	if parentJi.PipelineVersion >= pj.ji.PipelineVersion {
+		return nil
+	}
+	pj.reprocessGlob, err = glob.Compile(reprocessGlob, '/')
+	return errors.EnsureStack(err)
+}
+
+// reprocessDatum returns true if the datum must be reprocessed because one of
+// its input paths matches the pipeline's reprocess_glob.
+func (pj *pendingJob) reprocessDatum(meta *datum.Meta) bool {
+	if pj.reprocessGlob == nil {
+		return false
+	}
+	for _, input := range meta.Inputs {
+		if pj.reprocessGlob.Match(strings.TrimRight(input.FileInfo.File.Path, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadQuarantine determines which datums the job quarantines, given the meta
+// commits of the failed jobs since the job's parent (newest first).
+func (pj *pendingJob) loadQuarantine(failedMetaCommits []*pfs.Commit) error {
+	quarantineAfter := int(pj.driver.PipelineInfo().Details.QuarantineAfter)
+	if quarantineAfter == 0 {
+		return nil
+	}
+	var err error
+	pj.released, err = pj.driver.QuarantineReleases()
+	if err != nil {
+		return err
+	}
+	pj.quarantined = make(map[string]bool)
+	if len(failedMetaCommits) < quarantineAfter {
+		return nil
+	}
+	failures := make(map[string]int)
+	for _, commit := range failedMetaCommits[:quarantineAfter] {
+		if err := datum.NewCommitIterator(pj.driver.PachClient(), commit).Iterate(func(meta *datum.Meta) error {
+			if meta.State == datum.State_FAILED && meta.Job.ID == commit.ID {
+				failures[meta.Hash]++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for hash, n := range failures {
+		if n == quarantineAfter {
+			pj.quarantined[hash] = true
+		}
+	}
 	return nil
 }
 
+// isReleased returns true if the datum has been released from quarantine.
+func (pj *pendingJob) isReleased(meta *datum.Meta) bool {
+	id := common.DatumID(meta.Inputs)
+	for _, released := range pj.released {
+		if released == id {
+			return true
+		}
+	}
+	return false
+}
+
+// quarantinedDatum returns true if the datum should be quarantined rather
+// than processed.
+func (pj *pendingJob) quarantinedDatum(meta *datum.Meta) bool {
+	if pj.noSkip || !pj.quarantined[meta.Hash] {
+		return false
+	}
+	return !pj.isReleased(meta)
+}
+
+// stillQuarantined returns true if a datum quarantined by the parent job
+// remains quarantined.
+func (pj *pendingJob) stillQuarantined(meta, parentMeta *datum.Meta) bool {
+	if pj.noSkip || parentMeta.State != datum.State_QUARANTINED {
+		return false
+	}
+	return meta.Hash == parentMeta.Hash && !pj.isReleased(parentMeta)
+}
+
+// writeQuarantined records the given datums as quarantined in the job's meta
+// commit.
+func (pj *pendingJob) writeQuarantined(pachClient *client.APIClient, metas []*datum.Meta) error {
+	if len(metas) == 0 {
+		return nil
+	}
+	fileSetID, err := withDatumFileSet(pachClient, func(s *datum.Set) error {
+		for _, meta := range metas {
+			pj.logger.WithData(meta.Inputs).Logf("quarantining datum")
+			meta.State = datum.State_QUARANTINED
+			meta.Reason = fmt.Sprintf("datum failed in %d consecutive jobs", pj.driver.PipelineInfo().Details.QuarantineAfter)
+			if err := s.UploadMeta(meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := pachClient.PfsAPIClient.AddFileSet(
+		pachClient.Ctx(),
+		&pfs.AddFileSetRequest{
+			Commit:    pj.metaCommitInfo.Commit,
+			FileSetId: fileSetID,
+		},
+	); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	pj.saveJobStats(&datum.Stats{ProcessStats: &pps.ProcessStats{}, Skipped: int64(len(metas))})
+	return pj.writeJobInfo()
+}
+
 func (pj *pendingJob) clearJobStats() {
 	pj.ji.Stats = &pps.ProcessStats{}
 	pj.ji.DataProcessed = 0
@@ -134,6 +318,8 @@ func (pj *pendingJob) clearJobStats() {
 	pj.ji.DataFailed = 0
 	pj.ji.DataRecovered = 0
 	pj.ji.DataTotal = 0
+	pj.ji.DataPending = 0
+	pj.ji.FailedDatums = nil
 }
 
 func (pj *pendingJob) withDeleter(pachClient *client.APIClient, cb func(datum.Deleter) error) error {
@@ -191,11 +377,16 @@ func (pj *pendingJob) withParallelDatums(ctx context.Context, cb func(context.Co
 		}
 		dits = append(dits, datum.NewFileSetIterator(pachClient, fileSetID))
 		// Create the output datum file set for the new datums (datums that do not exist in the parent job).
+		var quarantined []*datum.Meta
 		outputFileSetID, err := withDatumFileSet(pachClient, func(outputSet *datum.Set) error {
 			return datum.Merge(dits, func(metas []*datum.Meta) error {
 				if len(metas) > 1 || !proto.Equal(metas[0].Job, pj.ji.Job) {
 					return nil
 				}
+				if pj.quarantinedDatum(metas[0]) {
+					quarantined = append(quarantined, proto.Clone(metas[0]).(*datum.Meta))
+					return nil
+				}
 				pj.logger.WithData(metas[0].Inputs).Logf("setting up datum for processing (parallel jobs)")
 				return outputSet.UploadMeta(metas[0], datum.WithPrefixIndex())
 			})
@@ -204,6 +395,9 @@ func (pj *pendingJob) withParallelDatums(ctx context.Context, cb func(context.Co
 			return err
 		}
 		renewer.Add(outputFileSetID)
+		if err := pj.writeQuarantined(pachClient, quarantined); err != nil {
+			return err
+		}
 		return cb(ctx, datum.NewFileSetIterator(pachClient, outputFileSetID))
 	})
 }
@@ -245,6 +439,7 @@ func (pj *pendingJob) withSerialDatums(ctx context.Context, cb func(context.Cont
 		// Also create deletion operations appropriately.
 		fileSetIterator := datum.NewFileSetIterator(pachClient, fileSetID)
 		stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
+		var quarantined []*datum.Meta
 		outputFileSetID, err := withDatumFileSet(pachClient, func(s *datum.Set) error {
 			return pj.withDeleter(pachClient, func(deleter datum.Deleter) error {
 				return datum.Merge([]datum.Iterator{parentDit, fileSetIterator}, func(metas []*datum.Meta) error {
@@ -257,13 +452,17 @@ func (pj *pendingJob) withSerialDatums(ctx context.Context, cb func(context.Cont
 						return deleter(metas[0])
 					}
 					// Check if a skippable datum was successfully processed by the parent.
-					if pj.skippableDatum(metas[1], metas[0]) {
+					if pj.skippableDatum(metas[1], metas[0]) || pj.stillQuarantined(metas[1], metas[0]) {
 						stats.Skipped++
 						return nil
 					}
 					if err := deleter(metas[0]); err != nil {
 						return err
 					}
+					if pj.quarantinedDatum(metas[1]) {
+						quarantined = append(quarantined, proto.Clone(metas[1]).(*datum.Meta))
+						return nil
+					}
 					pj.logger.WithData(metas[1].Inputs).Logf("setting up datum for processing (serial jobs)")
 					return s.UploadMeta(metas[1], datum.WithPrefixIndex())
 				})
@@ -273,6 +472,9 @@ func (pj *pendingJob) withSerialDatums(ctx context.Context, cb func(context.Cont
 			return err
 		}
 		renewer.Add(outputFileSetID)
+		if err := pj.writeQuarantined(pachClient, quarantined); err != nil {
+			return err
+		}
 		pj.saveJobStats(stats)
 		if err := pj.writeJobInfo(); err != nil {
 			return err
@@ -301,7 +503,7 @@ func withDatumFileSet(pachClient *client.APIClient, cb func(*datum.Set) error) (
 }
 
 func (pj *pendingJob) skippableDatum(meta1, meta2 *datum.Meta) bool {
-	if pj.noSkip {
+	if pj.noSkip || pj.reprocessDatum(meta1) {
 		return false
 	}
 	// If the hashes are equal and the second datum was processed, then skip it.
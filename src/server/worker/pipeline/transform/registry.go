@@ -1,12 +1,16 @@
 package transform
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 	"golang.org/x/sync/errgroup"
@@ -18,6 +22,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
@@ -34,6 +40,8 @@ import (
 
 const (
 	defaultDatumSetsPerWorker int64 = 4
+	failureReportLogLines     int64 = 10
+	maxJobFailedDatums              = 10
 )
 
 type hasher struct {
@@ -61,6 +69,10 @@ func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, err
 	if err != nil {
 		return nil, err
 	}
+	jobLimit := int(concurrency)
+	if maxJobs := driver.PipelineInfo().Details.MaxInFlightJobs; maxJobs > 0 && maxJobs < concurrency {
+		jobLimit = int(maxJobs)
+	}
 	taskQueue, err := driver.NewTaskQueue()
 	if err != nil {
 		return nil, err
@@ -70,20 +82,28 @@ func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, err
 		logger:      logger,
 		taskQueue:   taskQueue,
 		concurrency: concurrency,
-		limiter:     limit.New(int(concurrency)),
+		limiter:     limit.New(jobLimit),
 	}, nil
 }
 
 func (reg *registry) succeedJob(pj *pendingJob) error {
 	pj.logger.Logf("job successful, closing commits")
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
-	return ppsutil.FinishJob(reg.driver.PachClient(), pj.ji, pps.JobState_JOB_FINISHING, "")
+	return ppsutil.FinishJob(reg.driver.PachClient(), reg.driver.PipelineInfo(), pj.ji, pps.JobState_JOB_FINISHING, "", pj.description)
 }
 
 func (reg *registry) failJob(pj *pendingJob, reason string) error {
 	pj.logger.Logf("failing job with reason: %s", reason)
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
-	return ppsutil.FinishJob(reg.driver.PachClient(), pj.ji, pps.JobState_JOB_FAILURE, reason)
+	return ppsutil.FinishJob(reg.driver.PachClient(), reg.driver.PipelineInfo(), pj.ji, pps.JobState_JOB_FAILURE, reason, pj.description)
+}
+
+// timeoutJob fails a job that has run for longer than its timeout. It runs on
+// the job's goroutine, once the job's processing has been cancelled.
+func (reg *registry) timeoutJob(pj *pendingJob, timeout time.Duration) error {
+	reason := fmt.Sprintf("job exceeded its timeout of %v", timeout)
+	pj.logger.Logf("failing job with reason: %s", reason)
+	return ppsutil.FinishJob(reg.driver.PachClient(), reg.driver.PipelineInfo(), pj.ji, pps.JobState_JOB_FAILURE, reason, "")
 }
 
 func (reg *registry) killJob(pj *pendingJob, reason string) error {
@@ -117,8 +137,13 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 		hasher: &hasher{
 			salt: pi.Details.Salt,
 		},
-		noSkip: pi.Details.ReprocessSpec == client.ReprocessSpecEveryJob || pi.Details.S3Out,
+		noSkip: pi.Details.ReprocessSpec == client.ReprocessSpecEveryJob || pi.Details.S3Out || jobInfo.Reprocess,
 	}
+	defer func() {
+		if retErr != nil {
+			pj.releaseJobSlot(reg.driver.PachClient().Ctx())
+		}
+	}()
 	if pj.ji.State == pps.JobState_JOB_CREATED {
 		pj.ji.State = pps.JobState_JOB_STARTING
 		if err := pj.writeJobInfo(); err != nil {
@@ -135,13 +160,13 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 		return err
 	}
 	// TODO: This could probably be scoped to a callback.
-	var afterTime time.Duration
+	var timeout, afterTime time.Duration
 	if pj.ji.Details.JobTimeout != nil {
 		startTime, err := types.TimestampFromProto(pj.ji.Started)
 		if err != nil {
 			return err
 		}
-		timeout, err := types.DurationFromProto(pj.ji.Details.JobTimeout)
+		timeout, err = types.DurationFromProto(pj.ji.Details.JobTimeout)
 		if err != nil {
 			return err
 		}
@@ -149,15 +174,16 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 	}
 	go func() {
 		defer reg.limiter.Release()
+		defer pj.releaseJobSlot(reg.driver.PachClient().Ctx())
+		jobCtx := reg.driver.PachClient().Ctx()
 		if pj.ji.Details.JobTimeout != nil {
 			pj.logger.Logf("cancelling job at: %+v", afterTime)
-			timer := time.AfterFunc(afterTime, func() {
-				reg.killJob(pj, "job timed out")
-			})
-			defer timer.Stop()
+			var cancel context.CancelFunc
+			jobCtx, cancel = context.WithTimeout(jobCtx, afterTime)
+			defer cancel()
 		}
-		if err := backoff.RetryUntilCancel(pj.driver.PachClient().Ctx(), func() error {
-			ctx, cancel := context.WithCancel(reg.driver.PachClient().Ctx())
+		if err := backoff.RetryUntilCancel(jobCtx, func() error {
+			ctx, cancel := context.WithCancel(jobCtx)
 			defer cancel()
 			eg, jobCtx := errgroup.WithContext(ctx)
 			pj.driver = reg.driver.WithContext(jobCtx)
@@ -194,6 +220,14 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 			}
 			return nil
 		}); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				// The job's goroutines have exited, so pj isn't modified
+				// while the job is failed
+				if err := reg.timeoutJob(pj, timeout); err != nil {
+					pj.logger.Logf("error failing timed out job: %v", err)
+				}
+				return
+			}
 			// TODO: We can hit this due to a transient failure of the pachd sidecar.
 			pj.logger.Logf("fatal job error: %v", err)
 		}
@@ -264,16 +298,53 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 		reason := fmt.Sprintf("inputs failed: %s", strings.Join(failed, ", "))
 		return reg.failJob(pj, reason)
 	}
+	// Wait for a free slot if the cluster limits the number of running jobs.
+	// This happens after the inputs are ready so that a job waiting on its
+	// upstream jobs doesn't hold a slot they need.
+	if slot := pj.driver.NewJobSemaphore(); slot != nil {
+		if err := pj.logger.LogStep("waiting for a job slot", func() error {
+			return slot.Acquire(pj.driver.PachClient().Ctx())
+		}); err != nil {
+			return err
+		}
+		pj.jobSlot = slot
+	}
 	pj.ji.State = pps.JobState_JOB_RUNNING
 	return pj.writeJobInfo()
 }
 
 func (reg *registry) processJobRunning(pj *pendingJob) error {
 	pachClient := pj.driver.PachClient()
+	if script := pj.driver.PipelineInfo().Details.Transform.ScriptFromInput; script != "" {
+		var reason string
+		if err := pj.logger.LogStep("checking the transform's script", func() (retErr error) {
+			reason, retErr = checkScriptFromInput(pj, script)
+			return retErr
+		}); err != nil {
+			return err
+		}
+		if reason != "" {
+			return reg.failJob(pj, reason)
+		}
+	}
+	if len(pj.driver.PipelineInfo().Details.Transform.PreflightCmd) > 0 {
+		var reason string
+		if err := pj.logger.LogStep("running preflight command", func() (retErr error) {
+			reason, retErr = reg.runPreflight(pj)
+			return retErr
+		}); err != nil {
+			return err
+		}
+		if reason != "" {
+			return reg.failJob(pj, reason)
+		}
+	}
 	// TODO: We need to delete the output for S3Out since we don't have a clear way to track the output in the stats commit (which means datums cannot be skipped with S3Out).
 	// If we had a way to map the output added through the S3 gateway back to the datums, and stored this in the appropriate place in the stats commit, then we would be able
 	// handle datums the same way we handle normal pipelines.
-	if pj.driver.PipelineInfo().Details.S3Out {
+	// Pipelines without a meta repo have no record of which datums produced
+	// the parent's output, so they also rewrite their output from scratch.
+	if pj.driver.PipelineInfo().Details.S3Out || pj.driver.PipelineInfo().Details.NoMeta {
 		if err := pachClient.DeleteFile(pj.commitInfo.Commit, "/"); err != nil {
 			return err
 		}
@@ -289,10 +360,24 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		})
 	}); err != nil {
 		if errors.Is(err, errutil.ErrBreak) {
-			return nil
+			// The job failed, but it still processed the released datums
+			return pj.driver.ClearQuarantineReleases(pj.released)
 		}
 		return err
 	}
+	if err := pj.driver.ClearQuarantineReleases(pj.released); err != nil {
+		return err
+	}
+	var reason string
+	if err := pj.logger.LogStep("reading output control files", func() (retErr error) {
+		pj.description, reason, retErr = readOutputControlFiles(pachClient, pj.commitInfo.Commit)
+		return retErr
+	}); err != nil {
+		return err
+	}
+	if reason != "" {
+		return reg.failJob(pj, reason)
+	}
 	if pj.ji.Details.Egress != nil {
 		pj.ji.State = pps.JobState_JOB_EGRESSING
 		return pj.writeJobInfo()
@@ -300,6 +385,104 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	return reg.succeedJob(pj)
 }
 
+// readOutputControlFiles reads and removes the control files that user code
+// may write to the root of /pfs/out. It returns the description to set on the
+// output commit and, if the user code flagged the job as failed, the reason.
+func readOutputControlFiles(pachClient *client.APIClient, commit *pfs.Commit) (description, reason string, retErr error) {
+	var contents []string
+	for _, file := range []string{client.OutputDescriptionFile, client.OutputErrorFile} {
+		buf := &bytes.Buffer{}
+		if err := pachClient.GetFile(commit, file, buf); err != nil {
+			if !pfsserver.IsFileNotFoundErr(err) {
+				return "", "", err
+			}
+			contents = append(contents, "")
+			continue
+		}
+		if err := pachClient.DeleteFile(commit, file); err != nil {
+			return "", "", err
+		}
+		contents = append(contents, strings.TrimSpace(buf.String()))
+	}
+	return contents[0], contents[1], nil
+}
+
+// checkScriptFromInput checks that the transform's script exists in the job's
+// input commit. If it doesn't, it returns the reason the job should fail.
+func checkScriptFromInput(pj *pendingJob, script string) (string, error) {
+	name, scriptPath := ppsutil.SplitScriptFromInput(script)
+	var reason string
+	jobInput := ppsutil.JobInput(pj.driver.PipelineInfo(), pj.commitInfo.Commit)
+	if err := pps.VisitInput(jobInput, func(input *pps.Input) error {
+		if input.Pfs == nil || input.Pfs.Name != name {
+			return nil
+		}
+		commit := client.NewCommit(input.Pfs.Repo, input.Pfs.Branch, input.Pfs.Commit)
+		if _, err := pj.driver.PachClient().InspectFile(commit, scriptPath); err != nil {
+			if !pfsserver.IsFileNotFoundErr(err) && !pfsserver.IsAncestorNotFoundErr(input.Pfs.Commit, err) {
+				return err
+			}
+			reason = fmt.Sprintf("script %q not found in input %q", scriptPath, name)
+		}
+		return errutil.ErrBreak
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return "", errors.EnsureStack(err)
+	}
+	return reason, nil
+}
+
+// runPreflight runs the pipeline's preflight command with all of the job's
+// inputs downloaded, and returns the reason to fail the job if the command
+// fails.
+func (reg *registry) runPreflight(pj *pendingJob) (string, error) {
+	pachClient := pj.driver.PachClient()
+	meta := &datum.Meta{Job: pj.ji.Job}
+	jobInput := ppsutil.JobInput(pj.driver.PipelineInfo(), pj.commitInfo.Commit)
+	if err := pps.VisitInput(jobInput, func(input *pps.Input) error {
+		if input.Pfs == nil || input.Pfs.S3 {
+			return nil
+		}
+		fi, err := pachClient.InspectFile(client.NewCommit(input.Pfs.Repo, input.Pfs.Branch, input.Pfs.Commit), "/")
+		if err != nil {
+			if pfsserver.IsFileNotFoundErr(err) || pfsserver.IsAncestorNotFoundErr(input.Pfs.Commit, err) {
+				// The input commit is empty, so there's nothing to download
+				return nil
+			}
+			return err
+		}
+		meta.Inputs = append(meta.Inputs, &common.Input{
+			FileInfo:   fi,
+			Name:       input.Pfs.Name,
+			Lazy:       input.Pfs.Lazy,
+			Branch:     input.Pfs.Branch,
+			EmptyFiles: input.Pfs.EmptyFiles,
+		})
+		return nil
+	}); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	var preflightErr error
+	storageRoot := filepath.Join(pj.driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
+	if err := datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
+		env := pj.driver.UserCodeEnv(pj.ji.Job.ID, pj.commitInfo.Commit, meta.Inputs)
+		return s.WithDatum(meta, func(d *datum.Datum) error {
+			return pj.driver.WithActiveData(meta.Inputs, d.PFSStorageRoot(), func() error {
+				preflightErr = pj.driver.RunUserPreflightCode(pachClient.Ctx(), pj.logger, env)
+				return preflightErr
+			})
+		}, datum.WithRetry(0))
+	}); err != nil {
+		return "", err
+	}
+	if preflightErr != nil {
+		if err := pachClient.Ctx().Err(); err != nil {
+			return "", errors.EnsureStack(err)
+		}
+		return preflightErr.Error(), nil
+	}
+	return "", nil
+}
+
 func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *work.Master, dit datum.Iterator) error {
 	var numDatums int64
 	if err := dit.Iterate(func(_ *datum.Meta) error {
@@ -308,6 +491,10 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 	}); err != nil {
 		return err
 	}
+	pj.ji.DataPending += numDatums
+	if err := pj.writeJobInfo(); err != nil {
+		return err
+	}
 	// Set up the datum set spec for the job.
 	// When the datum set spec is not set, evenly distribute the datums.
 	var setSpec *datum.SetSpec
@@ -325,6 +512,7 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 			setSpec.Number = 1
 		}
 	}
+	setSpec.ShardByKey = pj.driver.PipelineInfo().Details.ShardByKey
 	pachClient := pj.driver.PachClient().WithCtx(ctx)
 	subtasks := make(chan *work.Task)
 	stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
@@ -372,19 +560,22 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 						); err != nil {
 							return grpcutil.ScrubGRPC(err)
 						}
-						if _, err := pachClient.PfsAPIClient.AddFileSet(
-							pachClient.Ctx(),
-							&pfs.AddFileSetRequest{
-								Commit:    pj.metaCommitInfo.Commit,
-								FileSetId: data.MetaFileSetId,
-							},
-						); err != nil {
-							return grpcutil.ScrubGRPC(err)
+						if pj.metaCommitInfo != nil {
+							if _, err := pachClient.PfsAPIClient.AddFileSet(
+								pachClient.Ctx(),
+								&pfs.AddFileSetRequest{
+									Commit:    pj.metaCommitInfo.Commit,
+									FileSetId: data.MetaFileSetId,
+								},
+							); err != nil {
+								return grpcutil.ScrubGRPC(err)
+							}
 						}
 						if err := datum.MergeStats(stats, data.Stats); err != nil {
 							return err
 						}
 						pj.saveJobStats(data.Stats)
+						pj.ji.DataPending -= data.Stats.Processed + data.Stats.Skipped + data.Stats.Failed + data.Stats.Recovered
 						return pj.writeJobInfo()
 					},
 				)
@@ -395,7 +586,25 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 		return err
 	}
 	if stats.FailedID != "" {
-		if err := reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedID)); err != nil {
+		reason := fmt.Sprintf("datum %v failed", stats.FailedID)
+		if pj.driver.PipelineInfo().Details.FailureReport {
+			if err := pj.logger.LogStep("writing failure report", func() error {
+				return writeFailureReport(pj, reason)
+			}); err != nil {
+				return err
+			}
+		}
+		if err := pj.logger.LogStep("collecting failed datums", func() error {
+			failedDatums, err := jobFailedDatums(pj, maxJobFailedDatums)
+			if err != nil {
+				return err
+			}
+			pj.ji.FailedDatums = failedDatums
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := reg.failJob(pj, reason); err != nil {
 			return err
 		}
 		return errutil.ErrBreak
@@ -403,6 +612,88 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 	return nil
 }
 
+// writeFailureReport writes a report of the job's failed datums to the
+// failure report branch of the output repo.
+func writeFailureReport(pj *pendingJob, reason string) error {
+	pachClient := pj.driver.PachClient()
+	failedDatums, err := jobFailedDatums(pj, 0)
+	if err != nil {
+		return err
+	}
+	report := &pps.FailureReport{
+		Job:          pj.ji.Job,
+		Reason:       reason,
+		FailedDatums: failedDatums,
+	}
+	buf := &bytes.Buffer{}
+	if err := (&jsonpb.Marshaler{Indent: "  "}).Marshal(buf, report); err != nil {
+		return errors.EnsureStack(err)
+	}
+	reportCommit := client.NewCommit(pj.ji.Job.Pipeline.Name, client.FailureReportBranch, "")
+	return pachClient.PutFile(reportCommit, pj.ji.Job.ID+".json", buf)
+}
+
+// jobFailedDatums returns the datums that failed in the job, along with their
+// logs. If limit is greater than zero, at most limit datums are returned.
+// Jobs without a meta commit don't record their datums, so none are returned.
+func jobFailedDatums(pj *pendingJob, limit int) ([]*pps.FailedDatum, error) {
+	if pj.metaCommitInfo == nil {
+		return nil, nil
+	}
+	pachClient := pj.driver.PachClient()
+	var failedDatums []*pps.FailedDatum
+	dit := datum.NewCommitIterator(pachClient, pj.metaCommitInfo.Commit)
+	if err := dit.Iterate(func(meta *datum.Meta) error {
+		if meta.State != datum.State_FAILED || !proto.Equal(meta.Job, pj.ji.Job) {
+			return nil
+		}
+		if limit > 0 && len(failedDatums) >= limit {
+			return errutil.ErrBreak
+		}
+		failedDatum := &pps.FailedDatum{
+			Datum: &pps.Datum{
+				Job: pj.ji.Job,
+				ID:  common.DatumID(meta.Inputs),
+			},
+			Reason:  meta.Reason,
+			Retries: meta.Retries,
+		}
+		for _, input := range meta.Inputs {
+			failedDatum.Data = append(failedDatum.Data, input.FileInfo)
+		}
+		failedDatum.Logs = datumLogs(pachClient, failedDatum.Datum)
+		failedDatums = append(failedDatums, failedDatum)
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	return failedDatums, nil
+}
+
+// datumLogs returns the last lines logged for a datum. Logs are best effort,
+// so no lines are returned if they can't be retrieved.
+func datumLogs(pachClient *client.APIClient, d *pps.Datum) []string {
+	ctx, cancel := context.WithCancel(pachClient.Ctx())
+	defer cancel()
+	logsClient, err := pachClient.PpsAPIClient.GetLogs(ctx, &pps.GetLogsRequest{
+		Pipeline: d.Job.Pipeline,
+		Job:      d.Job,
+		Datum:    d,
+		Tail:     failureReportLogLines,
+	})
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for {
+		msg, err := logsClient.Recv()
+		if err != nil {
+			return lines
+		}
+		lines = append(lines, msg.Message)
+	}
+}
+
 func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload func(client.ModifyFile) error, renewer *renew.StringSet) (*work.Task, error) {
 	resp, err := pachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
 		return upload(mf)
@@ -445,20 +736,61 @@ func deserializeDatumSet(any *types.Any) (*DatumSet, error) {
 }
 
 func (reg *registry) processJobEgressing(pj *pendingJob) error {
-	url := pj.ji.Details.Egress.URL
-	err := pj.driver.PachClient().GetFileURL(pj.commitInfo.Commit, "/", url)
+	egress := pj.ji.Details.Egress
+	var err error
+	if egress.Secret != nil {
+		err = egressWithSecret(pj.driver.PachClient(), pj.commitInfo.Commit, egress)
+	} else {
+		err = pj.driver.PachClient().GetFileURL(pj.commitInfo.Commit, "/", egress.URL)
+	}
 	// file not found means the commit is empty, nothing to egress
 	if err != nil && !pfsserver.IsFileNotFoundErr(err) {
+		// The job stays in EGRESSING and is retried, but the pipeline is marked
+		// CRASHING so that the failure is visible.
+		reason := fmt.Sprintf("%s%s to %s: %v", ppsutil.EgressFailedReasonPrefix, pj.ji.Job.ID, egress.URL, err)
+		if err := pj.driver.CrashPipeline(reason); err != nil {
+			pj.logger.Logf("could not mark pipeline crashing: %v", err)
+		}
 		return err
 	}
+	if err := pj.driver.ClearEgressFailure(); err != nil {
+		pj.logger.Logf("could not move pipeline out of CRASHING: %v", err)
+	}
 	return reg.succeedJob(pj)
 }
 
+// egressWithSecret copies the files in 'commit' to the s3 URL of 'egress',
+// using the credentials in the egress secret mounted in this worker.
+func egressWithSecret(pachClient *client.APIClient, commit *pfs.Commit, egress *pps.Egress) error {
+	url, err := obj.ParseURL(egress.URL)
+	if err != nil {
+		return err
+	}
+	objClient, err := obj.NewAmazonClientFromSecretDir(ppsutil.EgressSecretPath(egress), url.Bucket)
+	if err != nil {
+		return err
+	}
+	ctx := pachClient.Ctx()
+	return pachClient.WalkFile(commit, "/", func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		return miscutil.WithPipe(func(w io.Writer) error {
+			return pachClient.GetFile(commit, fi.File.Path, w)
+		}, func(r io.Reader) error {
+			return errors.EnsureStack(objClient.Put(ctx, filepath.Join(url.Object, fi.File.Path), r))
+		})
+	})
+}
+
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	waitCommit := func(name string, commit *pfs.Commit) error {
 		ci, err := pachClient.WaitCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
 		if err != nil {
+			if pfsserver.IsAncestorNotFoundErr(commit.ID, err) {
+				return nil
+			}
 			return errors.Wrapf(err, "error blocking on commit %s", commit)
 		}
 		if ci.Error != "" {
@@ -18,8 +18,16 @@ type Status struct {
 	jobID       string
 	datumStatus *pps.DatumStatus
 	cancel      func()
+	queueSize   int64
+	// finished holds the times at which datums finished within the last
+	// datumRateWindow, oldest first
+	finished []time.Time
 }
 
+// datumRateWindow is the window over which a worker's datum throughput is
+// measured.
+const datumRateWindow = time.Minute
+
 func convertInputs(inputs []*common.Input) []*pps.InputFile {
 	var result []*pps.InputFile
 	for _, input := range inputs {
@@ -44,11 +52,20 @@ func (s *Status) withJob(jobID string, cb func() error) error {
 
 	defer s.withLock(func() {
 		s.jobID = ""
+		s.queueSize = 0
 	})
 
 	return cb()
 }
 
+// setQueueSize sets the number of datums that the worker has yet to process in
+// its current datum set.
+func (s *Status) setQueueSize(queueSize int64) {
+	s.withLock(func() {
+		s.queueSize = queueSize
+	})
+}
+
 func (s *Status) withDatum(inputs []*common.Input, cancel func(), cb func() error) error {
 	var err error
 	s.withLock(func() {
@@ -69,19 +86,37 @@ func (s *Status) withDatum(inputs []*common.Input, cancel func(), cb func() erro
 	defer s.withLock(func() {
 		s.datumStatus = nil
 		s.cancel = nil
+		if s.queueSize > 0 {
+			s.queueSize--
+		}
+		now := time.Now()
+		s.finished = append(s.pruneFinished(now), now)
 	})
 
 	return cb()
 }
 
+// pruneFinished drops the finish times that are no longer within
+// datumRateWindow of now. The caller must hold the lock.
+func (s *Status) pruneFinished(now time.Time) []time.Time {
+	i := 0
+	for i < len(s.finished) && now.Sub(s.finished[i]) > datumRateWindow {
+		i++
+	}
+	s.finished = s.finished[i:]
+	return s.finished
+}
+
 // GetStatus returns the current WorkerStatus for the transform worker
 func (s *Status) GetStatus() (*pps.WorkerStatus, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	return &pps.WorkerStatus{
-		JobID:       s.jobID,
-		DatumStatus: s.datumStatus,
+		JobID:           s.jobID,
+		DatumStatus:     s.datumStatus,
+		QueueSize:       s.queueSize,
+		DatumsPerSecond: float64(len(s.pruneFinished(time.Now()))) / datumRateWindow.Seconds(),
 	}, nil
 }
 
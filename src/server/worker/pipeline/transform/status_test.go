@@ -0,0 +1,43 @@
+package transform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestStatusQueueSize(t *testing.T) {
+	s := &Status{}
+	inputs := []*common.Input{{FileInfo: &pfs.FileInfo{File: &pfs.File{Path: "/foo"}}}}
+	require.NoError(t, s.withJob("job", func() error {
+		s.setQueueSize(3)
+		for i := 3; i > 0; i-- {
+			require.NoError(t, s.withDatum(inputs, func() {}, func() error {
+				status, err := s.GetStatus()
+				require.NoError(t, err)
+				require.Equal(t, "job", status.JobID)
+				require.Equal(t, int64(i), status.QueueSize)
+				require.Equal(t, "/foo", status.DatumStatus.Data[0].Path)
+				return nil
+			}))
+		}
+		status, err := s.GetStatus()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), status.QueueSize)
+		require.Equal(t, 3/datumRateWindow.Seconds(), status.DatumsPerSecond)
+		return nil
+	}))
+	status, err := s.GetStatus()
+	require.NoError(t, err)
+	require.Equal(t, "", status.JobID)
+	require.Equal(t, int64(0), status.QueueSize)
+
+	// Datums that finished outside of the window no longer count
+	s.finished[0] = time.Now().Add(-2 * datumRateWindow)
+	status, err = s.GetStatus()
+	require.NoError(t, err)
+	require.Equal(t, 2/datumRateWindow.Seconds(), status.DatumsPerSecond)
+}
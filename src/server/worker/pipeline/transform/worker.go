@@ -11,10 +11,13 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
@@ -69,6 +72,34 @@ func checkS3Gateway(driver driver.Driver, logger logs.TaggedLogger) error {
 	// return nil
 }
 
+// dedupAgainstCommit resolves the commit that the outputs of a job are
+// deduplicated against. If the pipeline dedups against its own output branch,
+// the previous job's output commit is used. A nil commit is returned if
+// there is nothing to dedup against.
+func dedupAgainstCommit(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) (*pfs.Commit, error) {
+	if pipelineInfo.Details.DedupAgainst == pipelineInfo.Details.OutputBranch {
+		return previousOutputCommit(pachClient, outputCommit)
+	}
+	commitInfo, err := pachClient.InspectCommit(pipelineInfo.Pipeline.Name, pipelineInfo.Details.DedupAgainst, "")
+	if err != nil {
+		if pfsserver.IsBranchNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return commitInfo.Commit, nil
+}
+
+// previousOutputCommit returns the output commit of the job before the one
+// that produces outputCommit, or nil if this is the pipeline's first job.
+func previousOutputCommit(pachClient *client.APIClient, outputCommit *pfs.Commit) (*pfs.Commit, error) {
+	commitInfo, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{Commit: outputCommit})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commitInfo.ParentCommit, nil
+}
+
 func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status) error {
 	pachClient := driver.PachClient()
 	// TODO: Can this just be refactored into the datum package such that we don't need to specify a storage root for the sets?
@@ -84,9 +115,36 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
 			}
+			if driver.PipelineInfo().Details.DedupAgainst != "" {
+				dedupCommit, err := dedupAgainstCommit(pachClient, driver.PipelineInfo(), datumSet.OutputCommit)
+				if err != nil {
+					return err
+				}
+				if dedupCommit != nil {
+					opts = append(opts, datum.WithDedupAgainst(dedupCommit))
+				}
+			}
+			if driver.PipelineInfo().Details.Passthrough {
+				opts = append(opts, datum.WithPassthrough())
+			}
+			if name := driver.PipelineInfo().Details.PreviousOutput; name != "" {
+				previousCommit, err := previousOutputCommit(pachClient, datumSet.OutputCommit)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, datum.WithPreviousOutput(name, previousCommit))
+			}
 			// Setup datum set for processing.
 			return datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
 				di := datum.NewFileSetIterator(pachClient, datumSet.FileSetId)
+				var queueSize int64
+				if err := di.Iterate(func(_ *datum.Meta) error {
+					queueSize++
+					return nil
+				}); err != nil {
+					return err
+				}
+				status.setQueueSize(queueSize)
 				// Process each datum in the assigned datum set.
 				return di.Iterate(func(meta *datum.Meta) error {
 					ctx := pachClient.Ctx()
@@ -6,6 +6,8 @@ import (
 	"path"
 	"time"
 
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
 	"github.com/gogo/protobuf/types"
 	"golang.org/x/sync/errgroup"
 
@@ -76,9 +78,68 @@ func NewWorker(
 
 	go worker.master(env)
 	go worker.worker()
+	if pipelineInfo.Details.Heartbeat != nil {
+		go worker.heartbeat(env)
+	}
 	return worker, nil
 }
 
+// heartbeatTTL is the TTL, in seconds, of the lease on a worker's heartbeat
+// key. As with DLock, it's short so that a dead worker's heartbeat is removed
+// quickly.
+const heartbeatTTL = 15
+
+// heartbeat periodically records the current time in this worker's heartbeat
+// key, which the PPS master watches to detect stalled pipelines. The key is
+// attached to a lease that this worker keeps alive, so that the heartbeats of
+// dead workers are removed.
+func (w *Worker) heartbeat(env serviceenv.ServiceEnv) {
+	ctx := w.driver.PachClient().Ctx()
+	pipelineInfo := w.driver.PipelineInfo()
+	logger := logs.NewStatlessLogger(pipelineInfo)
+	interval, err := types.DurationFromProto(pipelineInfo.Details.Heartbeat.Interval)
+	if err != nil {
+		logger.Logf("invalid heartbeat interval: %v", err)
+		return
+	}
+	key := ppsutil.HeartbeatKey(env.Config().PPSEtcdPrefix, pipelineInfo.Pipeline.Name, env.Config().PodName)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var session *concurrency.Session
+	defer func() {
+		if session != nil {
+			session.Close()
+		}
+	}()
+	for {
+		if err := func() error {
+			if session != nil {
+				select {
+				case <-session.Done():
+					// the lease expired, e.g. because etcd was unreachable
+					session = nil
+				default:
+				}
+			}
+			if session == nil {
+				var err error
+				if session, err = concurrency.NewSession(env.GetEtcdClient(), concurrency.WithContext(ctx), concurrency.WithTTL(heartbeatTTL)); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			_, err := env.GetEtcdClient().Put(ctx, key, time.Now().UTC().Format(time.RFC3339Nano), etcd.WithLease(session.Lease()))
+			return errors.EnsureStack(err)
+		}(); err != nil {
+			logger.Logf("could not record heartbeat: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func (w *Worker) worker() {
 	ctx := w.driver.PachClient().Ctx()
 	logger := logs.NewStatlessLogger(w.driver.PipelineInfo())